@@ -27,4 +27,10 @@ type Object interface {
 	SetConditions(conditions []metav1.Condition)
 	GetPrintableStatus() string
 	SetPrintableStatus(printableStatus string)
+	GetLastHandledReconcileAt() string
+	SetLastHandledReconcileAt(lastHandledReconcileAt string)
+	GetNextReconcileAt() *metav1.Time
+	SetNextReconcileAt(nextReconcileAt *metav1.Time)
+	GetURL() string
+	SetURL(url string)
 }
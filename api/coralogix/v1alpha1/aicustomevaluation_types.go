@@ -15,6 +15,15 @@
 package v1alpha1
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -28,9 +37,18 @@ const (
 	aiCustomEvaluationAcceptableScore    = "1"
 	aiCustomEvaluationProhibitedScore    = "0"
 	aiCustomEvaluationExamplesUpdateMask = "examples"
+
+	// AICustomEvaluationApplicationsPlaceholder is replaced by the names of the linked AI applications in the
+	// instructions and criteria flags of a custom evaluation with templating.
+	AICustomEvaluationApplicationsPlaceholder = "${applications}"
+	// maxAICustomEvaluationRevisions is the number of revisions kept in the status of a custom evaluation.
+	maxAICustomEvaluationRevisions = 10
 )
 
+var aiCustomEvaluationInstructionsPattern = regexp.MustCompile(`\{(prompt|response|chat_history)\}`)
+
 // AICustomEvaluationSpec defines the desired state of AICustomEvaluation.
+// +kubebuilder:validation:XValidation:rule="has(self.instructions) != has(self.instructionsFrom)",message="Exactly one of instructions or instructionsFrom must be set"
 type AICustomEvaluationSpec struct {
 	// Display name of the custom evaluation.
 	// +kubebuilder:validation:MinLength=1
@@ -49,10 +67,21 @@ type AICustomEvaluationSpec struct {
 	Description *string `json:"description,omitempty"`
 
 	// Instructions sent to the LLM evaluator. Must contain at least one of {prompt}, {response}, or {chat_history}.
+	// +optional
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=1048576
 	// +kubebuilder:validation:Pattern=`\{(prompt|response|chat_history)\}`
-	Instructions string `json:"instructions"`
+	Instructions string `json:"instructions,omitempty"`
+
+	// InstructionsFrom loads the instructions from a ConfigMap, so that they can be versioned separately. Changes of
+	// the ConfigMap are synchronized right away.
+	// +optional
+	InstructionsFrom *AICustomEvaluationValueSource `json:"instructionsFrom,omitempty"`
+
+	// Templating replaces ${applications} in the instructions and criteria flags with the comma-separated names of
+	// the linked AI applications.
+	// +optional
+	Templating bool `json:"templating,omitempty"`
 
 	// Whether to include the system prompt in the LLM input. Defaults to false.
 	// +optional
@@ -72,6 +101,12 @@ type AICustomEvaluationSpec struct {
 	Criteria *AICustomEvaluationCriteria `json:"criteria,omitempty"`
 }
 
+// AICustomEvaluationValueSource selects a value of an AICustomEvaluation stored outside of it.
+type AICustomEvaluationValueSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap in the namespace of the AICustomEvaluation.
+	ConfigMapKeyRef corev1.ConfigMapKeySelector `json:"configMapKeyRef"`
+}
+
 type AICustomEvaluationApplicationSelector struct {
 	// AI application name.
 	// +kubebuilder:validation:MinLength=1
@@ -96,12 +131,17 @@ type AICustomEvaluationCriteria struct {
 	Prohibited *AICustomEvaluationCriterion `json:"prohibited,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="!(has(self.flags) && has(self.flagsFrom))",message="Only one of flags or flagsFrom can be set"
 type AICustomEvaluationCriterion struct {
 	// Criterion flags.
 	// +optional
 	// +kubebuilder:validation:MaxLength=65536
 	Flags *string `json:"flags,omitempty"`
 
+	// FlagsFrom loads the criterion flags from a ConfigMap.
+	// +optional
+	FlagsFrom *AICustomEvaluationValueSource `json:"flagsFrom,omitempty"`
+
 	// Example conversations for this criterion.
 	// +optional
 	// +kubebuilder:validation:MaxItems=100
@@ -120,6 +160,50 @@ type AICustomEvaluationStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// ContentHash is the hash of the instructions and criteria flags last sent, identifying the live prompt version.
+	// +optional
+	ContentHash string `json:"contentHash,omitempty"`
+
+	// Revisions are the latest versions of the instructions and criteria flags sent, newest first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	Revisions []AICustomEvaluationRevision `json:"revisions,omitempty"`
+}
+
+// AICustomEvaluationRevision is a version of the instructions and criteria flags sent to Coralogix.
+type AICustomEvaluationRevision struct {
+	// ContentHash is the hash of the instructions and criteria flags.
+	ContentHash string `json:"contentHash"`
+
+	// AppliedAt is the time the version was sent.
+	AppliedAt metav1.Time `json:"appliedAt"`
+}
+
+// RecordRevision records the content hash of the custom evaluation if it changed, and reports whether it did.
+func (s *AICustomEvaluationStatus) RecordRevision(contentHash string, now metav1.Time) bool {
+	if s.ContentHash == contentHash {
+		return false
+	}
+	s.ContentHash = contentHash
+	s.Revisions = append([]AICustomEvaluationRevision{{ContentHash: contentHash, AppliedAt: now}}, s.Revisions...)
+	if len(s.Revisions) > maxAICustomEvaluationRevisions {
+		s.Revisions = s.Revisions[:maxAICustomEvaluationRevisions]
+	}
+	return true
 }
 
 func (e *AICustomEvaluation) GetConditions() []metav1.Condition {
@@ -138,6 +222,30 @@ func (e *AICustomEvaluation) SetPrintableStatus(printableStatus string) {
 	e.Status.PrintableStatus = printableStatus
 }
 
+func (e *AICustomEvaluation) GetLastHandledReconcileAt() string {
+	return e.Status.LastHandledReconcileAt
+}
+
+func (e *AICustomEvaluation) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	e.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (e *AICustomEvaluation) GetNextReconcileAt() *metav1.Time {
+	return e.Status.NextReconcileAt
+}
+
+func (e *AICustomEvaluation) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	e.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (e *AICustomEvaluation) GetURL() string {
+	return e.Status.URL
+}
+
+func (e *AICustomEvaluation) SetURL(url string) {
+	e.Status.URL = url
+}
+
 func (e *AICustomEvaluation) HasIDInStatus() bool {
 	return e.Status.Id != nil && *e.Status.Id != ""
 }
@@ -181,6 +289,60 @@ func (e *AICustomEvaluation) ExtractUpdateAICustomEvaluationExamplesRequest() (*
 	}, nil
 }
 
+// Resolve returns a copy of the custom evaluation whose instructions and criteria flags are loaded from their
+// ConfigMaps and, with templating, name the given AI applications.
+func (e *AICustomEvaluation) Resolve(ctx context.Context, applicationNames []string) (*AICustomEvaluation, error) {
+	resolved := e.DeepCopy()
+	spec := &resolved.Spec
+	if source := spec.InstructionsFrom; source != nil {
+		instructions, err := getConfigMapKeyContent(ctx, e.Namespace, &source.ConfigMapKeyRef)
+		if err != nil {
+			return nil, fmt.Errorf("error on loading instructions: %w", err)
+		}
+		spec.Instructions = instructions
+		spec.InstructionsFrom = nil
+	}
+	if spec.Criteria != nil {
+		for _, criterion := range []*AICustomEvaluationCriterion{spec.Criteria.Acceptable, spec.Criteria.Prohibited} {
+			if criterion == nil || criterion.FlagsFrom == nil {
+				continue
+			}
+			flags, err := getConfigMapKeyContent(ctx, e.Namespace, &criterion.FlagsFrom.ConfigMapKeyRef)
+			if err != nil {
+				return nil, fmt.Errorf("error on loading criterion flags: %w", err)
+			}
+			criterion.Flags = ptr.To(flags)
+			criterion.FlagsFrom = nil
+		}
+	}
+
+	if spec.Templating {
+		names := slices.Clone(applicationNames)
+		slices.Sort(names)
+		replacer := strings.NewReplacer(AICustomEvaluationApplicationsPlaceholder, strings.Join(slices.Compact(names), ", "))
+		spec.Instructions = replacer.Replace(spec.Instructions)
+		if spec.Criteria != nil {
+			for _, criterion := range []*AICustomEvaluationCriterion{spec.Criteria.Acceptable, spec.Criteria.Prohibited} {
+				if criterion != nil && criterion.Flags != nil {
+					criterion.Flags = ptr.To(replacer.Replace(*criterion.Flags))
+				}
+			}
+		}
+	}
+
+	if !aiCustomEvaluationInstructionsPattern.MatchString(spec.Instructions) {
+		return nil, fmt.Errorf("instructions must contain at least one of {prompt}, {response} or {chat_history}")
+	}
+	return resolved, nil
+}
+
+// ContentHash returns the hash of the instructions and criteria flags of a resolved custom evaluation.
+func (s *AICustomEvaluationSpec) ContentHash() string {
+	sum := sha256.Sum256([]byte(s.Instructions + "\x00" + s.AcceptableCriterionValue().FlagsValue() + "\x00" +
+		s.ProhibitedCriterionValue().FlagsValue()))
+	return hex.EncodeToString(sum[:8])
+}
+
 func (s *AICustomEvaluationSpec) ShouldIncludeSystemPromptValue() bool {
 	if s.ShouldIncludeSystemPrompt == nil {
 		return false
@@ -234,6 +396,8 @@ func (s *AICustomEvaluationSpec) ExtractCustomEvaluationExamples() []aievaluatio
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="Revision",type="string",JSONPath=".status.contentHash"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // AICustomEvaluation is the Schema for the AI custom evaluations API.
@@ -15,9 +15,14 @@
 package v1alpha1
 
 import (
+	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	aievaluations "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/ai_evaluations_service"
@@ -318,3 +323,71 @@ func requireCustomEvaluationExamples(t *testing.T, actual []aievaluations.Custom
 		require.Equal(t, expected[i].score, actual[i].GetScore())
 	}
 }
+
+func TestAICustomEvaluationResolve(t *testing.T) {
+	useFakeClient(t, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "prompts", Namespace: "default"},
+		Data: map[string]string{
+			"instructions.txt": "Score whether {response} of ${applications} mentions competitor products.",
+			"prohibited.txt":   "Mentions a competitor of ${applications}",
+			"no-placeholder":   "Score the answer.",
+		},
+	})
+
+	aiCustomEvaluation := &AICustomEvaluation{
+		ObjectMeta: metav1.ObjectMeta{Name: "competition", Namespace: "default"},
+		Spec: AICustomEvaluationSpec{
+			Name:       "Competition",
+			PolicyType: AICustomEvaluationPolicyTypeQuality,
+			InstructionsFrom: &AICustomEvaluationValueSource{ConfigMapKeyRef: corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "prompts"},
+				Key:                  "instructions.txt",
+			}},
+			Templating: true,
+			Criteria: &AICustomEvaluationCriteria{Prohibited: &AICustomEvaluationCriterion{
+				FlagsFrom: &AICustomEvaluationValueSource{ConfigMapKeyRef: corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "prompts"},
+					Key:                  "prohibited.txt",
+				}},
+			}},
+		},
+	}
+
+	resolved, err := aiCustomEvaluation.Resolve(context.Background(), []string{"support-bot", "sales-bot", "support-bot"})
+	require.NoError(t, err)
+	require.Equal(t, "Score whether {response} of sales-bot, support-bot mentions competitor products.", resolved.Spec.Instructions)
+	require.Equal(t, "Mentions a competitor of sales-bot, support-bot", *resolved.Spec.Criteria.Prohibited.Flags)
+	require.Nil(t, aiCustomEvaluation.Spec.Criteria.Prohibited.Flags, "the custom evaluation is not modified")
+
+	createRequest, err := resolved.ExtractCreateAICustomEvaluationRequest(nil)
+	require.NoError(t, err)
+	require.Equal(t, resolved.Spec.Instructions, createRequest.GetInstructions())
+
+	aiCustomEvaluation.Spec.Templating = false
+	untemplated, err := aiCustomEvaluation.Resolve(context.Background(), []string{"support-bot"})
+	require.NoError(t, err)
+	require.Contains(t, untemplated.Spec.Instructions, AICustomEvaluationApplicationsPlaceholder)
+	require.NotEqual(t, resolved.Spec.ContentHash(), untemplated.Spec.ContentHash())
+
+	aiCustomEvaluation.Spec.InstructionsFrom.ConfigMapKeyRef.Key = "no-placeholder"
+	_, err = aiCustomEvaluation.Resolve(context.Background(), nil)
+	require.EqualError(t, err, "instructions must contain at least one of {prompt}, {response} or {chat_history}")
+
+	aiCustomEvaluation.Spec.InstructionsFrom.ConfigMapKeyRef.Key = "missing"
+	_, err = aiCustomEvaluation.Resolve(context.Background(), nil)
+	require.ErrorContains(t, err, "cannot find key 'missing' in config map 'prompts'")
+}
+
+func TestAICustomEvaluationRecordRevision(t *testing.T) {
+	var status AICustomEvaluationStatus
+	now := metav1.NewTime(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+	require.True(t, status.RecordRevision("first", now))
+	require.False(t, status.RecordRevision("first", now))
+	for i := range maxAICustomEvaluationRevisions {
+		require.True(t, status.RecordRevision(fmt.Sprintf("revision-%d", i), now))
+	}
+	require.Equal(t, fmt.Sprintf("revision-%d", maxAICustomEvaluationRevisions-1), status.ContentHash)
+	require.Len(t, status.Revisions, maxAICustomEvaluationRevisions)
+	require.Equal(t, status.ContentHash, status.Revisions[0].ContentHash)
+	require.Equal(t, "revision-0", status.Revisions[maxAICustomEvaluationRevisions-1].ContentHash)
+}
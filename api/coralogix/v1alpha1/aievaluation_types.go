@@ -242,6 +242,19 @@ type AIEvaluationStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (e *AIEvaluation) GetConditions() []metav1.Condition {
@@ -260,6 +273,30 @@ func (e *AIEvaluation) SetPrintableStatus(printableStatus string) {
 	e.Status.PrintableStatus = printableStatus
 }
 
+func (e *AIEvaluation) GetLastHandledReconcileAt() string {
+	return e.Status.LastHandledReconcileAt
+}
+
+func (e *AIEvaluation) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	e.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (e *AIEvaluation) GetNextReconcileAt() *metav1.Time {
+	return e.Status.NextReconcileAt
+}
+
+func (e *AIEvaluation) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	e.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (e *AIEvaluation) GetURL() string {
+	return e.Status.URL
+}
+
+func (e *AIEvaluation) SetURL(url string) {
+	e.Status.URL = url
+}
+
 func (e *AIEvaluation) HasIDInStatus() bool {
 	return e.Status.Id != nil && *e.Status.Id != ""
 }
@@ -551,6 +588,7 @@ func NewAIEvaluationToxicityConfig() *map[string]string {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // AIEvaluation is the Schema for the AI evaluations API.
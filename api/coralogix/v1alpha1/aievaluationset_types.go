@@ -0,0 +1,222 @@
+// Copyright 2026 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AIEvaluationSetSpec defines the evaluations of an AI application.
+type AIEvaluationSetSpec struct {
+	// Name of the AI application the evaluations belong to.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.application is immutable"
+	Application string `json:"application"`
+
+	// Subsystem within the application.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.subsystem is immutable"
+	Subsystem string `json:"subsystem"`
+
+	// Evaluations contains the evaluations that this resource manages.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	// +listType=map
+	// +listMapKey=key
+	Evaluations []AIEvaluationSetItem `json:"evaluations"`
+}
+
+// AIEvaluationSetItem defines one evaluation in an AIEvaluationSet.
+type AIEvaluationSetItem struct {
+	// Key is the stable identity of the evaluation in this AIEvaluationSet.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Key string `json:"key"`
+
+	// Target span content the evaluation runs against. Changing it recreates the remote evaluation.
+	// +kubebuilder:validation:Enum=prompt;response
+	Target string `json:"target"`
+
+	// Score threshold. Must be between 0.0 and 1.0 inclusive.
+	// Fractional values must be supplied as quoted quantities, for example "0.8".
+	Threshold resource.Quantity `json:"threshold"`
+
+	// Whether the evaluation is active.
+	// +optional
+	// +kubebuilder:default=true
+	IsEnabled *bool `json:"isEnabled,omitempty"`
+
+	// AI evaluation configuration.
+	Config AIEvaluationConfig `json:"config"`
+}
+
+// EvaluationSpec returns the spec of the AIEvaluation equivalent to an evaluation of the set.
+func (s *AIEvaluationSetSpec) EvaluationSpec(item AIEvaluationSetItem) AIEvaluationSpec {
+	return AIEvaluationSpec{
+		Application: s.Application,
+		Subsystem:   s.Subsystem,
+		Target:      item.Target,
+		Threshold:   item.Threshold,
+		IsEnabled:   item.IsEnabled,
+		Config:      item.Config,
+	}
+}
+
+// AIEvaluationSetItemState is the synchronization state of one evaluation.
+// +kubebuilder:validation:Enum=Pending;Synced;Failed;Deleting
+type AIEvaluationSetItemState string
+
+const (
+	AIEvaluationSetItemStatePending  AIEvaluationSetItemState = "Pending"
+	AIEvaluationSetItemStateSynced   AIEvaluationSetItemState = "Synced"
+	AIEvaluationSetItemStateFailed   AIEvaluationSetItemState = "Failed"
+	AIEvaluationSetItemStateDeleting AIEvaluationSetItemState = "Deleting"
+)
+
+// AIEvaluationSetItemStatus defines the observed state of one evaluation.
+type AIEvaluationSetItemStatus struct {
+	// Key is the stable identity of the evaluation in this AIEvaluationSet.
+	Key string `json:"key"`
+
+	// ID is the remote Coralogix AI evaluation ID.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Target is the target the remote evaluation was created with.
+	// +optional
+	Target string `json:"target,omitempty"`
+
+	// State is the latest synchronization state.
+	// +optional
+	State AIEvaluationSetItemState `json:"state,omitempty"`
+
+	// Message describes the latest synchronization failure.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// AIEvaluationSetStatus defines the observed state of an AIEvaluationSet.
+type AIEvaluationSetStatus struct {
+	// Evaluations contains the observed state of each managed evaluation.
+	// +optional
+	// +listType=map
+	// +listMapKey=key
+	Evaluations []AIEvaluationSetItemStatus `json:"evaluations,omitempty"`
+
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Application",type="string",JSONPath=".spec.application"
+// +kubebuilder:printcolumn:name="Subsystem",type="string",JSONPath=".spec.subsystem"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AIEvaluationSet is the Schema for the AIEvaluationSets API.
+// It manages all the evaluations of an AI application and subsystem, which are synchronized by listing the remote
+// evaluations once and creating, updating and deleting only those that differ.
+// See also https://coralogix.com/docs/user-guides/ai/
+type AIEvaluationSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AIEvaluationSetSpec   `json:"spec"`
+	Status AIEvaluationSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AIEvaluationSetList contains a list of AIEvaluationSet resources.
+type AIEvaluationSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AIEvaluationSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AIEvaluationSet{}, &AIEvaluationSetList{})
+}
+
+func (e *AIEvaluationSet) GetConditions() []metav1.Condition {
+	return e.Status.Conditions
+}
+
+func (e *AIEvaluationSet) SetConditions(conditions []metav1.Condition) {
+	e.Status.Conditions = conditions
+}
+
+func (e *AIEvaluationSet) HasIDInStatus() bool {
+	for _, evaluation := range e.Status.Evaluations {
+		if evaluation.ID != nil && *evaluation.ID != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *AIEvaluationSet) GetPrintableStatus() string {
+	return e.Status.PrintableStatus
+}
+
+func (e *AIEvaluationSet) SetPrintableStatus(printableStatus string) {
+	e.Status.PrintableStatus = printableStatus
+}
+
+func (e *AIEvaluationSet) GetLastHandledReconcileAt() string {
+	return e.Status.LastHandledReconcileAt
+}
+
+func (e *AIEvaluationSet) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	e.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (e *AIEvaluationSet) GetNextReconcileAt() *metav1.Time {
+	return e.Status.NextReconcileAt
+}
+
+func (e *AIEvaluationSet) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	e.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (e *AIEvaluationSet) GetURL() string {
+	return e.Status.URL
+}
+
+func (e *AIEvaluationSet) SetURL(url string) {
+	e.Status.URL = url
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
 	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/queryvalidation"
 )
 
 // AlertSchedulerSpec defines the desired state Coralogix AlertScheduler.
@@ -69,9 +70,17 @@ type Filter struct {
 	Alerts []AlertRef `json:"alerts,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="has(self.resourceRef) != has(self.alertSetRef)",message="Exactly one of resourceRef or alertSetRef must be set"
 type AlertRef struct {
 	// Alert custom resource name and namespace. If namespace is not set, the AlertScheduler namespace will be used.
-	ResourceRef *ResourceRef `json:"resourceRef"`
+	// Conflicts with `alertSetRef`.
+	// +optional
+	ResourceRef *ResourceRef `json:"resourceRef,omitempty"`
+
+	// AlertSet custom resource name and namespace, referencing all the alerts of the AlertSet.
+	// If namespace is not set, the AlertScheduler namespace will be used. Conflicts with `resourceRef`.
+	// +optional
+	AlertSetRef *ResourceRef `json:"alertSetRef,omitempty"`
 }
 
 type MetaLabel struct {
@@ -193,6 +202,19 @@ type AlertSchedulerStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 var (
@@ -232,11 +254,39 @@ func (a *AlertScheduler) SetPrintableStatus(printableStatus string) {
 	a.Status.PrintableStatus = printableStatus
 }
 
+func (a *AlertScheduler) GetLastHandledReconcileAt() string {
+	return a.Status.LastHandledReconcileAt
+}
+
+func (a *AlertScheduler) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	a.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (a *AlertScheduler) GetNextReconcileAt() *metav1.Time {
+	return a.Status.NextReconcileAt
+}
+
+func (a *AlertScheduler) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	a.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (a *AlertScheduler) GetURL() string {
+	return a.Status.URL
+}
+
+func (a *AlertScheduler) SetURL(url string) {
+	a.Status.URL = url
+}
+
 func (a *AlertScheduler) HasIDInStatus() bool {
 	return a.Status.ID != nil && *a.Status.ID != ""
 }
 
 func (a *AlertScheduler) ExtractAlertSchedulerRule() (*alertscheduler.AlertSchedulerRule, error) {
+	if err := queryvalidation.DataPrime(a.Spec.Filter.WhatExpression); err != nil {
+		return nil, fmt.Errorf("spec.filter.whatExpression: %w", err)
+	}
+
 	metaLabels := extractMetaLabels(a.Spec.MetaLabels)
 	filter, err := a.extractFilter()
 	if err != nil {
@@ -300,6 +350,16 @@ func (a *AlertScheduler) extractAlertsIds() ([]string, error) {
 	var errs error
 
 	for _, alert := range a.Spec.Filter.Alerts {
+		if alert.AlertSetRef != nil {
+			ids, err := extractAlertSetAlertsIds(alert, a.Namespace)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			result = append(result, ids...)
+			continue
+		}
+
 		id, err := extractAlertId(alert, a.Namespace)
 		if err != nil {
 			errs = errors.Join(errs, err)
@@ -341,6 +401,33 @@ func extractAlertId(alert AlertRef, schedulerNamespace string) (string, error) {
 	return *a.Status.ID, nil
 }
 
+func extractAlertSetAlertsIds(alert AlertRef, schedulerNamespace string) ([]string, error) {
+	namespace := schedulerNamespace
+	if alert.AlertSetRef.Namespace != nil {
+		namespace = *alert.AlertSetRef.Namespace
+	}
+
+	alertSet := &AlertSet{}
+	err := config.GetClient().Get(context.Background(),
+		client.ObjectKey{Name: alert.AlertSetRef.Name, Namespace: namespace}, alertSet)
+	if err != nil {
+		return nil, err
+	}
+
+	if !config.GetConfig().Selector.Matches(alertSet.Labels, alertSet.Namespace) {
+		return nil, fmt.Errorf("alert set %s does not match selector", alertSet.Name)
+	}
+
+	var result []string
+	for _, item := range alertSet.Status.Alerts {
+		if item.ID == nil {
+			return nil, fmt.Errorf("ID is not populated for alert %s of alert set %s", item.Key, alertSet.Name)
+		}
+		result = append(result, *item.ID)
+	}
+	return result, nil
+}
+
 func (a *AlertScheduler) extractSchedule() (*alertscheduler.Schedule, error) {
 	scheduleOperation := schemaToScheduleOperation[a.Spec.Schedule.Operation]
 
@@ -464,6 +551,7 @@ func extractTimeFrame(timeFrame *TimeFrame) (*alertscheduler.Timeframe, error) {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // AlertScheduler is the Schema for the AlertSchedulers API.
 // It is used to suppress or activate alerts based on a schedule.
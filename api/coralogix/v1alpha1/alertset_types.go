@@ -69,6 +69,14 @@ type AlertSetItemStatus struct {
 	// Message describes the latest synchronization failure.
 	// +optional
 	Message string `json:"message,omitempty"`
+
+	// AppliedDefaults are the namespace defaults merged into the spec of the alert.
+	// +optional
+	AppliedDefaults *v1beta1.AppliedDefaults `json:"appliedDefaults,omitempty"`
+
+	// Rollout is the progressive rollout of the changes to the alert.
+	// +optional
+	Rollout *v1beta1.AlertRolloutStatus `json:"rollout,omitempty"`
 }
 
 // AlertSetStatus defines the observed state of an AlertSet.
@@ -84,12 +92,26 @@ type AlertSetStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // AlertSet is the Schema for the AlertSets API.
@@ -138,3 +160,27 @@ func (a *AlertSet) GetPrintableStatus() string {
 func (a *AlertSet) SetPrintableStatus(printableStatus string) {
 	a.Status.PrintableStatus = printableStatus
 }
+
+func (a *AlertSet) GetLastHandledReconcileAt() string {
+	return a.Status.LastHandledReconcileAt
+}
+
+func (a *AlertSet) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	a.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (a *AlertSet) GetNextReconcileAt() *metav1.Time {
+	return a.Status.NextReconcileAt
+}
+
+func (a *AlertSet) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	a.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (a *AlertSet) GetURL() string {
+	return a.Status.URL
+}
+
+func (a *AlertSet) SetURL(url string) {
+	a.Status.URL = url
+}
@@ -71,6 +71,19 @@ type ApiKeyStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (a *ApiKey) GetConditions() []metav1.Condition {
@@ -89,6 +102,30 @@ func (a *ApiKey) SetPrintableStatus(printableStatus string) {
 	a.Status.PrintableStatus = printableStatus
 }
 
+func (a *ApiKey) GetLastHandledReconcileAt() string {
+	return a.Status.LastHandledReconcileAt
+}
+
+func (a *ApiKey) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	a.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (a *ApiKey) GetNextReconcileAt() *metav1.Time {
+	return a.Status.NextReconcileAt
+}
+
+func (a *ApiKey) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	a.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (a *ApiKey) GetURL() string {
+	return a.Status.URL
+}
+
+func (a *ApiKey) SetURL(url string) {
+	a.Status.URL = url
+}
+
 func (a *ApiKey) HasIDInStatus() bool {
 	return a.Status.Id != nil && *a.Status.Id != ""
 }
@@ -96,6 +133,7 @@ func (a *ApiKey) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // ApiKey is the Schema for the ApiKeys API.
 // See also https://coralogix.com/docs/user-guides/account-management/api-keys/api-keys/
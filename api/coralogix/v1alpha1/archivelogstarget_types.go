@@ -61,6 +61,19 @@ type ArchiveLogsTargetStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (s *ArchiveLogsTargetSpec) ExtractSetTargetRequest(isTargetActive bool) (*targets.SetTargetResponse, error) {
@@ -93,6 +106,30 @@ func (a *ArchiveLogsTarget) SetPrintableStatus(printableStatus string) {
 	a.Status.PrintableStatus = printableStatus
 }
 
+func (a *ArchiveLogsTarget) GetLastHandledReconcileAt() string {
+	return a.Status.LastHandledReconcileAt
+}
+
+func (a *ArchiveLogsTarget) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	a.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (a *ArchiveLogsTarget) GetNextReconcileAt() *metav1.Time {
+	return a.Status.NextReconcileAt
+}
+
+func (a *ArchiveLogsTarget) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	a.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (a *ArchiveLogsTarget) GetURL() string {
+	return a.Status.URL
+}
+
+func (a *ArchiveLogsTarget) SetURL(url string) {
+	a.Status.URL = url
+}
+
 func (a *ArchiveLogsTarget) HasIDInStatus() bool {
 	return a.Status.ID != nil && *a.Status.ID != ""
 }
@@ -100,10 +137,14 @@ func (a *ArchiveLogsTarget) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // ArchiveLogsTarget is the Schema for the Archive Logs API.
 // See also https://coralogix.com/docs/user-guides/account-management/user-management/create-roles-and-permissions/
 //
+// Only the oldest selected ArchiveLogsTarget in the cluster is applied to the account;
+// any other one reports a Conflict condition naming it and is ignored.
+//
 // **Added in v0.5.0**
 type ArchiveLogsTarget struct {
 	metav1.TypeMeta   `json:",inline"`
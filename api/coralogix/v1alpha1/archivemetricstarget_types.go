@@ -53,6 +53,19 @@ type ArchiveMetricsTargetStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (s *ArchiveMetricsTargetSpec) ExtractConfigureTenantRequest() (*archivemetrics.ConfigureTenantRequest, error) {
@@ -103,6 +116,30 @@ func (a *ArchiveMetricsTarget) SetPrintableStatus(printableStatus string) {
 	a.Status.PrintableStatus = printableStatus
 }
 
+func (a *ArchiveMetricsTarget) GetLastHandledReconcileAt() string {
+	return a.Status.LastHandledReconcileAt
+}
+
+func (a *ArchiveMetricsTarget) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	a.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (a *ArchiveMetricsTarget) GetNextReconcileAt() *metav1.Time {
+	return a.Status.NextReconcileAt
+}
+
+func (a *ArchiveMetricsTarget) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	a.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (a *ArchiveMetricsTarget) GetURL() string {
+	return a.Status.URL
+}
+
+func (a *ArchiveMetricsTarget) SetURL(url string) {
+	a.Status.URL = url
+}
+
 func (a *ArchiveMetricsTarget) HasIDInStatus() bool {
 	return a.Status.ID != nil && *a.Status.ID != ""
 }
@@ -110,10 +147,14 @@ func (a *ArchiveMetricsTarget) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // ArchiveLogsTarget is the Schema for the archive logs targets API.
 // See also https://coralogix.com/docs/archive-s3-bucket-forever
 //
+// Only the oldest selected ArchiveMetricsTarget in the cluster is applied to the account;
+// any other one reports a Conflict condition naming it and is ignored.
+//
 // **Added in v0.5.0**
 type ArchiveMetricsTarget struct {
 	metav1.TypeMeta   `json:",inline"`
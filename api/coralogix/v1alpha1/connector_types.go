@@ -96,6 +96,19 @@ type ConnectorStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (c *Connector) GetConditions() []metav1.Condition {
@@ -114,6 +127,30 @@ func (c *Connector) SetPrintableStatus(printableStatus string) {
 	c.Status.PrintableStatus = printableStatus
 }
 
+func (c *Connector) GetLastHandledReconcileAt() string {
+	return c.Status.LastHandledReconcileAt
+}
+
+func (c *Connector) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	c.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (c *Connector) GetNextReconcileAt() *metav1.Time {
+	return c.Status.NextReconcileAt
+}
+
+func (c *Connector) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	c.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (c *Connector) GetURL() string {
+	return c.Status.URL
+}
+
+func (c *Connector) SetURL(url string) {
+	c.Status.URL = url
+}
+
 func (c *Connector) HasIDInStatus() bool {
 	return c.Status.Id != nil && *c.Status.Id != ""
 }
@@ -236,6 +273,7 @@ func readSecret(ctx context.Context, secretKeyRef corev1.SecretKeySelector, name
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // Connector is the Schema for the connectors API.
 //
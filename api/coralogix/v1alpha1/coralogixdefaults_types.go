@@ -0,0 +1,212 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+)
+
+const (
+	routingLabelEnvironment = "routing.environment"
+	routingLabelService     = "routing.service"
+	routingLabelTeam        = "routing.team"
+)
+
+// CoralogixDefaultsSpec defines the defaults merged into the Alerts, AlertSets and SLOs of a namespace.
+type CoralogixDefaultsSpec struct {
+	// Whether the defaults apply to all the namespaces, as a fallback for the values not set by the CoralogixDefaults
+	// of the namespace.
+	// +optional
+	ClusterWide bool `json:"clusterWide,omitempty"`
+
+	// Notification group of the alerts that do not set one.
+	// +optional
+	NotificationGroup *v1beta1.NotificationGroup `json:"notificationGroup,omitempty"`
+
+	// Entity labels added to the alerts and to the labels of the SLOs. Labels set on the resource take precedence.
+	// +optional
+	EntityLabels map[string]string `json:"entityLabels,omitempty"`
+
+	// Routing labels of the alerts, added to their entity labels as `routing.environment`, `routing.service` and
+	// `routing.team`.
+	// +optional
+	RoutingLabels *RoutingLabels `json:"routingLabels,omitempty"`
+
+	// Data sources of the alerts that do not set any.
+	// +optional
+	DataSources []v1beta1.AlertDataSource `json:"dataSources,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=coralogixdefaults,singular=coralogixdefaults
+// +kubebuilder:printcolumn:name="Cluster Wide",type="boolean",JSONPath=".spec.clusterWide"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// CoralogixDefaults is the Schema for the CoralogixDefaults API.
+// It holds the notification group, entity labels, routing labels and data sources that the Alerts, AlertSets and
+// SLOs of its namespace would otherwise repeat. The values are merged into their specs when the requests to
+// Coralogix are built, and the applied defaults are recorded in their status.
+//
+// A namespace has a single CoralogixDefaults, and a CoralogixDefaults with `clusterWide` set is the fallback of all
+// the namespaces. If several of them match, the oldest one is used.
+type CoralogixDefaults struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec CoralogixDefaultsSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CoralogixDefaultsList contains a list of CoralogixDefaults.
+type CoralogixDefaultsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CoralogixDefaults `json:"items"`
+}
+
+// GetAlertDefaults returns the defaults of the alerts of the namespace, or nil if no CoralogixDefaults applies to it.
+// The values of the CoralogixDefaults of the namespace take precedence over the cluster-wide ones.
+func GetAlertDefaults(ctx context.Context, namespace string) (*v1beta1.AlertDefaults, error) {
+	applicable, err := getCoralogixDefaults(ctx, namespace)
+	if err != nil || len(applicable) == 0 {
+		return nil, err
+	}
+
+	defaults := &v1beta1.AlertDefaults{}
+	for _, d := range applicable {
+		defaults.Sources = append(defaults.Sources, d.Namespace+"/"+d.Name)
+		if d.Spec.NotificationGroup != nil {
+			defaults.NotificationGroup = d.Spec.NotificationGroup
+		}
+		if len(d.Spec.DataSources) > 0 {
+			defaults.DataSources = d.Spec.DataSources
+		}
+		if labels := d.Spec.alertEntityLabels(); len(labels) > 0 {
+			if defaults.EntityLabels == nil {
+				defaults.EntityLabels = make(map[string]string)
+			}
+			maps.Copy(defaults.EntityLabels, labels)
+		}
+	}
+	return defaults, nil
+}
+
+// SLODefaults are the namespace defaults of the SLOs, resolved from CoralogixDefaults.
+// The notification group and routing labels do not apply to SLOs themselves, but to the Alerts generated from
+// spec.alerting, which get them as any other Alert of the namespace.
+// +k8s:deepcopy-gen=false
+type SLODefaults struct {
+	// Sources are the CoralogixDefaults the values come from, as `<namespace>/<name>`.
+	Sources []string
+	Labels  map[string]string
+}
+
+// GetSLODefaults returns the defaults of the SLOs of the namespace, or nil if no CoralogixDefaults applies to it.
+func GetSLODefaults(ctx context.Context, namespace string) (*SLODefaults, error) {
+	applicable, err := getCoralogixDefaults(ctx, namespace)
+	if err != nil || len(applicable) == 0 {
+		return nil, err
+	}
+
+	defaults := &SLODefaults{}
+	for _, d := range applicable {
+		defaults.Sources = append(defaults.Sources, d.Namespace+"/"+d.Name)
+		if len(d.Spec.EntityLabels) > 0 {
+			if defaults.Labels == nil {
+				defaults.Labels = make(map[string]string)
+			}
+			maps.Copy(defaults.Labels, d.Spec.EntityLabels)
+		}
+	}
+	return defaults, nil
+}
+
+// alertEntityLabels returns the entity labels of the alerts, including the routing labels.
+func (s *CoralogixDefaultsSpec) alertEntityLabels() map[string]string {
+	labels := maps.Clone(s.EntityLabels)
+	if s.RoutingLabels == nil {
+		return labels
+	}
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	if s.RoutingLabels.Environment != nil {
+		labels[routingLabelEnvironment] = *s.RoutingLabels.Environment
+	}
+	if s.RoutingLabels.Service != nil {
+		labels[routingLabelService] = *s.RoutingLabels.Service
+	}
+	if s.RoutingLabels.Team != nil {
+		labels[routingLabelTeam] = *s.RoutingLabels.Team
+	}
+	return labels
+}
+
+// getCoralogixDefaults returns the CoralogixDefaults applying to the namespace, in increasing order of precedence:
+// the oldest cluster-wide one, then the oldest one of the namespace.
+// CoralogixDefaults not matching the selector of the operator are ignored.
+func getCoralogixDefaults(ctx context.Context, namespace string) ([]*CoralogixDefaults, error) {
+	list := &CoralogixDefaultsList{}
+	if err := config.GetClient().List(ctx, list); err != nil {
+		return nil, fmt.Errorf("failed to list CoralogixDefaults: %w", err)
+	}
+
+	items := list.Items
+	sort.Slice(items, func(i, j int) bool {
+		ti, tj := items[i].CreationTimestamp, items[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	var namespaced, clusterWide *CoralogixDefaults
+	for i := range items {
+		d := &items[i]
+		if !config.GetConfig().Selector.Matches(d.Labels, d.Namespace) {
+			continue
+		}
+		if d.Namespace == namespace && namespaced == nil {
+			namespaced = d
+		}
+		if d.Spec.ClusterWide && clusterWide == nil {
+			clusterWide = d
+		}
+	}
+
+	var applicable []*CoralogixDefaults
+	if clusterWide != nil && clusterWide != namespaced {
+		applicable = append(applicable, clusterWide)
+	}
+	if namespaced != nil {
+		applicable = append(applicable, namespaced)
+	}
+	return applicable, nil
+}
+
+func init() {
+	SchemeBuilder.Register(&CoralogixDefaults{}, &CoralogixDefaultsList{})
+}
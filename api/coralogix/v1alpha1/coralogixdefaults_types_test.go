@@ -0,0 +1,146 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+)
+
+func TestGetAlertDefaults(t *testing.T) {
+	now := time.Now()
+	clusterWide := &CoralogixDefaults{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "platform", CreationTimestamp: metav1.NewTime(now)},
+		Spec: CoralogixDefaultsSpec{
+			ClusterWide: true,
+			NotificationGroup: &v1beta1.NotificationGroup{
+				Router: &v1beta1.NotificationRouter{NotifyOn: "triggeredAndResolved"},
+			},
+			EntityLabels: map[string]string{"owner": "platform", "tier": "1"},
+			DataSources:  []v1beta1.AlertDataSource{{DataSpace: "default", DataSet: "logs"}},
+		},
+	}
+	namespaced := &CoralogixDefaults{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "checkout", CreationTimestamp: metav1.NewTime(now)},
+		Spec: CoralogixDefaultsSpec{
+			EntityLabels:  map[string]string{"owner": "checkout"},
+			RoutingLabels: &RoutingLabels{Environment: ptr.To("production"), Team: ptr.To("checkout")},
+		},
+	}
+	newer := &CoralogixDefaults{
+		ObjectMeta: metav1.ObjectMeta{Name: "newer", Namespace: "checkout", CreationTimestamp: metav1.NewTime(now.Add(time.Hour))},
+		Spec:       CoralogixDefaultsSpec{EntityLabels: map[string]string{"owner": "ignored"}},
+	}
+	setupCoralogixDefaultsTest(t, clusterWide, namespaced, newer)
+
+	defaults, err := GetAlertDefaults(context.Background(), "checkout")
+	require.NoError(t, err)
+	require.Equal(t, &v1beta1.AlertDefaults{
+		Sources:           []string{"platform/cluster", "checkout/checkout"},
+		NotificationGroup: clusterWide.Spec.NotificationGroup,
+		EntityLabels: map[string]string{
+			"owner":               "checkout",
+			"tier":                "1",
+			"routing.environment": "production",
+			"routing.team":        "checkout",
+		},
+		DataSources: clusterWide.Spec.DataSources,
+	}, defaults)
+
+	defaults, err = GetAlertDefaults(context.Background(), "payments")
+	require.NoError(t, err)
+	require.Equal(t, []string{"platform/cluster"}, defaults.Sources)
+	require.Equal(t, map[string]string{"owner": "platform", "tier": "1"}, defaults.EntityLabels)
+
+	sloDefaults, err := GetSLODefaults(context.Background(), "checkout")
+	require.NoError(t, err)
+	require.Equal(t, &SLODefaults{
+		Sources: []string{"platform/cluster", "checkout/checkout"},
+		Labels:  map[string]string{"owner": "checkout", "tier": "1"},
+	}, sloDefaults)
+}
+
+func TestGetAlertDefaultsWithoutCoralogixDefaults(t *testing.T) {
+	setupCoralogixDefaultsTest(t)
+
+	defaults, err := GetAlertDefaults(context.Background(), "checkout")
+	require.NoError(t, err)
+	require.Nil(t, defaults)
+}
+
+func TestAlertSpecWithDefaults(t *testing.T) {
+	spec := &v1beta1.AlertSpec{
+		Name:         "errors",
+		EntityLabels: map[string]string{"owner": "checkout"},
+	}
+	defaults := &v1beta1.AlertDefaults{
+		Sources: []string{"checkout/defaults"},
+		NotificationGroup: &v1beta1.NotificationGroup{
+			Router: &v1beta1.NotificationRouter{NotifyOn: "triggeredOnly"},
+		},
+		EntityLabels: map[string]string{"owner": "platform", "routing.team": "checkout"},
+		DataSources:  []v1beta1.AlertDataSource{{DataSpace: "default", DataSet: "logs"}},
+	}
+
+	merged, applied := spec.WithDefaults(defaults)
+	require.Equal(t, defaults.NotificationGroup, merged.NotificationGroup)
+	require.Equal(t, defaults.DataSources, merged.DataSources)
+	require.Equal(t, map[string]string{"owner": "checkout", "routing.team": "checkout"}, merged.EntityLabels)
+	require.Equal(t, &v1beta1.AppliedDefaults{
+		Sources: []string{"checkout/defaults"},
+		Fields:  []string{"notificationGroup", "dataSources", "entityLabels.routing.team"},
+	}, applied)
+	require.Equal(t, map[string]string{"owner": "checkout"}, spec.EntityLabels, "the spec of the alert is not modified")
+
+	_, applied = merged.WithDefaults(defaults)
+	require.Nil(t, applied, "nothing is applied to an alert already setting every field")
+}
+
+func TestSLOSpecWithDefaults(t *testing.T) {
+	spec := &SLOSpec{Name: "availability"}
+	merged, applied := spec.WithDefaults(&SLODefaults{
+		Sources: []string{"checkout/defaults"},
+		Labels:  map[string]string{"owner": "checkout"},
+	})
+	require.Equal(t, &map[string]string{"owner": "checkout"}, merged.Labels)
+	require.Equal(t, &v1beta1.AppliedDefaults{Sources: []string{"checkout/defaults"}, Fields: []string{"labels.owner"}}, applied)
+	require.Nil(t, spec.Labels)
+}
+
+func setupCoralogixDefaultsTest(t *testing.T, objects ...client.Object) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, AddToScheme(scheme))
+
+	originalClient := config.GetClient()
+	originalSelector := config.GetConfig().Selector
+	t.Cleanup(func() {
+		config.InitClient(originalClient)
+		config.GetConfig().Selector = originalSelector
+	})
+
+	config.InitClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build())
+	config.GetConfig().Selector = config.Selector{}
+}
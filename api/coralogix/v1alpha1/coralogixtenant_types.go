@@ -0,0 +1,202 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CoralogixTenantSpec defines the desired state of a team onboarded to Coralogix.
+type CoralogixTenantSpec struct {
+	//+kubebuilder:validation:MinLength=1
+	// Name of the team. The Scope, CustomRole, Group and ApiKey of the tenant are named after it.
+	TeamName string `json:"teamName"`
+
+	// Description of the tenant, applied to its Scope, CustomRole and Group.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Applications and subsystems the team has access to. Without filters, no Scope is created and the team has
+	// access to all the data allowed by its role.
+	// +optional
+	Filters *CoralogixTenantFilters `json:"filters,omitempty"`
+
+	// Role of the members of the team.
+	Role CoralogixTenantRole `json:"role"`
+
+	// Members of the team.
+	// +optional
+	Members []Member `json:"members,omitempty"`
+
+	// API key owned by the team. No API key is created if not set.
+	// +optional
+	ApiKey *CoralogixTenantApiKey `json:"apiKey,omitempty"`
+}
+
+// CoralogixTenantFilters defines the data a team has access to.
+// +kubebuilder:validation:XValidation:rule="has(self.applications) || has(self.subsystems)",message="At least one of applications or subsystems must be set"
+type CoralogixTenantFilters struct {
+	// Applications of the logs and spans the team has access to.
+	// +optional
+	Applications []string `json:"applications,omitempty"`
+
+	// Subsystems of the logs and spans the team has access to.
+	// +optional
+	Subsystems []string `json:"subsystems,omitempty"`
+}
+
+// CoralogixTenantRole defines the custom role of a team.
+type CoralogixTenantRole struct {
+	// Name of the role the custom role is based on, for example `Standard User`.
+	ParentRoleName string `json:"parentRoleName"`
+
+	// +kubebuilder:validation:MinItems=1
+	// Permissions of the custom role.
+	Permissions []string `json:"permissions"`
+}
+
+// CoralogixTenantApiKey defines the API key of a team.
+// +kubebuilder:validation:XValidation:rule="has(self.presets) || has(self.permissions)",message="At least one of presets or permissions must be set"
+type CoralogixTenantApiKey struct {
+	// Team ID of the Coralogix team owning the API key.
+	TeamId uint32 `json:"teamId"`
+
+	// API key presets.
+	// +optional
+	Presets []string `json:"presets,omitempty"`
+
+	// API key permissions.
+	// +optional
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// CoralogixTenantStatus defines the observed state of CoralogixTenant.
+type CoralogixTenantStatus struct {
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Resources rendered for the tenant, and their statuses.
+	// +optional
+	Resources []CoralogixTenantResourceStatus `json:"resources,omitempty"`
+}
+
+// CoralogixTenantResourceStatus is the observed state of a resource rendered for the tenant.
+type CoralogixTenantResourceStatus struct {
+	// Kind of the resource.
+	Kind string `json:"kind"`
+
+	// Name of the resource.
+	Name string `json:"name"`
+
+	// ID of the resource in Coralogix.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Printable status of the resource, or Pending if it is not created yet.
+	// +optional
+	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// Reason the resource is not synced.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+func (t *CoralogixTenant) GetConditions() []metav1.Condition {
+	return t.Status.Conditions
+}
+
+func (t *CoralogixTenant) SetConditions(conditions []metav1.Condition) {
+	t.Status.Conditions = conditions
+}
+
+func (t *CoralogixTenant) GetPrintableStatus() string {
+	return t.Status.PrintableStatus
+}
+
+func (t *CoralogixTenant) SetPrintableStatus(printableStatus string) {
+	t.Status.PrintableStatus = printableStatus
+}
+
+func (t *CoralogixTenant) GetLastHandledReconcileAt() string {
+	return t.Status.LastHandledReconcileAt
+}
+
+func (t *CoralogixTenant) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	t.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (t *CoralogixTenant) GetNextReconcileAt() *metav1.Time {
+	return t.Status.NextReconcileAt
+}
+
+func (t *CoralogixTenant) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	t.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (t *CoralogixTenant) GetURL() string {
+	return t.Status.URL
+}
+
+func (t *CoralogixTenant) SetURL(url string) {
+	t.Status.URL = url
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Team",type="string",JSONPath=".spec.teamName"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// CoralogixTenant is the Schema for the CoralogixTenants API.
+// It onboards a team by rendering and owning its Scope, CustomRole, Group and ApiKey, which are named
+// `<tenant>-scope`, `<tenant>-role`, `<tenant>-group` and `<tenant>-api-key`.
+// The Group is created once the Scope and CustomRole are synced, and the tenant is RemoteSynced once all of its
+// resources are.
+type CoralogixTenant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CoralogixTenantSpec   `json:"spec,omitempty"`
+	Status CoralogixTenantStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CoralogixTenantList contains a list of CoralogixTenants.
+type CoralogixTenantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CoralogixTenant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CoralogixTenant{}, &CoralogixTenantList{})
+}
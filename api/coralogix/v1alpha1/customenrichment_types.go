@@ -15,13 +15,22 @@
 package v1alpha1
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
 	"fmt"
 	"hash/adler32"
+	"io"
+	"slices"
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	customenrichments "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/custom_enrichments_service"
@@ -30,7 +39,7 @@ import (
 )
 
 // CustomEnrichmentSpec defines the desired state of CustomEnrichment.
-// +kubebuilder:validation:XValidation:rule="has(self.csv) != has(self.configMapRef)", message="Exactly one of csv or configMapRef must be set"
+// +kubebuilder:validation:XValidation:rule="(has(self.csv) ? 1 : 0) + (has(self.configMapRef) ? 1 : 0) + (has(self.sources) ? 1 : 0) + (has(self.generator) ? 1 : 0) == 1", message="Exactly one of csv, configMapRef, sources or generator must be set"
 type CustomEnrichmentSpec struct {
 	// The name of the custom enrichment.
 	Name string `json:"name"`
@@ -38,28 +47,502 @@ type CustomEnrichmentSpec struct {
 	// The description of the custom enrichment.
 	Description string `json:"description"`
 
-	// Inline CSV data. Conflicts with ConfigMapRef.
+	// Inline CSV data. Conflicts with ConfigMapRef, Sources and Generator.
 	// +optional
 	CSV *string `json:"csv,omitempty"`
 
-	// Reference to a ConfigMap that contains the CSV data. Conflicts with CSV.
+	// Reference to a ConfigMap that contains the CSV data. Conflicts with CSV, Sources and Generator.
 	// +optional
 	ConfigMapRef *corev1.ConfigMapKeySelector `json:"configMapRef,omitempty"`
+
+	// Sources are ConfigMap or Secret keys holding parts of the CSV data, for tables larger than the 1 MiB limit of
+	// a ConfigMap or holding sensitive data. They are concatenated in order, and their header rows must be identical.
+	// Conflicts with CSV, ConfigMapRef and Generator.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	Sources []CustomEnrichmentSource `json:"sources,omitempty"`
+
+	// Generator builds the CSV data from the pods, nodes or namespaces of the cluster, and regenerates it when they
+	// change. Requires the operator to run with -custom-enrichment-generator. Conflicts with CSV, ConfigMapRef and
+	// Sources.
+	// +optional
+	Generator *CustomEnrichmentGenerator `json:"generator,omitempty"`
+
+	// Schema the CSV data is checked against before it is sent.
+	// +optional
+	Schema *CustomEnrichmentSchema `json:"schema,omitempty"`
 }
 
-func (c *CustomEnrichment) ExtractCreateCustomEnrichmentRequest(ctx context.Context) (*customenrichments.CreateCustomEnrichmentRequest, error) {
-	var fileContent *string
+// CustomEnrichmentSource is a key of a ConfigMap or a Secret of the namespace holding CSV data.
+// +kubebuilder:validation:XValidation:rule="has(self.configMapRef) != has(self.secretRef)", message="Exactly one of configMapRef or secretRef must be set"
+type CustomEnrichmentSource struct {
+	// ConfigMapRef is a key of the data or binaryData of a ConfigMap.
+	// +optional
+	ConfigMapRef *corev1.ConfigMapKeySelector `json:"configMapRef,omitempty"`
+
+	// SecretRef is a key of a Secret.
+	// +optional
+	SecretRef *corev1.SecretKeySelector `json:"secretRef,omitempty"`
+
+	// Gzip decompresses the data, e.g. the binaryData of a ConfigMap created with
+	// `kubectl create configmap --from-file=table.csv.gz`.
+	// +optional
+	Gzip bool `json:"gzip,omitempty"`
+}
+
+// CustomEnrichmentSchema defines the checks of the CSV data of a custom enrichment. Rows must have as many columns
+// as the header row once a schema is declared.
+type CustomEnrichmentSchema struct {
+	// Columns is the number of columns of the CSV data.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Columns *int32 `json:"columns,omitempty"`
+
+	// RequiredHeaders are the columns the header row must have.
+	// +optional
+	RequiredHeaders []string `json:"requiredHeaders,omitempty"`
+
+	// UniqueKeys rejects CSV data with duplicate values in the first column, the key of the enrichment.
+	// +optional
+	UniqueKeys bool `json:"uniqueKeys,omitempty"`
+}
+
+// CustomEnrichmentGeneratorResource is the kind of the objects a CSV is generated from.
+// +kubebuilder:validation:Enum=pods;nodes;namespaces
+type CustomEnrichmentGeneratorResource string
+
+const (
+	CustomEnrichmentGeneratorResourcePods       CustomEnrichmentGeneratorResource = "pods"
+	CustomEnrichmentGeneratorResourceNodes      CustomEnrichmentGeneratorResource = "nodes"
+	CustomEnrichmentGeneratorResourceNamespaces CustomEnrichmentGeneratorResource = "namespaces"
+)
+
+// CustomEnrichmentGenerator generates a CSV with a row for each object of a kind.
+type CustomEnrichmentGenerator struct {
+	// Resource is the kind of the objects, one row is generated for each of them. Pods which are terminated or use
+	// the host network are skipped, as their IP is not their own.
+	Resource CustomEnrichmentGeneratorResource `json:"resource"`
+
+	// Selector filters the objects by their labels.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// NamespaceSelector filters pods by the labels of their namespace.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Columns of the CSV, in order. The first one is the key of the enrichment, rows with an empty key are skipped.
+	// +kubebuilder:validation:MinItems=1
+	Columns []CustomEnrichmentColumn `json:"columns"`
+
+	// Debounce delays the regeneration after the objects change, so that bursts of changes like rollouts
+	// regenerate the CSV once.
+	// +optional
+	// +kubebuilder:default="30s"
+	Debounce *metav1.Duration `json:"debounce,omitempty"`
+}
+
+// CustomEnrichmentField is a field of the generated objects.
+// +kubebuilder:validation:Enum=name;namespace;podIP;hostIP;nodeName;internalIP;externalIP
+type CustomEnrichmentField string
+
+const (
+	// CustomEnrichmentFieldName is the name of the object.
+	CustomEnrichmentFieldName CustomEnrichmentField = "name"
+	// CustomEnrichmentFieldNamespace is the namespace of pods, and the name of namespaces.
+	CustomEnrichmentFieldNamespace CustomEnrichmentField = "namespace"
+	// CustomEnrichmentFieldPodIP is the IP of pods.
+	CustomEnrichmentFieldPodIP CustomEnrichmentField = "podIP"
+	// CustomEnrichmentFieldHostIP is the IP of the node of pods.
+	CustomEnrichmentFieldHostIP CustomEnrichmentField = "hostIP"
+	// CustomEnrichmentFieldNodeName is the name of the node of pods.
+	CustomEnrichmentFieldNodeName CustomEnrichmentField = "nodeName"
+	// CustomEnrichmentFieldInternalIP is the internal IP of nodes.
+	CustomEnrichmentFieldInternalIP CustomEnrichmentField = "internalIP"
+	// CustomEnrichmentFieldExternalIP is the external IP of nodes.
+	CustomEnrichmentFieldExternalIP CustomEnrichmentField = "externalIP"
+)
+
+var customEnrichmentFields = map[CustomEnrichmentGeneratorResource][]CustomEnrichmentField{
+	CustomEnrichmentGeneratorResourcePods: {CustomEnrichmentFieldName, CustomEnrichmentFieldNamespace,
+		CustomEnrichmentFieldPodIP, CustomEnrichmentFieldHostIP, CustomEnrichmentFieldNodeName},
+	CustomEnrichmentGeneratorResourceNodes: {CustomEnrichmentFieldName, CustomEnrichmentFieldInternalIP,
+		CustomEnrichmentFieldExternalIP},
+	CustomEnrichmentGeneratorResourceNamespaces: {CustomEnrichmentFieldName, CustomEnrichmentFieldNamespace},
+}
+
+// CustomEnrichmentColumn is a column of a generated CSV.
+// +kubebuilder:validation:XValidation:rule="(has(self.field) ? 1 : 0) + (has(self.label) ? 1 : 0) + (has(self.annotation) ? 1 : 0) + (has(self.namespaceLabel) ? 1 : 0) + (has(self.namespaceAnnotation) ? 1 : 0) == 1", message="Exactly one of field, label, annotation, namespaceLabel or namespaceAnnotation must be set"
+type CustomEnrichmentColumn struct {
+	// Name is the header of the column.
+	Name string `json:"name"`
+
+	// Field of the object.
+	// +optional
+	Field *CustomEnrichmentField `json:"field,omitempty"`
+
+	// Label of the object.
+	// +optional
+	Label *string `json:"label,omitempty"`
+
+	// Annotation of the object.
+	// +optional
+	Annotation *string `json:"annotation,omitempty"`
+
+	// NamespaceLabel is a label of the namespace of pods, or of namespaces.
+	// +optional
+	NamespaceLabel *string `json:"namespaceLabel,omitempty"`
+
+	// NamespaceAnnotation is an annotation of the namespace of pods, or of namespaces.
+	// +optional
+	NamespaceAnnotation *string `json:"namespaceAnnotation,omitempty"`
+
+	// Default is the value of the objects without the field, label or annotation.
+	// +optional
+	Default string `json:"default,omitempty"`
+}
+
+// generatedObject is an object a row of a generated CSV is built from.
+type generatedObject struct {
+	metav1.Object
+	namespace *corev1.Namespace
+	fields    map[CustomEnrichmentField]string
+}
+
+func (in *CustomEnrichmentColumn) value(obj generatedObject) string {
+	var value string
+	switch {
+	case in.Field != nil:
+		value = obj.fields[*in.Field]
+	case in.Label != nil:
+		value = obj.GetLabels()[*in.Label]
+	case in.Annotation != nil:
+		value = obj.GetAnnotations()[*in.Annotation]
+	case in.NamespaceLabel != nil && obj.namespace != nil:
+		value = obj.namespace.Labels[*in.NamespaceLabel]
+	case in.NamespaceAnnotation != nil && obj.namespace != nil:
+		value = obj.namespace.Annotations[*in.NamespaceAnnotation]
+	}
+	if value == "" {
+		return in.Default
+	}
+	return value
+}
+
+func (in *CustomEnrichmentGenerator) validate() error {
+	for _, column := range in.Columns {
+		if column.Field != nil && !slices.Contains(customEnrichmentFields[in.Resource], *column.Field) {
+			return fmt.Errorf("column %q: field %q is not supported for %s", column.Name, *column.Field, in.Resource)
+		}
+		if (column.NamespaceLabel != nil || column.NamespaceAnnotation != nil) && in.Resource == CustomEnrichmentGeneratorResourceNodes {
+			return fmt.Errorf("column %q: nodes have no namespace", column.Name)
+		}
+	}
+	if in.NamespaceSelector != nil && in.Resource != CustomEnrichmentGeneratorResourcePods {
+		return fmt.Errorf("namespaceSelector is only supported for pods")
+	}
+	return nil
+}
+
+// Generate returns the CSV of the objects of the cluster, with a header row and the rows sorted.
+func (in *CustomEnrichmentGenerator) Generate(ctx context.Context) (string, error) {
+	if err := in.validate(); err != nil {
+		return "", err
+	}
+	objects, err := in.listObjects(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	rows := make([][]string, 0, len(objects))
+	for _, obj := range objects {
+		row := make([]string, len(in.Columns))
+		for i, column := range in.Columns {
+			row[i] = column.value(obj)
+		}
+		if row[0] != "" {
+			rows = append(rows, row)
+		}
+	}
+	slices.SortFunc(rows, slices.Compare)
+
+	header := make([]string, len(in.Columns))
+	for i, column := range in.Columns {
+		header[i] = column.Name
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return "", err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (in *CustomEnrichmentGenerator) listObjects(ctx context.Context) ([]generatedObject, error) {
+	var listOptions []client.ListOption
+	if in.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(in.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector: %w", err)
+		}
+		listOptions = append(listOptions, client.MatchingLabelsSelector{Selector: selector})
+	}
+
+	switch in.Resource {
+	case CustomEnrichmentGeneratorResourceNodes:
+		var nodeList corev1.NodeList
+		if err := config.GetClient().List(ctx, &nodeList, listOptions...); err != nil {
+			return nil, fmt.Errorf("error listing nodes: %w", err)
+		}
+		objects := make([]generatedObject, 0, len(nodeList.Items))
+		for i := range nodeList.Items {
+			node := &nodeList.Items[i]
+			fields := map[CustomEnrichmentField]string{CustomEnrichmentFieldName: node.Name}
+			for _, address := range node.Status.Addresses {
+				switch address.Type {
+				case corev1.NodeInternalIP:
+					fields[CustomEnrichmentFieldInternalIP] = address.Address
+				case corev1.NodeExternalIP:
+					fields[CustomEnrichmentFieldExternalIP] = address.Address
+				}
+			}
+			objects = append(objects, generatedObject{Object: node, fields: fields})
+		}
+		return objects, nil
+
+	case CustomEnrichmentGeneratorResourceNamespaces:
+		var namespaceList corev1.NamespaceList
+		if err := config.GetClient().List(ctx, &namespaceList, listOptions...); err != nil {
+			return nil, fmt.Errorf("error listing namespaces: %w", err)
+		}
+		objects := make([]generatedObject, 0, len(namespaceList.Items))
+		for i := range namespaceList.Items {
+			namespace := &namespaceList.Items[i]
+			objects = append(objects, generatedObject{Object: namespace, namespace: namespace, fields: map[CustomEnrichmentField]string{
+				CustomEnrichmentFieldName:      namespace.Name,
+				CustomEnrichmentFieldNamespace: namespace.Name,
+			}})
+		}
+		return objects, nil
+
+	case CustomEnrichmentGeneratorResourcePods:
+		namespaceSelector := labels.Everything()
+		if in.NamespaceSelector != nil {
+			var err error
+			if namespaceSelector, err = metav1.LabelSelectorAsSelector(in.NamespaceSelector); err != nil {
+				return nil, fmt.Errorf("invalid namespaceSelector: %w", err)
+			}
+		}
+		var namespaceList corev1.NamespaceList
+		if err := config.GetClient().List(ctx, &namespaceList); err != nil {
+			return nil, fmt.Errorf("error listing namespaces: %w", err)
+		}
+		namespaces := make(map[string]*corev1.Namespace, len(namespaceList.Items))
+		for i := range namespaceList.Items {
+			namespaces[namespaceList.Items[i].Name] = &namespaceList.Items[i]
+		}
+
+		var podList corev1.PodList
+		if err := config.GetClient().List(ctx, &podList, listOptions...); err != nil {
+			return nil, fmt.Errorf("error listing pods: %w", err)
+		}
+		objects := make([]generatedObject, 0, len(podList.Items))
+		for i := range podList.Items {
+			pod := &podList.Items[i]
+			namespace := namespaces[pod.Namespace]
+			if namespace == nil || !namespaceSelector.Matches(labels.Set(namespace.Labels)) ||
+				pod.Spec.HostNetwork || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+			objects = append(objects, generatedObject{Object: pod, namespace: namespace, fields: map[CustomEnrichmentField]string{
+				CustomEnrichmentFieldName:      pod.Name,
+				CustomEnrichmentFieldNamespace: pod.Namespace,
+				CustomEnrichmentFieldPodIP:     pod.Status.PodIP,
+				CustomEnrichmentFieldHostIP:    pod.Status.HostIP,
+				CustomEnrichmentFieldNodeName:  pod.Spec.NodeName,
+			}})
+		}
+		return objects, nil
+	}
+	return nil, fmt.Errorf("unsupported generator resource %q", in.Resource)
+}
+
+// CustomEnrichmentContentHash returns the hash of the name, description and CSV data of a custom enrichment, used to
+// update the remote custom enrichment of a generator only when they change.
+func CustomEnrichmentContentHash(name, description, content string) string {
+	sum := sha256.Sum256([]byte(name + "\x00" + description + "\x00" + content))
+	return hex.EncodeToString(sum[:8])
+}
 
+func (c *CustomEnrichment) fileContent(ctx context.Context) (*string, error) {
+	var content string
 	if c.Spec.CSV != nil {
-		fileContent = c.Spec.CSV
+		content = *c.Spec.CSV
 	} else if c.Spec.ConfigMapRef != nil {
 		cmContext, err := readConfigMap(ctx, *c.Spec.ConfigMapRef, c.Namespace)
 		if err != nil {
 			return nil, fmt.Errorf("failed to read configmap: %w", err)
 		}
-		fileContent = &cmContext
+		content = cmContext
+	} else if len(c.Spec.Sources) > 0 {
+		concatenated, err := concatenateSources(ctx, c.Spec.Sources, c.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		content = concatenated
+	} else if c.Spec.Generator != nil {
+		generated, err := c.Spec.Generator.Generate(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate csv: %w", err)
+		}
+		content = generated
 	} else {
-		return nil, fmt.Errorf("either CSV or ConfigMapRef must be provided")
+		return nil, fmt.Errorf("one of CSV, ConfigMapRef, Sources or Generator must be provided")
+	}
+
+	if c.Spec.Schema != nil {
+		if err := c.Spec.Schema.validate(content); err != nil {
+			return nil, fmt.Errorf("invalid csv: %w", err)
+		}
+	}
+	return &content, nil
+}
+
+// concatenateSources returns the CSV data of the sources, with the header row of the first one.
+func concatenateSources(ctx context.Context, sources []CustomEnrichmentSource, namespace string) (string, error) {
+	var header []string
+	var rows [][]string
+	for i, source := range sources {
+		content, err := readSource(ctx, source, namespace)
+		if err != nil {
+			return "", fmt.Errorf("failed to read source %d: %w", i, err)
+		}
+		reader := csv.NewReader(strings.NewReader(content))
+		reader.FieldsPerRecord = -1
+		records, err := reader.ReadAll()
+		if err != nil {
+			return "", fmt.Errorf("failed to parse source %d: %w", i, err)
+		}
+		if len(records) == 0 {
+			return "", fmt.Errorf("source %d has no header row", i)
+		}
+		if i == 0 {
+			header = records[0]
+		} else if !slices.Equal(header, records[0]) {
+			return "", fmt.Errorf("header row of source %d %q differs from the header row of source 0 %q", i, records[0], header)
+		}
+		rows = append(rows, records[1:]...)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return "", err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func readSource(ctx context.Context, source CustomEnrichmentSource, namespace string) (string, error) {
+	var data []byte
+	if ref := source.ConfigMapRef; ref != nil {
+		cm := &corev1.ConfigMap{}
+		if err := config.GetClient().Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, cm); err != nil {
+			return "", err
+		}
+		if content, ok := cm.Data[ref.Key]; ok {
+			data = []byte(content)
+		} else if content, ok := cm.BinaryData[ref.Key]; ok {
+			data = content
+		} else {
+			return "", fmt.Errorf("cannot find key '%v' in config map '%v'", ref.Key, ref.Name)
+		}
+	} else if ref := source.SecretRef; ref != nil {
+		secret := &corev1.Secret{}
+		if err := config.GetClient().Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+			return "", err
+		}
+		content, ok := secret.Data[ref.Key]
+		if !ok {
+			return "", fmt.Errorf("cannot find key '%v' in secret '%v'", ref.Key, ref.Name)
+		}
+		data = content
+	} else {
+		return "", fmt.Errorf("either configMapRef or secretRef must be provided")
+	}
+
+	if source.Gzip {
+		unzipped, err := Unzip(data)
+		if err != nil {
+			return "", fmt.Errorf("failed to gunzip: %w", err)
+		}
+		data = unzipped
+	}
+	return string(data), nil
+}
+
+func (in *CustomEnrichmentSchema) validate(content string) error {
+	records, err := csv.NewReader(strings.NewReader(content)).ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("no header row")
+	}
+
+	header := records[0]
+	if in.Columns != nil && len(header) != int(*in.Columns) {
+		return fmt.Errorf("expected %d columns, got %d", *in.Columns, len(header))
+	}
+	var missing []string
+	for _, required := range in.RequiredHeaders {
+		if !slices.Contains(header, required) {
+			missing = append(missing, required)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required headers: %s", strings.Join(missing, ", "))
+	}
+	if in.UniqueKeys {
+		rows := make(map[string]int, len(records)-1)
+		for i, record := range records[1:] {
+			if previous, ok := rows[record[0]]; ok {
+				return fmt.Errorf("duplicate key %q in rows %d and %d", record[0], previous, i+1)
+			}
+			rows[record[0]] = i + 1
+		}
+	}
+	return nil
+}
+
+// CustomEnrichmentRowCount returns the number of rows of the CSV data, without its header row, or nil when it
+// cannot be parsed.
+func CustomEnrichmentRowCount(content string) *int32 {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	rows := int32(-1)
+	for {
+		if _, err := reader.Read(); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil
+		}
+		rows++
+	}
+	return ptr.To(max(rows, 0))
+}
+
+func (c *CustomEnrichment) ExtractCreateCustomEnrichmentRequest(ctx context.Context) (*customenrichments.CreateCustomEnrichmentRequest, error) {
+	fileContent, err := c.fileContent(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	h := adler32.New()
@@ -89,17 +572,9 @@ func (c *CustomEnrichment) ExtractUpdateCustomEnrichmentRequest(ctx context.Cont
 		return nil, fmt.Errorf("failed to convert ID to UInt32: %w", err)
 	}
 
-	var fileContent *string
-	if c.Spec.CSV != nil {
-		fileContent = c.Spec.CSV
-	} else if c.Spec.ConfigMapRef != nil {
-		cmContext, err := readConfigMap(ctx, *c.Spec.ConfigMapRef, c.Namespace)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read configmap: %w", err)
-		}
-		fileContent = &cmContext
-	} else {
-		return nil, fmt.Errorf("either CSV or ConfigMapRef must be provided")
+	fileContent, err := c.fileContent(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	h := adler32.New()
@@ -143,6 +618,27 @@ type CustomEnrichmentStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// ContentHash is the hash of the name, description and CSV data last sent for a generator.
+	// +optional
+	ContentHash string `json:"contentHash,omitempty"`
+
+	// RowCount is the number of rows of the CSV data last sent, without its header row.
+	// +optional
+	RowCount *int32 `json:"rowCount,omitempty"`
 }
 
 func (c *CustomEnrichment) GetConditions() []metav1.Condition {
@@ -161,6 +657,30 @@ func (c *CustomEnrichment) SetPrintableStatus(printableStatus string) {
 	c.Status.PrintableStatus = printableStatus
 }
 
+func (c *CustomEnrichment) GetLastHandledReconcileAt() string {
+	return c.Status.LastHandledReconcileAt
+}
+
+func (c *CustomEnrichment) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	c.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (c *CustomEnrichment) GetNextReconcileAt() *metav1.Time {
+	return c.Status.NextReconcileAt
+}
+
+func (c *CustomEnrichment) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	c.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (c *CustomEnrichment) GetURL() string {
+	return c.Status.URL
+}
+
+func (c *CustomEnrichment) SetURL(url string) {
+	c.Status.URL = url
+}
+
 func (c *CustomEnrichment) HasIDInStatus() bool {
 	return c.Status.Id != nil && *c.Status.Id != ""
 }
@@ -168,6 +688,8 @@ func (c *CustomEnrichment) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="Rows",type="integer",JSONPath=".status.rowCount"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // CustomEnrichment is the Schema for the customenrichments API.
@@ -0,0 +1,214 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestCustomEnrichmentGeneratorPods(t *testing.T) {
+	useFakeClient(t,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "payments",
+			Labels: map[string]string{"team": "payments", "tier": "production"},
+		}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "sandbox",
+			Labels: map[string]string{"team": "platform"},
+		}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "checkout-1",
+				Namespace:   "payments",
+				Annotations: map[string]string{"example.com/owner": "alice, bob"},
+			},
+			Spec:   corev1.PodSpec{NodeName: "node-1"},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.2"},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "checkout-0", Namespace: "payments"},
+			Spec:       corev1.PodSpec{NodeName: "node-2"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.1"},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "payments"},
+			Status:     corev1.PodStatus{Phase: corev1.PodPending},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "migration", Namespace: "payments"},
+			Status:     corev1.PodStatus{Phase: corev1.PodSucceeded, PodIP: "10.0.0.3"},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-exporter", Namespace: "payments"},
+			Spec:       corev1.PodSpec{HostNetwork: true},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "192.168.0.1"},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "playground", Namespace: "sandbox"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.1.1"},
+		},
+	)
+
+	generator := &CustomEnrichmentGenerator{
+		Resource:          CustomEnrichmentGeneratorResourcePods,
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "production"}},
+		Columns: []CustomEnrichmentColumn{
+			{Name: "ip", Field: ptr.To(CustomEnrichmentFieldPodIP)},
+			{Name: "pod", Field: ptr.To(CustomEnrichmentFieldName)},
+			{Name: "node", Field: ptr.To(CustomEnrichmentFieldNodeName)},
+			{Name: "team", NamespaceLabel: ptr.To("team")},
+			{Name: "owner", Annotation: ptr.To("example.com/owner"), Default: "unknown"},
+		},
+	}
+	csv, err := generator.Generate(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, `ip,pod,node,team,owner
+10.0.0.1,checkout-0,node-2,payments,unknown
+10.0.0.2,checkout-1,node-1,payments,"alice, bob"
+`, csv)
+}
+
+func TestCustomEnrichmentGeneratorNodes(t *testing.T) {
+	useFakeClient(t, &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"topology.kubernetes.io/zone": "eu-west-1a"}},
+		Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+			{Type: corev1.NodeHostName, Address: "node-1"},
+			{Type: corev1.NodeInternalIP, Address: "192.168.0.1"},
+		}},
+	})
+
+	generator := &CustomEnrichmentGenerator{
+		Resource: CustomEnrichmentGeneratorResourceNodes,
+		Columns: []CustomEnrichmentColumn{
+			{Name: "ip", Field: ptr.To(CustomEnrichmentFieldInternalIP)},
+			{Name: "zone", Label: ptr.To("topology.kubernetes.io/zone")},
+		},
+	}
+	csv, err := generator.Generate(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "ip,zone\n192.168.0.1,eu-west-1a\n", csv)
+
+	generator.Columns = append(generator.Columns, CustomEnrichmentColumn{Name: "ip", Field: ptr.To(CustomEnrichmentFieldPodIP)})
+	_, err = generator.Generate(context.Background())
+	require.EqualError(t, err, `column "ip": field "podIP" is not supported for nodes`)
+
+	generator.Columns = []CustomEnrichmentColumn{{Name: "team", NamespaceLabel: ptr.To("team")}}
+	_, err = generator.Generate(context.Background())
+	require.EqualError(t, err, `column "team": nodes have no namespace`)
+}
+
+func TestCustomEnrichmentContentHash(t *testing.T) {
+	hash := CustomEnrichmentContentHash("inventory", "pods", "ip\n10.0.0.1\n")
+	require.Equal(t, hash, CustomEnrichmentContentHash("inventory", "pods", "ip\n10.0.0.1\n"))
+	require.NotEqual(t, hash, CustomEnrichmentContentHash("inventory", "pods", "ip\n10.0.0.2\n"))
+}
+
+func TestCustomEnrichmentSources(t *testing.T) {
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, err := gz.Write([]byte("ip,customer\n10.0.0.2,\"Acme, Inc.\"\n"))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	useFakeClient(t,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "customers", Namespace: "default"},
+			Data:       map[string]string{"part-1.csv": "ip,customer\n10.0.0.1,Globex\n"},
+			BinaryData: map[string][]byte{"part-2.csv.gz": compressed.Bytes()},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "customers", Namespace: "default"},
+			Data: map[string][]byte{
+				"part-3.csv": []byte("ip,customer\n10.0.0.3,Initech\n"),
+				"other.csv":  []byte("ip,owner\n10.0.0.4,Hooli\n"),
+			},
+		},
+	)
+
+	customEnrichment := &CustomEnrichment{
+		ObjectMeta: metav1.ObjectMeta{Name: "customers", Namespace: "default"},
+		Spec: CustomEnrichmentSpec{
+			Sources: []CustomEnrichmentSource{
+				{ConfigMapRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "customers"}, Key: "part-1.csv"}},
+				{ConfigMapRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "customers"}, Key: "part-2.csv.gz"}, Gzip: true},
+				{SecretRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "customers"}, Key: "part-3.csv"}},
+			},
+		},
+	}
+	content, err := customEnrichment.fileContent(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "ip,customer\n10.0.0.1,Globex\n10.0.0.2,\"Acme, Inc.\"\n10.0.0.3,Initech\n", *content)
+	require.Equal(t, ptr.To(int32(3)), CustomEnrichmentRowCount(*content))
+
+	customEnrichment.Spec.Sources[2].SecretRef.Key = "other.csv"
+	_, err = customEnrichment.fileContent(context.Background())
+	require.ErrorContains(t, err, `header row of source 2 ["ip" "owner"] differs from the header row of source 0 ["ip" "customer"]`)
+}
+
+func TestCustomEnrichmentSchema(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		csv           string
+		schema        CustomEnrichmentSchema
+		expectedError string
+	}{
+		{
+			name:   "valid",
+			csv:    "ip,team\n10.0.0.1,payments\n10.0.0.2,payments\n",
+			schema: CustomEnrichmentSchema{Columns: ptr.To(int32(2)), RequiredHeaders: []string{"ip"}, UniqueKeys: true},
+		},
+		{
+			name:          "column count",
+			csv:           "ip,team\n10.0.0.1,payments\n",
+			schema:        CustomEnrichmentSchema{Columns: ptr.To(int32(3))},
+			expectedError: "expected 3 columns, got 2",
+		},
+		{
+			name:          "row with another number of columns",
+			csv:           "ip,team\n10.0.0.1,payments,extra\n",
+			expectedError: "wrong number of fields",
+		},
+		{
+			name:          "missing required headers",
+			csv:           "ip,team\n10.0.0.1,payments\n",
+			schema:        CustomEnrichmentSchema{RequiredHeaders: []string{"ip", "owner", "region"}},
+			expectedError: "missing required headers: owner, region",
+		},
+		{
+			name:          "duplicate keys",
+			csv:           "ip,team\n10.0.0.1,payments\n10.0.0.2,payments\n10.0.0.1,platform\n",
+			schema:        CustomEnrichmentSchema{UniqueKeys: true},
+			expectedError: `duplicate key "10.0.0.1" in rows 1 and 3`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			customEnrichment := &CustomEnrichment{Spec: CustomEnrichmentSpec{CSV: ptr.To(tc.csv), Schema: &tc.schema}}
+			_, err := customEnrichment.fileContent(context.Background())
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -63,6 +63,19 @@ type CustomRoleStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (c *CustomRole) GetConditions() []metav1.Condition {
@@ -81,6 +94,30 @@ func (c *CustomRole) SetPrintableStatus(printableStatus string) {
 	c.Status.PrintableStatus = printableStatus
 }
 
+func (c *CustomRole) GetLastHandledReconcileAt() string {
+	return c.Status.LastHandledReconcileAt
+}
+
+func (c *CustomRole) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	c.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (c *CustomRole) GetNextReconcileAt() *metav1.Time {
+	return c.Status.NextReconcileAt
+}
+
+func (c *CustomRole) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	c.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (c *CustomRole) GetURL() string {
+	return c.Status.URL
+}
+
+func (c *CustomRole) SetURL(url string) {
+	c.Status.URL = url
+}
+
 func (c *CustomRole) HasIDInStatus() bool {
 	return c.Status.ID != nil && *c.Status.ID != ""
 }
@@ -88,6 +125,7 @@ func (c *CustomRole) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // CustomRole is the Schema for the CustomRoles API.
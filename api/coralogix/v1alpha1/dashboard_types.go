@@ -30,11 +30,13 @@ import (
 	dashboards "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/dashboard_service"
 
 	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/grafanaconvert"
 )
 
 // DashboardSpec defines the desired state of Dashboard.
 // See also https://coralogix.com/docs/user-guides/custom-dashboards/getting-started/
 // +kubebuilder:validation:XValidation:rule="!(has(self.json) && has(self.configMapRef))", message="Only one of json or configMapRef can be declared at the same time"
+// +kubebuilder:validation:XValidation:rule="[has(self.json), has(self.gzipJson), has(self.configMapRef), has(self.grafanaJson), has(self.grafanaConfigMapRef), has(self.templateRef)].filter(x, x).size() <= 1", message="Only one of json, gzipJson, configMapRef, grafanaJson, grafanaConfigMapRef or templateRef can be declared at the same time"
 type DashboardSpec struct {
 	// JSON string representing the access policy for this dashboard. Defines granular permissions for users and groups.
 	// +optional
@@ -47,6 +49,18 @@ type DashboardSpec struct {
 	// model from configmap
 	//+optional
 	ConfigMapRef *v1.ConfigMapKeySelector `json:"configMapRef,omitempty"`
+	// GrafanaJson is a Grafana dashboard JSON, converted into a Coralogix dashboard. The panels that cannot be
+	// converted are listed in status.unsupportedPanels.
+	// +optional
+	GrafanaJson *string `json:"grafanaJson,omitempty"`
+	// GrafanaConfigMapRef is a Grafana dashboard JSON from a ConfigMap, e.g. one provisioned for the Grafana
+	// sidecar, converted like grafanaJson.
+	// +optional
+	GrafanaConfigMapRef *v1.ConfigMapKeySelector `json:"grafanaConfigMapRef,omitempty"`
+	// TemplateRef renders the dashboard from a DashboardTemplate of the namespace. The revision of the template it
+	// was rendered from is recorded in status.templateRevision.
+	// +optional
+	TemplateRef *DashboardTemplateRef `json:"templateRef,omitempty"`
 	// +optional
 	FolderRef *DashboardFolderRef `json:"folderRef,omitempty"`
 }
@@ -63,7 +77,27 @@ type DashboardFolderRef struct {
 }
 
 func (in *DashboardSpec) ExtractDashboardFromSpec(ctx context.Context, namespace string) (*dashboards.Dashboard, error) {
-	contentJson, err := ExtractJsonContentFromSpec(ctx, namespace, in)
+	extracted, err := in.ExtractDashboardContentFromSpec(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return extracted.Dashboard, nil
+}
+
+// ExtractedDashboard is the dashboard of a spec, with what is recorded in the status about where it came from.
+type ExtractedDashboard struct {
+	Dashboard *dashboards.Dashboard
+	// UnsupportedPanels are the parts of a Grafana dashboard that could not be converted.
+	UnsupportedPanels []string
+	// TemplateRevision is the revision of the DashboardTemplate the dashboard was rendered from.
+	TemplateRevision string
+}
+
+// ExtractDashboardContentFromSpec is ExtractDashboardFromSpec also returning the unsupported panels of a Grafana
+// dashboard and the revision of a DashboardTemplate.
+func (in *DashboardSpec) ExtractDashboardContentFromSpec(ctx context.Context, namespace string) (*ExtractedDashboard, error) {
+	extracted := &ExtractedDashboard{}
+	contentJson, err := extractJsonContentFromSpec(ctx, namespace, in, extracted)
 	if err != nil {
 		return nil, err
 	}
@@ -73,12 +107,12 @@ func (in *DashboardSpec) ExtractDashboardFromSpec(ctx context.Context, namespace
 		return nil, fmt.Errorf("failed to unmarshal contentJson: %w", err)
 	}
 
-	dashboard, err = expandDashboardFolder(ctx, namespace, in, dashboard)
+	extracted.Dashboard, err = expandDashboardFolder(ctx, namespace, in, dashboard)
 	if err != nil {
 		return nil, err
 	}
 
-	return dashboard, nil
+	return extracted, nil
 }
 
 func expandDashboardFolder(ctx context.Context, namespace string, in *DashboardSpec, dashboard *dashboards.Dashboard) (*dashboards.Dashboard, error) {
@@ -111,6 +145,10 @@ func expandDashboardFolder(ctx context.Context, namespace string, in *DashboardS
 }
 
 func ExtractJsonContentFromSpec(ctx context.Context, namespace string, in *DashboardSpec) (string, error) {
+	return extractJsonContentFromSpec(ctx, namespace, in, &ExtractedDashboard{})
+}
+
+func extractJsonContentFromSpec(ctx context.Context, namespace string, in *DashboardSpec, extracted *ExtractedDashboard) (string, error) {
 	if json := in.Json; json != nil {
 		return *json, nil
 	} else if gzipJson := in.GzipJson; gzipJson != nil {
@@ -120,18 +158,51 @@ func ExtractJsonContentFromSpec(ctx context.Context, namespace string, in *Dashb
 		}
 		return string(content), nil
 	} else if configMapRef := in.ConfigMapRef; configMapRef != nil {
-		dashboardConfigMap := &v1.ConfigMap{}
-		if err := config.GetClient().Get(ctx, client.ObjectKey{Namespace: namespace, Name: configMapRef.Name}, dashboardConfigMap); err != nil {
+		return getConfigMapKeyContent(ctx, namespace, configMapRef)
+	} else if grafanaJson := in.GrafanaJson; grafanaJson != nil {
+		return convertGrafanaDashboard(*grafanaJson, extracted)
+	} else if grafanaConfigMapRef := in.GrafanaConfigMapRef; grafanaConfigMapRef != nil {
+		content, err := getConfigMapKeyContent(ctx, namespace, grafanaConfigMapRef)
+		if err != nil {
+			return "", err
+		}
+		return convertGrafanaDashboard(content, extracted)
+	} else if templateRef := in.TemplateRef; templateRef != nil {
+		template, err := GetDashboardTemplate(ctx, namespace, templateRef.Name)
+		if err != nil {
 			return "", err
 		}
-		if content, ok := dashboardConfigMap.Data[configMapRef.Key]; ok {
-			return content, nil
+		content, revision, err := template.Spec.Render(ctx, namespace, templateRef.Values)
+		if err != nil {
+			return "", fmt.Errorf("failed to render DashboardTemplate %s: %w", templateRef.Name, err)
 		}
+		extracted.TemplateRevision = revision
+		return content, nil
+	}
+
+	return "", fmt.Errorf("json, gzipContentJson, configMapRef, grafanaJson, grafanaConfigMapRef or templateRef is required")
+}
 
-		return "", fmt.Errorf("cannot find key '%v' in config map '%v'", configMapRef.Key, configMapRef.Name)
+// getConfigMapKeyContent returns the value of a key of a ConfigMap in the namespace of the resource referencing it.
+func getConfigMapKeyContent(ctx context.Context, namespace string, configMapRef *v1.ConfigMapKeySelector) (string, error) {
+	configMap := &v1.ConfigMap{}
+	if err := config.GetClient().Get(ctx, client.ObjectKey{Namespace: namespace, Name: configMapRef.Name}, configMap); err != nil {
+		return "", err
 	}
+	if content, ok := configMap.Data[configMapRef.Key]; ok {
+		return content, nil
+	}
+
+	return "", fmt.Errorf("cannot find key '%v' in config map '%v'", configMapRef.Key, configMapRef.Name)
+}
 
-	return "", fmt.Errorf("json, gzipContentJson or configMapRef is required")
+func convertGrafanaDashboard(content string, extracted *ExtractedDashboard) (string, error) {
+	result, err := grafanaconvert.Convert([]byte(content))
+	if err != nil {
+		return "", err
+	}
+	extracted.UnsupportedPanels = result.Unsupported
+	return string(result.Dashboard), nil
 }
 
 func Unzip(compressed []byte) ([]byte, error) {
@@ -163,12 +234,33 @@ type DashboardStatus struct {
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
 
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
+
 	// Imported records that this Dashboard was already adopted via the import annotation once.
 	// It is set the first time adoption succeeds and, unlike status.id, is not cleared if the
 	// remote dashboard is later deleted outside the operator - so a subsequent reconcile
 	// recreates it from spec instead of retrying the import Get for an id that no longer exists.
 	// +optional
 	Imported bool `json:"imported,omitempty"`
+
+	// UnsupportedPanels lists the panels, targets and variables of a Grafana dashboard that could not be converted.
+	// +optional
+	UnsupportedPanels []string `json:"unsupportedPanels,omitempty"`
+
+	// TemplateRevision is the revision of the DashboardTemplate the dashboard was last rendered from.
+	// +optional
+	TemplateRevision string `json:"templateRevision,omitempty"`
 }
 
 func (d *Dashboard) GetConditions() []metav1.Condition {
@@ -187,6 +279,30 @@ func (d *Dashboard) SetPrintableStatus(printableStatus string) {
 	d.Status.PrintableStatus = printableStatus
 }
 
+func (d *Dashboard) GetLastHandledReconcileAt() string {
+	return d.Status.LastHandledReconcileAt
+}
+
+func (d *Dashboard) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	d.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (d *Dashboard) GetNextReconcileAt() *metav1.Time {
+	return d.Status.NextReconcileAt
+}
+
+func (d *Dashboard) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	d.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (d *Dashboard) GetURL() string {
+	return d.Status.URL
+}
+
+func (d *Dashboard) SetURL(url string) {
+	d.Status.URL = url
+}
+
 func (d *Dashboard) HasIDInStatus() bool {
 	return d.Status.ID != nil && *d.Status.ID != ""
 }
@@ -196,6 +312,7 @@ func (d *Dashboard) HasIDInStatus() bool {
 // +kubebuilder:conversion:hub
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Dashboard is the Schema for the dashboards API.
@@ -16,6 +16,7 @@ package v1alpha1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -149,6 +150,60 @@ func TestExtractDashboardFromSpecReadsConfigMapRef(t *testing.T) {
 	require.Equal(t, "OpenTelemetry Collector Dashboard", dashboard.Name)
 }
 
+func TestExtractDashboardFromSpecConvertsGrafanaConfigMapRef(t *testing.T) {
+	documents := sampleDocuments(t, "dashboard-grafana.yaml")
+	require.Len(t, documents, 2, "sample is expected to hold a Dashboard and its ConfigMap")
+
+	sample := new(Dashboard)
+	require.NoError(t, yaml.Unmarshal([]byte(documents[0]), sample))
+	configMap := new(corev1.ConfigMap)
+	require.NoError(t, yaml.Unmarshal([]byte(documents[1]), configMap))
+	configMap.Namespace = "default"
+	useFakeClient(t, configMap)
+
+	extracted, err := sample.Spec.ExtractDashboardContentFromSpec(context.Background(), "default")
+	require.NoError(t, err)
+
+	dashboard := extracted.Dashboard
+	require.Equal(t, "CoreDNS", dashboard.Name)
+	require.Equal(t, []string{`panel "Request duration": type heatmap is not supported`}, extracted.UnsupportedPanels)
+	require.Len(t, dashboard.Layout.Sections, 2)
+	require.NotNil(t, dashboard.Layout.Sections[0].Rows[0].Widgets[0].Definition.Gauge)
+	require.NotNil(t, dashboard.Layout.Sections[0].Rows[0].Widgets[1].Definition.Markdown)
+	requests := dashboard.Layout.Sections[1]
+	require.NotNil(t, requests.Rows[0].Widgets[0].Definition.LineChart)
+	require.NotNil(t, requests.Rows[0].Widgets[1].Definition.LineChart)
+	require.NotNil(t, requests.Rows[1].Widgets[0].Definition.DataTable)
+	require.Len(t, dashboard.Variables, 1)
+}
+
+func TestExtractDashboardFromSpecRendersTemplateRef(t *testing.T) {
+	documents := sampleDocuments(t, "dashboard-template.yaml")
+	require.Len(t, documents, 2, "sample is expected to hold a DashboardTemplate and a Dashboard rendering it")
+
+	template := new(DashboardTemplate)
+	require.NoError(t, yaml.Unmarshal([]byte(documents[0]), template))
+	template.Namespace = "default"
+	sample := new(Dashboard)
+	require.NoError(t, yaml.Unmarshal([]byte(documents[1]), sample))
+	useFakeClient(t, template)
+
+	extracted, err := sample.Spec.ExtractDashboardContentFromSpec(context.Background(), "default")
+	require.NoError(t, err)
+
+	dashboard := extracted.Dashboard
+	require.Equal(t, "checkout / api overview", dashboard.Name)
+	rendered, err := json.Marshal(dashboard)
+	require.NoError(t, err)
+	require.Contains(t, string(rendered), `http_requests_total{cluster=\"staging\", service=\"api\"}`, "string values are escaped")
+	require.Contains(t, string(rendered), `"from":10`, "number values are not quoted")
+	require.Contains(t, string(rendered), `"values":["checkout"]`)
+
+	revision, err := template.Spec.Revision(context.Background(), "default")
+	require.NoError(t, err)
+	require.Equal(t, revision, extracted.TemplateRevision)
+}
+
 func TestExtractDashboardFromSpecExpandsFolderBackendRef(t *testing.T) {
 	json := `{"name": "test", "layout": {"sections": []}}`
 	folderID := "3d7f1c2a-9e4b-4a11-8f2d-1a2b3c4d5e6f"
@@ -273,5 +328,5 @@ func TestExtractDashboardFromSpecRejectsOutOfSpecEnums(t *testing.T) {
 func TestExtractDashboardFromSpecRequiresAContentSource(t *testing.T) {
 	_, err := (&DashboardSpec{}).ExtractDashboardFromSpec(context.Background(), "default")
 
-	require.ErrorContains(t, err, "json, gzipContentJson or configMapRef is required")
+	require.ErrorContains(t, err, "json, gzipContentJson, configMapRef, grafanaJson, grafanaConfigMapRef or templateRef is required")
 }
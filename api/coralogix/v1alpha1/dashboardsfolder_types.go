@@ -94,6 +94,19 @@ type DashboardsFolderStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (df *DashboardsFolder) GetConditions() []metav1.Condition {
@@ -112,6 +125,30 @@ func (df *DashboardsFolder) SetPrintableStatus(printableStatus string) {
 	df.Status.PrintableStatus = printableStatus
 }
 
+func (df *DashboardsFolder) GetLastHandledReconcileAt() string {
+	return df.Status.LastHandledReconcileAt
+}
+
+func (df *DashboardsFolder) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	df.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (df *DashboardsFolder) GetNextReconcileAt() *metav1.Time {
+	return df.Status.NextReconcileAt
+}
+
+func (df *DashboardsFolder) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	df.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (df *DashboardsFolder) GetURL() string {
+	return df.Status.URL
+}
+
+func (df *DashboardsFolder) SetURL(url string) {
+	df.Status.URL = url
+}
+
 func (df *DashboardsFolder) HasIDInStatus() bool {
 	return df.Status.ID != nil && *df.Status.ID != ""
 }
@@ -119,6 +156,7 @@ func (df *DashboardsFolder) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // DashboardsFolder is the Schema for the DashboardsFolders API.
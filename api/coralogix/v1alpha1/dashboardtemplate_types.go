@@ -0,0 +1,309 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+)
+
+// DashboardTemplateLabelKey is the label of the Dashboards generated from a DashboardTemplate, set to its name.
+const DashboardTemplateLabelKey = "app.coralogix.com/dashboard-template"
+
+// DashboardTemplateSpec defines a dashboard JSON with parameters.
+// +kubebuilder:validation:XValidation:rule="has(self.json) != has(self.configMapRef)", message="Exactly one of json or configMapRef is required"
+type DashboardTemplateSpec struct {
+	// Json is the dashboard JSON, in the format of Dashboard.spec.json, where `${name}` is replaced by the value of
+	// the parameter `name`. Placeholders of undeclared parameters are left untouched.
+	// +optional
+	Json *string `json:"json,omitempty"`
+
+	// ConfigMapRef is the dashboard JSON from a ConfigMap of the namespace, like json.
+	// +optional
+	ConfigMapRef *v1.ConfigMapKeySelector `json:"configMapRef,omitempty"`
+
+	// Parameters of the template.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Parameters []DashboardTemplateParameter `json:"parameters,omitempty"`
+
+	// Generator creates a Dashboard rendered from the template for each selected namespace.
+	// +optional
+	Generator *DashboardTemplateGenerator `json:"generator,omitempty"`
+}
+
+// DashboardTemplateParameterType is the type of the values of a parameter.
+// +kubebuilder:validation:Enum=string;number;boolean
+type DashboardTemplateParameterType string
+
+const (
+	// DashboardTemplateParameterTypeString values are escaped as the content of a JSON string.
+	DashboardTemplateParameterTypeString DashboardTemplateParameterType = "string"
+	// DashboardTemplateParameterTypeNumber values are JSON numbers.
+	DashboardTemplateParameterTypeNumber DashboardTemplateParameterType = "number"
+	// DashboardTemplateParameterTypeBoolean values are `true` or `false`.
+	DashboardTemplateParameterTypeBoolean DashboardTemplateParameterType = "boolean"
+)
+
+// DashboardTemplateParameter is a parameter of a DashboardTemplate.
+type DashboardTemplateParameter struct {
+	// Name of the parameter, referenced as `${name}` in the template.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*$`
+	Name string `json:"name"`
+
+	// Type of the values of the parameter.
+	// +optional
+	// +kubebuilder:default=string
+	Type DashboardTemplateParameterType `json:"type,omitempty"`
+
+	// Default is the value used when none is given. Parameters without a default are required.
+	// +optional
+	Default *string `json:"default,omitempty"`
+
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// DashboardTemplateGenerator fans a DashboardTemplate out over namespaces. The Dashboards are created in the
+// namespace of the template, named `<template>-<namespace>`, and are deleted with it or when their namespace is
+// no longer selected.
+type DashboardTemplateGenerator struct {
+	// NamespaceSelector selects the namespaces a Dashboard is generated for.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	// Values of the parameters, common to all the generated Dashboards.
+	// +optional
+	Values map[string]string `json:"values,omitempty"`
+
+	// NamespaceParameter is the parameter set to the name of the namespace.
+	// +optional
+	NamespaceParameter string `json:"namespaceParameter,omitempty"`
+
+	// LabelParameters maps parameters to the namespace labels they are set to, e.g. `cluster: env.example.com/cluster`.
+	// They take precedence over values, which remain the fallback of the namespaces without the label.
+	// +optional
+	LabelParameters map[string]string `json:"labelParameters,omitempty"`
+
+	// FolderRef is the folder of the generated Dashboards.
+	// +optional
+	FolderRef *DashboardFolderRef `json:"folderRef,omitempty"`
+}
+
+// DashboardTemplateRef references the DashboardTemplate a Dashboard is rendered from.
+type DashboardTemplateRef struct {
+	// Name of the DashboardTemplate, in the namespace of the Dashboard.
+	Name string `json:"name"`
+
+	// Values of the parameters of the template.
+	// +optional
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// DashboardTemplateStatus defines the observed state of DashboardTemplate.
+type DashboardTemplateStatus struct {
+	// Revision is the hash of the template content and parameters. Dashboards rendered from the template record
+	// the revision they were rendered from in status.templateRevision.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// GeneratedDashboards are the names of the Dashboards created by the generator.
+	// +optional
+	GeneratedDashboards []string `json:"generatedDashboards,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+}
+
+var templatePlaceholder = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// Render returns the dashboard JSON of the template rendered with the values, and the revision of the template.
+func (in *DashboardTemplateSpec) Render(ctx context.Context, namespace string, values map[string]string) (string, string, error) {
+	content, err := in.content(ctx, namespace)
+	if err != nil {
+		return "", "", err
+	}
+
+	rendered, err := in.render(content, values)
+	if err != nil {
+		return "", "", err
+	}
+	return rendered, in.revision(content), nil
+}
+
+// Revision returns the hash of the template content and parameters.
+func (in *DashboardTemplateSpec) Revision(ctx context.Context, namespace string) (string, error) {
+	content, err := in.content(ctx, namespace)
+	if err != nil {
+		return "", err
+	}
+	return in.revision(content), nil
+}
+
+func (in *DashboardTemplateSpec) content(ctx context.Context, namespace string) (string, error) {
+	if json := in.Json; json != nil {
+		return *json, nil
+	} else if configMapRef := in.ConfigMapRef; configMapRef != nil {
+		return getConfigMapKeyContent(ctx, namespace, configMapRef)
+	}
+	return "", fmt.Errorf("json or configMapRef is required")
+}
+
+func (in *DashboardTemplateSpec) revision(content string) string {
+	parameters, _ := json.Marshal(in.Parameters)
+	sum := sha256.Sum256(append([]byte(content+"\x00"), parameters...))
+	return hex.EncodeToString(sum[:8])
+}
+
+func (in *DashboardTemplateSpec) render(content string, values map[string]string) (string, error) {
+	parameters := make(map[string]DashboardTemplateParameter, len(in.Parameters))
+	for _, parameter := range in.Parameters {
+		parameters[parameter.Name] = parameter
+	}
+
+	var unknown []string
+	for name := range values {
+		if _, ok := parameters[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return "", fmt.Errorf("unknown template parameters: %s", strings.Join(unknown, ", "))
+	}
+
+	replacements := make(map[string]string, len(parameters))
+	for name, parameter := range parameters {
+		value, ok := values[name]
+		if !ok {
+			if parameter.Default == nil {
+				return "", fmt.Errorf("template parameter %q is required", name)
+			}
+			value = *parameter.Default
+		}
+		replacement, err := parameter.format(value)
+		if err != nil {
+			return "", err
+		}
+		replacements[name] = replacement
+	}
+
+	return templatePlaceholder.ReplaceAllStringFunc(content, func(placeholder string) string {
+		if replacement, ok := replacements[placeholder[2:len(placeholder)-1]]; ok {
+			return replacement
+		}
+		return placeholder
+	}), nil
+}
+
+func (in *DashboardTemplateParameter) format(value string) (string, error) {
+	switch in.Type {
+	case DashboardTemplateParameterTypeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "", fmt.Errorf("template parameter %q must be a number, got %q", in.Name, value)
+		}
+		return value, nil
+	case DashboardTemplateParameterTypeBoolean:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", fmt.Errorf("template parameter %q must be a boolean, got %q", in.Name, value)
+		}
+		return strconv.FormatBool(parsed), nil
+	default:
+		// Placeholders of string parameters are inside JSON strings, so the value is escaped without its quotes.
+		escaped, _ := json.Marshal(value)
+		return string(escaped[1 : len(escaped)-1]), nil
+	}
+}
+
+// GeneratedDashboardName returns the name of the Dashboard generated from the template for the namespace.
+func GeneratedDashboardName(templateName, namespace string) string {
+	return templateName + "-" + namespace
+}
+
+// GeneratedDashboardValues returns the values of the Dashboard generated for the namespace.
+func (in *DashboardTemplateGenerator) GeneratedDashboardValues(namespace *v1.Namespace) map[string]string {
+	values := make(map[string]string, len(in.Values)+len(in.LabelParameters)+1)
+	for name, value := range in.Values {
+		values[name] = value
+	}
+	for name, label := range in.LabelParameters {
+		if value, ok := namespace.Labels[label]; ok {
+			values[name] = value
+		}
+	}
+	if in.NamespaceParameter != "" {
+		values[in.NamespaceParameter] = namespace.Name
+	}
+	return values
+}
+
+// GetDashboardTemplate returns the DashboardTemplate of the namespace.
+func GetDashboardTemplate(ctx context.Context, namespace, name string) (*DashboardTemplate, error) {
+	template := &DashboardTemplate{}
+	if err := config.GetClient().Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, template); err != nil {
+		return nil, fmt.Errorf("failed to get DashboardTemplate %s: %w", name, err)
+	}
+	return template, nil
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Revision",type="string",JSONPath=".status.revision"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DashboardTemplate is the Schema for the DashboardTemplates API.
+// It holds a dashboard JSON with typed parameters, so that the dashboards of several environments or services,
+// which only differ in their filters, are declared once. A Dashboard renders it through spec.templateRef, and
+// spec.generator fans it out over the namespaces selected by a label selector.
+type DashboardTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DashboardTemplateSpec   `json:"spec,omitempty"`
+	Status DashboardTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DashboardTemplateList contains a list of DashboardTemplate.
+type DashboardTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DashboardTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DashboardTemplate{}, &DashboardTemplateList{})
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestDashboardTemplateRender(t *testing.T) {
+	spec := &DashboardTemplateSpec{
+		Json: ptr.To(`{"name": "${application}", "query": "up{cluster=\"${cluster}\"}", "max": ${max}, "visible": ${visible}, "legend": "{{pod}} ${pod}"}`),
+		Parameters: []DashboardTemplateParameter{
+			{Name: "application"},
+			{Name: "cluster", Default: ptr.To("production")},
+			{Name: "max", Type: DashboardTemplateParameterTypeNumber, Default: ptr.To("100")},
+			{Name: "visible", Type: DashboardTemplateParameterTypeBoolean, Default: ptr.To("1")},
+		},
+	}
+
+	for _, tc := range []struct {
+		name          string
+		values        map[string]string
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "defaults",
+			values:   map[string]string{"application": "checkout"},
+			expected: `{"name": "checkout", "query": "up{cluster=\"production\"}", "max": 100, "visible": true, "legend": "{{pod}} ${pod}"}`,
+		},
+		{
+			name:     "values",
+			values:   map[string]string{"application": `say "hi"`, "cluster": "staging", "max": "2.5", "visible": "false"},
+			expected: `{"name": "say \"hi\"", "query": "up{cluster=\"staging\"}", "max": 2.5, "visible": false, "legend": "{{pod}} ${pod}"}`,
+		},
+		{
+			name:          "missing required value",
+			expectedError: `template parameter "application" is required`,
+		},
+		{
+			name:          "unknown values",
+			values:        map[string]string{"application": "checkout", "pod": "api", "namespace": "default"},
+			expectedError: "unknown template parameters: namespace, pod",
+		},
+		{
+			name:          "invalid number",
+			values:        map[string]string{"application": "checkout", "max": "lots"},
+			expectedError: `template parameter "max" must be a number, got "lots"`,
+		},
+		{
+			name:          "invalid boolean",
+			values:        map[string]string{"application": "checkout", "visible": "maybe"},
+			expectedError: `template parameter "visible" must be a boolean, got "maybe"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rendered, revision, err := spec.Render(context.Background(), "default", tc.values)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, rendered)
+			require.NotEmpty(t, revision)
+		})
+	}
+}
+
+func TestDashboardTemplateRevision(t *testing.T) {
+	spec := &DashboardTemplateSpec{
+		Json:       ptr.To(`{"name": "${application}"}`),
+		Parameters: []DashboardTemplateParameter{{Name: "application"}},
+	}
+	revision, err := spec.Revision(context.Background(), "default")
+	require.NoError(t, err)
+
+	_, renderedRevision, err := spec.Render(context.Background(), "default", map[string]string{"application": "checkout"})
+	require.NoError(t, err)
+	require.Equal(t, revision, renderedRevision, "the revision does not depend on the values")
+
+	spec.Parameters[0].Default = ptr.To("checkout")
+	changed, err := spec.Revision(context.Background(), "default")
+	require.NoError(t, err)
+	require.NotEqual(t, revision, changed, "the revision depends on the parameters")
+}
+
+func TestDashboardTemplateReadsConfigMapRef(t *testing.T) {
+	useFakeClient(t, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "templates", Namespace: "default"},
+		Data:       map[string]string{"overview.json": `{"name": "${application}"}`},
+	})
+	spec := &DashboardTemplateSpec{
+		ConfigMapRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "templates"},
+			Key:                  "overview.json",
+		},
+		Parameters: []DashboardTemplateParameter{{Name: "application"}},
+	}
+
+	rendered, _, err := spec.Render(context.Background(), "default", map[string]string{"application": "checkout"})
+	require.NoError(t, err)
+	require.Equal(t, `{"name": "checkout"}`, rendered)
+}
+
+func TestGeneratedDashboardValues(t *testing.T) {
+	generator := &DashboardTemplateGenerator{
+		Values:             map[string]string{"cluster": "production", "subsystem": "api"},
+		NamespaceParameter: "application",
+		LabelParameters:    map[string]string{"cluster": "example.com/cluster"},
+	}
+
+	require.Equal(t, map[string]string{"application": "checkout", "cluster": "staging", "subsystem": "api"},
+		generator.GeneratedDashboardValues(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "checkout",
+			Labels: map[string]string{"example.com/cluster": "staging"},
+		}}))
+	require.Equal(t, map[string]string{"application": "payments", "cluster": "production", "subsystem": "api"},
+		generator.GeneratedDashboardValues(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "payments"}}),
+		"values are the fallback of namespaces without the label")
+}
@@ -130,17 +130,33 @@ type EnrichmentStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Enrichment is the Schema for the enrichments API.
 // Will overwrite the existing enrichments on the Coralogix side,
 // so it should contain all enrichments that should be applied, not just the new ones.
 // See also https://coralogix.com/docs/user-guides/data-transformation/enrichments/custom-enrichment/#configuration.
+// Only the oldest selected Enrichment resource in the cluster is applied to the account;
+// any other one reports a Conflict condition naming it and is ignored.
 type Enrichment struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -174,6 +190,30 @@ func (e *Enrichment) SetPrintableStatus(printableStatus string) {
 	e.Status.PrintableStatus = printableStatus
 }
 
+func (e *Enrichment) GetLastHandledReconcileAt() string {
+	return e.Status.LastHandledReconcileAt
+}
+
+func (e *Enrichment) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	e.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (e *Enrichment) GetNextReconcileAt() *metav1.Time {
+	return e.Status.NextReconcileAt
+}
+
+func (e *Enrichment) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	e.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (e *Enrichment) GetURL() string {
+	return e.Status.URL
+}
+
+func (e *Enrichment) SetURL(url string) {
+	e.Status.URL = url
+}
+
 func (e *Enrichment) HasIDInStatus() bool {
 	return true
 }
@@ -232,6 +232,19 @@ type Events2MetricStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (e2m *Events2Metric) GetConditions() []metav1.Condition {
@@ -250,6 +263,30 @@ func (e2m *Events2Metric) SetPrintableStatus(printableStatus string) {
 	e2m.Status.PrintableStatus = printableStatus
 }
 
+func (e2m *Events2Metric) GetLastHandledReconcileAt() string {
+	return e2m.Status.LastHandledReconcileAt
+}
+
+func (e2m *Events2Metric) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	e2m.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (e2m *Events2Metric) GetNextReconcileAt() *metav1.Time {
+	return e2m.Status.NextReconcileAt
+}
+
+func (e2m *Events2Metric) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	e2m.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (e2m *Events2Metric) GetURL() string {
+	return e2m.Status.URL
+}
+
+func (e2m *Events2Metric) SetURL(url string) {
+	e2m.Status.URL = url
+}
+
 func (e2m *Events2Metric) HasIDInStatus() bool {
 	return e2m.Status.Id != nil && *e2m.Status.Id != ""
 }
@@ -257,6 +294,7 @@ func (e2m *Events2Metric) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // See also https://coralogix.com/docs/user-guides/monitoring-and-insights/events2metrics/
 //
@@ -371,6 +371,19 @@ type GlobalRouterStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (g *GlobalRouter) GetConditions() []metav1.Condition {
@@ -389,6 +402,30 @@ func (g *GlobalRouter) SetPrintableStatus(printableStatus string) {
 	g.Status.PrintableStatus = printableStatus
 }
 
+func (g *GlobalRouter) GetLastHandledReconcileAt() string {
+	return g.Status.LastHandledReconcileAt
+}
+
+func (g *GlobalRouter) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	g.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (g *GlobalRouter) GetNextReconcileAt() *metav1.Time {
+	return g.Status.NextReconcileAt
+}
+
+func (g *GlobalRouter) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	g.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (g *GlobalRouter) GetURL() string {
+	return g.Status.URL
+}
+
+func (g *GlobalRouter) SetURL(url string) {
+	g.Status.URL = url
+}
+
 func (g *GlobalRouter) HasIDInStatus() bool {
 	return g.Status.Id != nil && *g.Status.Id != ""
 }
@@ -396,6 +433,7 @@ func (g *GlobalRouter) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // GlobalRouter is the Schema for the GlobalRouters API.
 // NOTE: This CRD exposes a new feature and may have breaking changes in future releases.
@@ -16,8 +16,8 @@ package v1alpha1
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -64,10 +64,16 @@ type GroupSpec struct {
 	Scope *GroupScope `json:"scope,omitempty"`
 }
 
-// User on Coralogix.
+// User on Coralogix, either by user name or by reference to a User within the cluster.
+// +kubebuilder:validation:XValidation:rule="has(self.userName) != has(self.resourceRef)",message="Exactly one of userName or resourceRef is required"
 type Member struct {
 	// User's name.
-	UserName string `json:"userName"`
+	// +optional
+	UserName string `json:"userName,omitempty"`
+
+	// Reference to the User within the cluster.
+	// +optional
+	ResourceRef *ResourceRef `json:"resourceRef,omitempty"`
 }
 
 // Custom role reference.
@@ -92,19 +98,12 @@ type ResourceRef struct {
 	Namespace *string `json:"namespace,omitempty"`
 }
 
-func (g *Group) ExtractCreateGroupRequest(
-	ctx context.Context,
-	usersClient *cxsdk.UsersClient) (*groups.CreateTeamGroupRequest, error) {
+func (g *Group) ExtractCreateGroupRequest(usersIds []string) (*groups.CreateTeamGroupRequest, error) {
 	var groupType *groups.GroupType
 	if g.Spec.GroupType != nil {
 		groupType = groupTypeSchemaToOpenAPI[*g.Spec.GroupType].Ptr()
 	}
 
-	usersIds, err := g.ExtractUsersIDs(ctx, usersClient)
-	if err != nil {
-		return nil, err
-	}
-
 	roleId, err := g.ExtractRoleId()
 	if err != nil {
 		return nil, err
@@ -127,18 +126,12 @@ func (g *Group) ExtractCreateGroupRequest(
 	}, nil
 }
 
-func (g *Group) ExtractUpdateGroupRequest(
-	ctx context.Context, usersClient *cxsdk.UsersClient) (*groups.UpdateTeamGroupRequest, error) {
+func (g *Group) ExtractUpdateGroupRequest(usersIds []string) (*groups.UpdateTeamGroupRequest, error) {
 	var groupType *groups.GroupType
 	if g.Spec.GroupType != nil {
 		groupType = groupTypeSchemaToOpenAPI[*g.Spec.GroupType].Ptr()
 	}
 
-	usersIds, err := g.ExtractUsersIDs(ctx, usersClient)
-	if err != nil {
-		return nil, err
-	}
-
 	roleId, err := g.ExtractRoleId()
 	if err != nil {
 		return nil, err
@@ -180,37 +173,74 @@ func (g *Group) ExtractUpdateGroupRequest(
 	}, nil
 }
 
-func (g *Group) ExtractUsersIDs(ctx context.Context, usersClient *cxsdk.UsersClient) ([]string, error) {
+// ExtractMembers resolves the members of the group to user IDs. Members that cannot be resolved are skipped and
+// reported in the returned statuses, so that a single unknown user does not fail the whole group.
+func (g *Group) ExtractMembers(ctx context.Context, usersClient *cxsdk.UsersClient) ([]string, []GroupMemberStatus, error) {
 	if g.Spec.Members == nil {
-		return nil, nil
+		return nil, nil, nil
 	}
 
-	users, err := usersClient.List(ctx)
-	if err != nil {
-		return nil, err
+	var users []cxsdk.SCIMUser
+	if slices.ContainsFunc(g.Spec.Members, func(member Member) bool { return member.ResourceRef == nil }) {
+		var err error
+		if users, err = usersClient.List(ctx); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	var usersIDs []string
-	var errs error
+	var statuses []GroupMemberStatus
 	for _, member := range g.Spec.Members {
-		found := false
-		for _, user := range users {
-			if user.UserName == member.UserName {
-				found = true
-				usersIDs = append(usersIDs, *user.ID)
-				break
-			}
+		var status GroupMemberStatus
+		var err error
+		if member.ResourceRef != nil {
+			status.ResourceRef = member.ResourceRef
+			status.ID, err = g.extractUserRefID(ctx, *member.ResourceRef)
+		} else {
+			status.UserName = member.UserName
+			status.ID, err = extractUserNameID(users, member.UserName)
 		}
-		if !found {
-			errs = errors.Join(errs, fmt.Errorf("user %s not found", member.UserName))
+
+		if err != nil {
+			status.Message = err.Error()
+		} else {
+			usersIDs = append(usersIDs, *status.ID)
+		}
+		statuses = append(statuses, status)
+	}
+
+	return usersIDs, statuses, nil
+}
+
+func extractUserNameID(users []cxsdk.SCIMUser, userName string) (*string, error) {
+	for _, user := range users {
+		if user.UserName == userName {
+			return user.ID, nil
 		}
 	}
+	return nil, fmt.Errorf("user %s not found", userName)
+}
 
-	if errs != nil {
-		return nil, errs
+func (g *Group) extractUserRefID(ctx context.Context, ref ResourceRef) (*string, error) {
+	namespace := g.Namespace
+	if ref.Namespace != nil {
+		namespace = *ref.Namespace
 	}
 
-	return usersIDs, nil
+	user := &User{}
+	if err := config.GetClient().Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: namespace}, user); err != nil {
+		return nil, err
+	}
+
+	if !config.GetConfig().Selector.Matches(user.Labels, user.Namespace) {
+		return nil, fmt.Errorf("user %s does not match selector", user.Name)
+	}
+
+	if user.Status.ID == nil {
+		return nil, fmt.Errorf("ID is not populated for User %s", ref.Name)
+	}
+
+	return user.Status.ID, nil
 }
 
 func (g *Group) ExtractRoleId() (int64, error) {
@@ -282,6 +312,42 @@ type GroupStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Members of the group, with their user IDs, or the reason they could not be resolved.
+	// +optional
+	Members []GroupMemberStatus `json:"members,omitempty"`
+}
+
+// GroupMemberStatus is the observed state of a member of the group.
+type GroupMemberStatus struct {
+	// User's name, for members specified by user name.
+	// +optional
+	UserName string `json:"userName,omitempty"`
+
+	// Reference to the User, for members specified by reference.
+	// +optional
+	ResourceRef *ResourceRef `json:"resourceRef,omitempty"`
+
+	// ID of the Coralogix user.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Reason the member could not be resolved. Such members are not added to the group.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 func (g *Group) GetConditions() []metav1.Condition {
@@ -300,6 +366,30 @@ func (g *Group) SetPrintableStatus(printableStatus string) {
 	g.Status.PrintableStatus = printableStatus
 }
 
+func (g *Group) GetLastHandledReconcileAt() string {
+	return g.Status.LastHandledReconcileAt
+}
+
+func (g *Group) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	g.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (g *Group) GetNextReconcileAt() *metav1.Time {
+	return g.Status.NextReconcileAt
+}
+
+func (g *Group) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	g.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (g *Group) GetURL() string {
+	return g.Status.URL
+}
+
+func (g *Group) SetURL(url string) {
+	g.Status.URL = url
+}
+
 func (g *Group) HasIDInStatus() bool {
 	return g.Status.ID != nil && *g.Status.ID != ""
 }
@@ -307,6 +397,7 @@ func (g *Group) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // Group is the Schema for the Groups API.
 // See also https://coralogix.com/docs/user-guides/account-management/user-management/assign-user-roles-and-scopes-via-groups/
@@ -190,6 +190,19 @@ type IntegrationStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (i *Integration) GetConditions() []metav1.Condition {
@@ -208,6 +221,30 @@ func (i *Integration) SetPrintableStatus(printableStatus string) {
 	i.Status.PrintableStatus = printableStatus
 }
 
+func (i *Integration) GetLastHandledReconcileAt() string {
+	return i.Status.LastHandledReconcileAt
+}
+
+func (i *Integration) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	i.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (i *Integration) GetNextReconcileAt() *metav1.Time {
+	return i.Status.NextReconcileAt
+}
+
+func (i *Integration) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	i.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (i *Integration) GetURL() string {
+	return i.Status.URL
+}
+
+func (i *Integration) SetURL(url string) {
+	i.Status.URL = url
+}
+
 func (i *Integration) HasIDInStatus() bool {
 	return i.Status.Id != nil && *i.Status.Id != ""
 }
@@ -215,6 +252,7 @@ func (i *Integration) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Integration is the Schema for the Integrations API.
@@ -106,6 +106,19 @@ type IPAccessStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (i *IPAccess) GetConditions() []metav1.Condition {
@@ -124,6 +137,30 @@ func (i *IPAccess) SetPrintableStatus(printableStatus string) {
 	i.Status.PrintableStatus = printableStatus
 }
 
+func (i *IPAccess) GetLastHandledReconcileAt() string {
+	return i.Status.LastHandledReconcileAt
+}
+
+func (i *IPAccess) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	i.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (i *IPAccess) GetNextReconcileAt() *metav1.Time {
+	return i.Status.NextReconcileAt
+}
+
+func (i *IPAccess) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	i.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (i *IPAccess) GetURL() string {
+	return i.Status.URL
+}
+
+func (i *IPAccess) SetURL(url string) {
+	i.Status.URL = url
+}
+
 func (i *IPAccess) HasIDInStatus() bool {
 	return i.Status.ID != nil && *i.Status.ID != ""
 }
@@ -131,10 +168,13 @@ func (i *IPAccess) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // IPAccess is the Schema for the ipaccesses API.
 // See also https://coralogix.com/docs/user-guides/account-management/account-settings/ip-access-control/
+// Only the oldest selected IPAccess resource in the cluster is applied to the account;
+// any other one reports a Conflict condition naming it and is ignored.
 // **Added in v1.2.0**
 type IPAccess struct {
 	metav1.TypeMeta   `json:",inline"`
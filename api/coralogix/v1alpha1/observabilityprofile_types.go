@@ -0,0 +1,176 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+)
+
+// ObservabilityProfileSpec defines the resources generated for the workloads using the profile.
+type ObservabilityProfileSpec struct {
+	// Dashboards generated for each workload, named `<workload>-<name>`.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Dashboards []ObservabilityProfileDashboard `json:"dashboards,omitempty"`
+
+	// Alerts generated for each workload, named `<workload>-<name>`.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Alerts []ObservabilityProfileAlert `json:"alerts,omitempty"`
+
+	// AlertSets generated for each workload, named `<workload>-<name>`.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	AlertSets []ObservabilityProfileAlertSet `json:"alertSets,omitempty"`
+
+	// SLOs generated for each workload, named `<workload>-<name>`.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	SLOs []ObservabilityProfileSLO `json:"slos,omitempty"`
+}
+
+// ObservabilityProfileDashboard is a Dashboard of an ObservabilityProfile.
+type ObservabilityProfileDashboard struct {
+	// Name is appended to the name of the workload to name the Dashboard.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	Spec DashboardSpec `json:"spec"`
+}
+
+// ObservabilityProfileAlert is an Alert of an ObservabilityProfile.
+type ObservabilityProfileAlert struct {
+	// Name is appended to the name of the workload to name the Alert.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	Spec v1beta1.AlertSpec `json:"spec"`
+}
+
+// ObservabilityProfileAlertSet is an AlertSet of an ObservabilityProfile.
+type ObservabilityProfileAlertSet struct {
+	// Name is appended to the name of the workload to name the AlertSet.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	Spec AlertSetSpec `json:"spec"`
+}
+
+// ObservabilityProfileSLO is an SLO of an ObservabilityProfile.
+type ObservabilityProfileSLO struct {
+	// Name is appended to the name of the workload to name the SLO.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	Spec SLOSpec `json:"spec"`
+}
+
+// ObservabilityProfileWorkload is the workload a profile is rendered for.
+type ObservabilityProfileWorkload struct {
+	Kind      string
+	Name      string
+	Namespace string
+	// Container is the name of the first container of the workload.
+	Container string
+	Labels    map[string]string
+}
+
+var workloadPlaceholder = regexp.MustCompile(`\$\{workload\.([^}]*)\}`)
+
+// Render returns the profile with its `${workload.name}`, `${workload.namespace}`, `${workload.kind}`,
+// `${workload.container}` and `${workload.labels.<key>}` placeholders replaced by the values of the workload.
+// Labels the workload does not have are replaced by an empty string.
+func (in *ObservabilityProfileSpec) Render(workload ObservabilityProfileWorkload) (*ObservabilityProfileSpec, error) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var unknown []string
+	rendered := workloadPlaceholder.ReplaceAllFunc(data, func(placeholder []byte) []byte {
+		field := string(workloadPlaceholder.FindSubmatch(placeholder)[1])
+		var value string
+		switch {
+		case field == "kind":
+			value = workload.Kind
+		case field == "name":
+			value = workload.Name
+		case field == "namespace":
+			value = workload.Namespace
+		case field == "container":
+			value = workload.Container
+		case strings.HasPrefix(field, "labels."):
+			value = workload.Labels[strings.TrimPrefix(field, "labels.")]
+		default:
+			unknown = append(unknown, string(placeholder))
+			return placeholder
+		}
+		// Placeholders are inside JSON strings. Names and label values cannot hold characters escaped differently
+		// in the JSON strings nested in them, like spec.json of Dashboards.
+		escaped, _ := json.Marshal(value)
+		return escaped[1 : len(escaped)-1]
+	})
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown placeholders: %s", strings.Join(unknown, ", "))
+	}
+
+	result := &ObservabilityProfileSpec{}
+	if err = json.Unmarshal(rendered, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ObservabilityProfile is the Schema for the ObservabilityProfiles API.
+// It defines the Dashboards, Alerts, AlertSets and SLOs generated for the Deployments and StatefulSets annotated with
+// `app.coralogix.com/observability-profile: <name>`, or `<namespace>/<name>` for a profile of another namespace.
+// The generated resources are created in the namespace of the workload with its labels, and owned by it, so that
+// they are deleted with it, or when the annotation is removed. Profiles are only applied when the operator runs with
+// `-observability-profile-controller`.
+type ObservabilityProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ObservabilityProfileSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObservabilityProfileList contains a list of ObservabilityProfile.
+type ObservabilityProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ObservabilityProfile `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ObservabilityProfile{}, &ObservabilityProfileList{})
+}
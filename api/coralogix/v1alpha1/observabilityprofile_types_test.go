@@ -0,0 +1,66 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+func TestObservabilityProfileRender(t *testing.T) {
+	profile := &ObservabilityProfileSpec{
+		Dashboards: []ObservabilityProfileDashboard{{
+			Name: "overview",
+			Spec: DashboardSpec{
+				Json: ptr.To(`{"name": "${workload.kind} ${workload.name}", "query": "up{pod=~\"${workload.name}-.*\"}"}`),
+			},
+		}},
+		SLOs: []ObservabilityProfileSLO{{
+			Name: "availability",
+			Spec: SLOSpec{
+				Name:        "${workload.namespace}/${workload.name} availability",
+				Description: ptr.To("Owned by ${workload.labels.team}${workload.labels.missing}"),
+				SliType: SliType{RequestBasedMetricSli: &RequestBasedMetricSli{
+					GoodEvents:  SloMetricEvent{Query: `sum(rate(http_requests_total{container="${workload.container}", code!~"5.."}[5m]))`},
+					TotalEvents: SloMetricEvent{Query: `sum(rate(http_requests_total{container="${workload.container}"}[5m]))`},
+				}},
+				TargetThresholdPercentage: resource.MustParse("99.9"),
+			},
+		}},
+	}
+	workload := ObservabilityProfileWorkload{
+		Kind:      "Deployment",
+		Name:      "checkout",
+		Namespace: "payments",
+		Container: "api",
+		Labels:    map[string]string{"team": `"payments"`},
+	}
+
+	rendered, err := profile.Render(workload)
+	require.NoError(t, err)
+	require.Equal(t, `{"name": "Deployment checkout", "query": "up{pod=~\"checkout-.*\"}"}`, *rendered.Dashboards[0].Spec.Json)
+	slo := rendered.SLOs[0].Spec
+	require.Equal(t, "payments/checkout availability", slo.Name)
+	require.Equal(t, `Owned by "payments"`, *slo.Description, "values are escaped and missing labels are empty")
+	require.Equal(t, `sum(rate(http_requests_total{container="api"}[5m]))`, slo.SliType.RequestBasedMetricSli.TotalEvents.Query)
+	require.Contains(t, *profile.Dashboards[0].Spec.Json, "${workload.name}", "the profile is not modified")
+
+	profile.SLOs[0].Spec.Name = "${workload.uid} ${workload.image}"
+	_, err = profile.Render(workload)
+	require.EqualError(t, err, "unknown placeholders: ${workload.image}, ${workload.uid}")
+}
@@ -342,6 +342,19 @@ type OutboundWebhookStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (in *OutboundWebhook) GetConditions() []metav1.Condition {
@@ -360,6 +373,30 @@ func (in *OutboundWebhook) SetPrintableStatus(printableStatus string) {
 	in.Status.PrintableStatus = printableStatus
 }
 
+func (in *OutboundWebhook) GetLastHandledReconcileAt() string {
+	return in.Status.LastHandledReconcileAt
+}
+
+func (in *OutboundWebhook) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	in.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (in *OutboundWebhook) GetNextReconcileAt() *metav1.Time {
+	return in.Status.NextReconcileAt
+}
+
+func (in *OutboundWebhook) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	in.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (in *OutboundWebhook) GetURL() string {
+	return in.Status.URL
+}
+
+func (in *OutboundWebhook) SetURL(url string) {
+	in.Status.URL = url
+}
+
 func (in *OutboundWebhook) HasIDInStatus() bool {
 	return in.Status.ID != nil && *in.Status.ID != ""
 }
@@ -367,6 +404,7 @@ func (in *OutboundWebhook) HasIDInStatus() bool {
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+//+kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // OutboundWebhook is the Schema for the API
@@ -107,6 +107,19 @@ type PresetStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (p *Preset) GetConditions() []metav1.Condition {
@@ -125,6 +138,30 @@ func (p *Preset) SetPrintableStatus(printableStatus string) {
 	p.Status.PrintableStatus = printableStatus
 }
 
+func (p *Preset) GetLastHandledReconcileAt() string {
+	return p.Status.LastHandledReconcileAt
+}
+
+func (p *Preset) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	p.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (p *Preset) GetNextReconcileAt() *metav1.Time {
+	return p.Status.NextReconcileAt
+}
+
+func (p *Preset) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	p.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (p *Preset) GetURL() string {
+	return p.Status.URL
+}
+
+func (p *Preset) SetURL(url string) {
+	p.Status.URL = url
+}
+
 func (p *Preset) HasIDInStatus() bool {
 	return p.Status.Id != nil && *p.Status.Id != ""
 }
@@ -218,6 +255,7 @@ func ExtractMessageConfig(messageConfig MessageConfig) *presets.MessageConfig {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Preset is the Schema for the presets API.
@@ -0,0 +1,66 @@
+// Copyright 2026 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coralogix/coralogix-operator/v2/internal/queryvalidation"
+)
+
+func TestExtractRecordingRuleGroupsValidatesExpressions(t *testing.T) {
+	spec := RecordingRuleGroupSetSpec{
+		Groups: []RecordingRuleGroup{
+			{Name: "ok", Rules: []RecordingRule{{Record: "job:up:sum", Expr: "sum(up) by (job)"}}},
+			{Name: "broken", Rules: []RecordingRule{
+				{Record: "job:up:sum", Expr: "sum(up) by (job)"},
+				{Record: "job:errors:rate5m", Expr: "sum(rate(errors_total[5m]) by (job)"},
+			}},
+		},
+	}
+
+	_, err := spec.ExtractRecordingRuleGroups()
+	require.EqualError(t, err, "spec.groups[1].rules[1].expr: invalid PromQL query at 1:28: unexpected <by> in aggregation")
+	require.True(t, queryvalidation.IsError(err))
+
+	spec.Groups[1].Rules[1].Expr = "sum(rate(errors_total[5m])) by (job)"
+	groups, err := spec.ExtractRecordingRuleGroups()
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+}
+
+func TestExtractSLOValidatesQueries(t *testing.T) {
+	slo := &SLO{Spec: SLOSpec{
+		Name: "availability",
+		SliType: SliType{RequestBasedMetricSli: &RequestBasedMetricSli{
+			GoodEvents:  SloMetricEvent{Query: `sum(rate(http_requests_total{code!~"5.."}[5m]))`},
+			TotalEvents: SloMetricEvent{Query: `sum(rate(http_requests_total[5m])`},
+		}},
+	}}
+
+	_, err := slo.ExtractSLOCreateRequest(nil)
+	require.EqualError(t, err, "error extracting request based metric SLI: spec.sliType.requestBasedMetric.totalEvents.query: invalid PromQL query at 1:34: unclosed left parenthesis")
+}
+
+func TestExtractAlertSchedulerRuleValidatesWhatExpression(t *testing.T) {
+	alertScheduler := &AlertScheduler{Spec: AlertSchedulerSpec{
+		Filter: Filter{WhatExpression: `source logs | filter $d.pod == 'api`},
+	}}
+
+	_, err := alertScheduler.ExtractAlertSchedulerRule()
+	require.EqualError(t, err, "spec.filter.whatExpression: invalid DataPrime query at 1:32: unterminated string")
+}
@@ -129,6 +129,19 @@ type QuotaAllocationRuleSetStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (q *QuotaAllocationRuleSet) GetConditions() []metav1.Condition {
@@ -147,6 +160,30 @@ func (q *QuotaAllocationRuleSet) SetPrintableStatus(printableStatus string) {
 	q.Status.PrintableStatus = printableStatus
 }
 
+func (q *QuotaAllocationRuleSet) GetLastHandledReconcileAt() string {
+	return q.Status.LastHandledReconcileAt
+}
+
+func (q *QuotaAllocationRuleSet) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	q.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (q *QuotaAllocationRuleSet) GetNextReconcileAt() *metav1.Time {
+	return q.Status.NextReconcileAt
+}
+
+func (q *QuotaAllocationRuleSet) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	q.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (q *QuotaAllocationRuleSet) GetURL() string {
+	return q.Status.URL
+}
+
+func (q *QuotaAllocationRuleSet) SetURL(url string) {
+	q.Status.URL = url
+}
+
 func (q *QuotaAllocationRuleSet) HasIDInStatus() bool {
 	return true
 }
@@ -154,12 +191,16 @@ func (q *QuotaAllocationRuleSet) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // QuotaAllocationRuleSet is the Schema for the QuotaAllocationRuleSet API.
 // NOTE: This account-level singleton resource replaces all user-managed backend
 // quota allocation rules. Coralogix-managed rules returned by the backend are
 // preserved by the controller.
 //
+// Only the oldest selected QuotaAllocationRuleSet in the cluster is applied to the account;
+// any other one reports a Conflict condition naming it and is ignored.
+//
 // **Added in v0.4.0**
 type QuotaAllocationRuleSet struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -15,12 +15,16 @@
 package v1alpha1
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	recordingrules "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/recording_rules_service"
+
+	"github.com/coralogix/coralogix-operator/v2/internal/queryvalidation"
 )
 
 // RecordingRuleGroupSetSpec defines the desired state of a set of Coralogix recording rule groups.
@@ -30,13 +34,30 @@ type RecordingRuleGroupSetSpec struct {
 	Groups []RecordingRuleGroup `json:"groups"`
 }
 
-func (in *RecordingRuleGroupSetSpec) ExtractRecordingRuleGroups() []recordingrules.InRuleGroup {
+func (in *RecordingRuleGroupSetSpec) ExtractRecordingRuleGroups() ([]recordingrules.InRuleGroup, error) {
+	if err := in.ValidateQueries(); err != nil {
+		return nil, err
+	}
+
 	result := make([]recordingrules.InRuleGroup, 0, len(in.Groups))
 	for _, ruleGroup := range in.Groups {
 		rg := expandRecordingRuleGroup(ruleGroup)
 		result = append(result, *rg)
 	}
-	return result
+	return result, nil
+}
+
+// ValidateQueries checks the PromQL expressions of the recording rules.
+func (in *RecordingRuleGroupSetSpec) ValidateQueries() error {
+	var errs []error
+	for i, group := range in.Groups {
+		for j, rule := range group.Rules {
+			if err := queryvalidation.PromQL(rule.Expr); err != nil {
+				errs = append(errs, fmt.Errorf("spec.groups[%d].rules[%d].expr: %w", i, j, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
 }
 
 func expandRecordingRuleGroup(group RecordingRuleGroup) *recordingrules.InRuleGroup {
@@ -114,6 +135,19 @@ type RecordingRuleGroupSetStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (r *RecordingRuleGroupSet) GetConditions() []metav1.Condition {
@@ -132,6 +166,30 @@ func (r *RecordingRuleGroupSet) SetPrintableStatus(printableStatus string) {
 	r.Status.PrintableStatus = printableStatus
 }
 
+func (r *RecordingRuleGroupSet) GetLastHandledReconcileAt() string {
+	return r.Status.LastHandledReconcileAt
+}
+
+func (r *RecordingRuleGroupSet) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	r.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (r *RecordingRuleGroupSet) GetNextReconcileAt() *metav1.Time {
+	return r.Status.NextReconcileAt
+}
+
+func (r *RecordingRuleGroupSet) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	r.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (r *RecordingRuleGroupSet) GetURL() string {
+	return r.Status.URL
+}
+
+func (r *RecordingRuleGroupSet) SetURL(url string) {
+	r.Status.URL = url
+}
+
 func (r *RecordingRuleGroupSet) HasIDInStatus() bool {
 	return r.Status.ID != nil && *r.Status.ID != ""
 }
@@ -139,6 +197,7 @@ func (r *RecordingRuleGroupSet) HasIDInStatus() bool {
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+//+kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 //+kubebuilder:storageversion
 
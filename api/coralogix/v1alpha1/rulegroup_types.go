@@ -304,6 +304,53 @@ type RuleGroupSpec struct {
 	// for an example.
 	// +optional
 	RuleSubgroups []RuleSubGroup `json:"subgroups,omitempty"`
+
+	// Sample logs with their expected result, run by the operator against the rules before every remote
+	// create or update. The remote rule-group is not changed while any test fails, and the RemoteSynced condition
+	// has the RuleGroupTestsFailed reason until the spec changes.
+	// The tests can also be run locally with the rulegroup-test tool.
+	// +optional
+	Tests []RuleGroupTest `json:"tests,omitempty"`
+}
+
+// A sample log and the result the rule-group is expected to produce for it.
+// +kubebuilder:validation:XValidation:rule="!(has(self.expectBlocked) && self.expectBlocked && (has(self.expectedOutput) || has(self.expectedMetadata) || has(self.expectedTimestamp)))",message="expectBlocked conflicts with the other expectations"
+type RuleGroupTest struct {
+	// Name of the test.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Application name of the sample log. If not set, the log matches any of the rule-group applications.
+	// +optional
+	ApplicationName string `json:"applicationName,omitempty"`
+
+	// Subsystem name of the sample log. If not set, the log matches any of the rule-group subsystems.
+	// +optional
+	SubsystemName string `json:"subsystemName,omitempty"`
+
+	// Severity of the sample log. If not set, the log matches any of the rule-group severities.
+	// +optional
+	Severity *RuleSeverity `json:"severity,omitempty"`
+
+	// The sample log. A JSON object is processed as a structured log, anything else as plain text.
+	Input string `json:"input"`
+
+	// Whether the sample log is expected to be blocked.
+	// +optional
+	ExpectBlocked bool `json:"expectBlocked,omitempty"`
+
+	// The expected log after all rules were applied. JSON objects are compared regardless of key order and formatting.
+	// +optional
+	ExpectedOutput *string `json:"expectedOutput,omitempty"`
+
+	// The expected metadata fields extracted by jsonExtract rules, keyed by their destination field
+	// (Category, CLASSNAME, METHODNAME, THREADID or SEVERITY).
+	// +optional
+	ExpectedMetadata map[string]string `json:"expectedMetadata,omitempty"`
+
+	// The expected timestamp extracted by extractTimestamp rules, in RFC 3339 format.
+	// +optional
+	ExpectedTimestamp *string `json:"expectedTimestamp,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=Debug;Verbose;Info;Warning;Error;Critical
@@ -508,6 +555,36 @@ type RuleGroupStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Results of spec.tests against the current rules.
+	// +optional
+	Tests []RuleGroupTestResult `json:"tests,omitempty"`
+}
+
+// The result of a single rule-group test.
+type RuleGroupTestResult struct {
+	// Name of the test.
+	Name string `json:"name"`
+
+	// Whether the rule-group produced the expected result.
+	Passed bool `json:"passed"`
+
+	// Describes the mismatch between the expected and the actual result.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 func (r *RuleGroup) GetConditions() []metav1.Condition {
@@ -526,11 +603,36 @@ func (r *RuleGroup) SetPrintableStatus(printableStatus string) {
 	r.Status.PrintableStatus = printableStatus
 }
 
+func (r *RuleGroup) GetLastHandledReconcileAt() string {
+	return r.Status.LastHandledReconcileAt
+}
+
+func (r *RuleGroup) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	r.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (r *RuleGroup) GetNextReconcileAt() *metav1.Time {
+	return r.Status.NextReconcileAt
+}
+
+func (r *RuleGroup) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	r.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (r *RuleGroup) GetURL() string {
+	return r.Status.URL
+}
+
+func (r *RuleGroup) SetURL(url string) {
+	r.Status.URL = url
+}
+
 func (r *RuleGroup) HasIDInStatus() bool {
 	return r.Status.ID != nil && *r.Status.ID != ""
 }
 
 //+kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+//+kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
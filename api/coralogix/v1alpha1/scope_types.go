@@ -104,6 +104,19 @@ type ScopeStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (s *Scope) GetConditions() []metav1.Condition {
@@ -122,6 +135,30 @@ func (s *Scope) SetPrintableStatus(printableStatus string) {
 	s.Status.PrintableStatus = printableStatus
 }
 
+func (s *Scope) GetLastHandledReconcileAt() string {
+	return s.Status.LastHandledReconcileAt
+}
+
+func (s *Scope) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	s.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (s *Scope) GetNextReconcileAt() *metav1.Time {
+	return s.Status.NextReconcileAt
+}
+
+func (s *Scope) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	s.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (s *Scope) GetURL() string {
+	return s.Status.URL
+}
+
+func (s *Scope) SetURL(url string) {
+	s.Status.URL = url
+}
+
 func (s *Scope) HasIDInStatus() bool {
 	return s.Status.ID != nil && *s.Status.ID != ""
 }
@@ -129,6 +166,7 @@ func (s *Scope) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // Scope is the Schema for the scopes API.
 // See also https://coralogix.com/docs/user-guides/account-management/user-management/scopes/
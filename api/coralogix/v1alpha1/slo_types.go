@@ -17,12 +17,18 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	slos "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/slos_service"
+
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/queryvalidation"
 )
 
 var (
@@ -56,6 +62,63 @@ type SLOSpec struct {
 	Window SloWindow `json:"window"`
 	// TargetThresholdPercentage is the target threshold percentage for the SLO.
 	TargetThresholdPercentage resource.Quantity `json:"targetThresholdPercentage"`
+	// +optional
+	// Alerting generates Alerts on the burn rate and the remaining error budget of the SLO.
+	// The generated Alerts are owned by the SLO, kept in sync with it and deleted with it.
+	Alerting *SLOAlerting `json:"alerting,omitempty"`
+}
+
+// SLOAlerting defines the Alerts generated for an SLO.
+type SLOAlerting struct {
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// BurnRates generates an Alert for each burn-rate policy. For example, the multi-window policies of the
+	// Google SRE workbook page on a 14.4 burn rate over 1h and 5m, and on a 6 burn rate over 6h and 30m.
+	BurnRates []SLOBurnRateAlerting `json:"burnRates,omitempty"`
+	// +optional
+	// ErrorBudget generates an Alert when the remaining error budget drops below thresholds.
+	ErrorBudget *SLOErrorBudgetAlerting `json:"errorBudget,omitempty"`
+	// +optional
+	// NotificationGroup defines where the notifications of the generated Alerts are sent to.
+	NotificationGroup *v1beta1.NotificationGroup `json:"notificationGroup,omitempty"`
+	// +optional
+	// EntityLabels are added to the generated Alerts.
+	EntityLabels map[string]string `json:"entityLabels,omitempty"`
+}
+
+// SLOBurnRateAlerting defines a burn-rate Alert of an SLO.
+type SLOBurnRateAlerting struct {
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=30
+	// Name of the policy, appended to the name of the generated Alert.
+	Name string `json:"name"`
+	// +kubebuilder:validation:Minimum=1
+	// WindowHours is the window the burn rate is evaluated over, in hours.
+	WindowHours int `json:"windowHours"`
+	// +kubebuilder:default=true
+	// Dual also requires the burn rate to be crossed over a short window of 1/12 of the window, like 5m for
+	// a 1h window, so that the Alert resolves shortly after the burn stops.
+	Dual bool `json:"dual"`
+	// +kubebuilder:validation:MinItems=1
+	// Thresholds are the burn rates that trigger the Alert.
+	Thresholds []SLOAlertThreshold `json:"thresholds"`
+}
+
+// SLOErrorBudgetAlerting defines the error-budget Alert of an SLO.
+type SLOErrorBudgetAlerting struct {
+	// +kubebuilder:validation:MinItems=1
+	// Thresholds are the remaining error-budget percentages that trigger the Alert.
+	Thresholds []SLOAlertThreshold `json:"thresholds"`
+}
+
+// SLOAlertThreshold is a threshold of a generated Alert.
+type SLOAlertThreshold struct {
+	// Threshold to match to.
+	Threshold resource.Quantity `json:"threshold"`
+	// +kubebuilder:default=p1
+	// Priority of the Alert when the threshold is matched.
+	Priority v1beta1.AlertPriority `json:"priority"`
 }
 
 type SloGrouping struct {
@@ -142,11 +205,37 @@ type SLOStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// CreatedAt is the time the remote SLO was created.
+	// +optional
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the remote SLO was last updated.
+	// +optional
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+
+	// Namespace defaults merged into the spec of the SLO.
+	// +optional
+	AppliedDefaults *v1beta1.AppliedDefaults `json:"appliedDefaults,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // SLO is the Schema for the slos API.
 // See also https://coralogix.com/platform/apm/slo-management/
@@ -158,16 +247,17 @@ type SLO struct {
 	Status SLOStatus `json:"status,omitempty"`
 }
 
-func (s *SLO) ExtractSLOCreateRequest() (*slos.Slo1, error) {
-	if requestBasedMetricSli := s.Spec.SliType.RequestBasedMetricSli; requestBasedMetricSli != nil {
-		requestBased, err := s.Spec.ExtractRequestBasedMetricSli()
+func (s *SLO) ExtractSLOCreateRequest(defaults *SLODefaults) (*slos.Slo1, error) {
+	spec, _ := s.Spec.WithDefaults(defaults)
+	if requestBasedMetricSli := spec.SliType.RequestBasedMetricSli; requestBasedMetricSli != nil {
+		requestBased, err := spec.ExtractRequestBasedMetricSli()
 		if err != nil {
 			return nil, fmt.Errorf("error extracting request based metric SLI: %w", err)
 		}
 
 		return requestBased, nil
-	} else if windowBasedMetricSli := s.Spec.SliType.WindowBasedMetricSli; windowBasedMetricSli != nil {
-		windowBased, err := s.Spec.ExtractWindowBasedMetricSli()
+	} else if windowBasedMetricSli := spec.SliType.WindowBasedMetricSli; windowBasedMetricSli != nil {
+		windowBased, err := spec.ExtractWindowBasedMetricSli()
 		if err != nil {
 			return nil, fmt.Errorf("error extracting window based metric SLI: %w", err)
 		}
@@ -177,17 +267,18 @@ func (s *SLO) ExtractSLOCreateRequest() (*slos.Slo1, error) {
 	return nil, fmt.Errorf("sliType must be set to either requestBasedMetricSli or windowBasedMetricSli")
 }
 
-func (s *SLO) ExtractSLOUpdateRequest() (*slos.Slo1, error) {
-	if requestBasedMetricSli := s.Spec.SliType.RequestBasedMetricSli; requestBasedMetricSli != nil {
-		requestBased, err := s.Spec.ExtractRequestBasedMetricSli()
+func (s *SLO) ExtractSLOUpdateRequest(defaults *SLODefaults) (*slos.Slo1, error) {
+	spec, _ := s.Spec.WithDefaults(defaults)
+	if requestBasedMetricSli := spec.SliType.RequestBasedMetricSli; requestBasedMetricSli != nil {
+		requestBased, err := spec.ExtractRequestBasedMetricSli()
 		if err != nil {
 			return nil, fmt.Errorf("error extracting request based metric SLI: %w", err)
 		}
 
 		requestBased.Id = s.Status.ID
 		return requestBased, nil
-	} else if windowBasedMetricSli := s.Spec.SliType.WindowBasedMetricSli; windowBasedMetricSli != nil {
-		windowBased, err := s.Spec.ExtractWindowBasedMetricSli()
+	} else if windowBasedMetricSli := spec.SliType.WindowBasedMetricSli; windowBasedMetricSli != nil {
+		windowBased, err := spec.ExtractWindowBasedMetricSli()
 		if err != nil {
 			return nil, fmt.Errorf("error extracting window based metric SLI: %w", err)
 		}
@@ -199,7 +290,42 @@ func (s *SLO) ExtractSLOUpdateRequest() (*slos.Slo1, error) {
 	return nil, fmt.Errorf("sliType must be set to either requestBasedMetricSli or windowBasedMetricSli")
 }
 
+// WithDefaults returns a copy of the spec with the default labels merged in, and the defaults that were applied.
+// Labels set on the SLO take precedence over the default ones.
+func (s *SLOSpec) WithDefaults(defaults *SLODefaults) (*SLOSpec, *v1beta1.AppliedDefaults) {
+	if defaults == nil {
+		return s, nil
+	}
+
+	out := s.DeepCopy()
+	var labels map[string]string
+	if out.Labels != nil {
+		labels = *out.Labels
+	}
+	var fields []string
+	for _, key := range slices.Sorted(maps.Keys(defaults.Labels)) {
+		if _, found := labels[key]; found {
+			continue
+		}
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[key] = defaults.Labels[key]
+		fields = append(fields, "labels."+key)
+	}
+
+	if len(fields) == 0 {
+		return out, nil
+	}
+	out.Labels = &labels
+	return out, &v1beta1.AppliedDefaults{Sources: defaults.Sources, Fields: fields}
+}
+
 func (s *SLOSpec) ExtractRequestBasedMetricSli() (*slos.Slo1, error) {
+	if err := s.ValidateQueries(); err != nil {
+		return nil, err
+	}
+
 	timeFrame, err := s.Window.ExpandTimeFrame()
 	if err != nil {
 		return nil, fmt.Errorf("error expanding time frame: %w", err)
@@ -223,6 +349,10 @@ func (s *SLOSpec) ExtractRequestBasedMetricSli() (*slos.Slo1, error) {
 }
 
 func (s *SLOSpec) ExtractWindowBasedMetricSli() (*slos.Slo1, error) {
+	if err := s.ValidateQueries(); err != nil {
+		return nil, err
+	}
+
 	timeFrame, err := s.Window.ExpandTimeFrame()
 	if err != nil {
 		return nil, fmt.Errorf("error expanding time frame: %w", err)
@@ -245,6 +375,25 @@ func (s *SLOSpec) ExtractWindowBasedMetricSli() (*slos.Slo1, error) {
 	}, nil
 }
 
+// ValidateQueries checks the PromQL queries of the SLI.
+func (s *SLOSpec) ValidateQueries() error {
+	var errs []error
+	if requestBased := s.SliType.RequestBasedMetricSli; requestBased != nil {
+		if err := queryvalidation.PromQL(requestBased.GoodEvents.Query); err != nil {
+			errs = append(errs, fmt.Errorf("spec.sliType.requestBasedMetric.goodEvents.query: %w", err))
+		}
+		if err := queryvalidation.PromQL(requestBased.TotalEvents.Query); err != nil {
+			errs = append(errs, fmt.Errorf("spec.sliType.requestBasedMetric.totalEvents.query: %w", err))
+		}
+	}
+	if windowBased := s.SliType.WindowBasedMetricSli; windowBased != nil && windowBased.Query != nil {
+		if err := queryvalidation.PromQL(windowBased.Query.Query); err != nil {
+			errs = append(errs, fmt.Errorf("spec.sliType.windowBasedMetric.query.query: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func (w *SloWindow) ExpandTimeFrame() (*slos.SloTimeFrame, error) {
 	if w.TimeFrame != nil {
 		tf, ok := sloTimeFrameSchemaToOpenAPI[*w.TimeFrame]
@@ -273,6 +422,30 @@ func (s *SLO) SetPrintableStatus(status string) {
 	s.Status.PrintableStatus = status
 }
 
+func (s *SLO) GetLastHandledReconcileAt() string {
+	return s.Status.LastHandledReconcileAt
+}
+
+func (s *SLO) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	s.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (s *SLO) GetNextReconcileAt() *metav1.Time {
+	return s.Status.NextReconcileAt
+}
+
+func (s *SLO) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	s.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (s *SLO) GetURL() string {
+	return s.Status.URL
+}
+
+func (s *SLO) SetURL(url string) {
+	s.Status.URL = url
+}
+
 func (s *SLO) HasIDInStatus() bool {
 	return s.Status.ID != nil && *s.Status.ID != ""
 }
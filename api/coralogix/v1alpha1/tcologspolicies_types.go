@@ -33,6 +33,12 @@ import (
 type TCOLogsPoliciesSpec struct {
 	// Coralogix TCO-Policies-List.
 	Policies []TCOLogsPolicy `json:"policies"`
+
+	// Order of this resource's policies in the composed list, when the operator runs with
+	// policies composition enabled. Resources with a lower order come first; ties are broken by
+	// namespace and name. Ignored otherwise.
+	// +optional
+	Order *int32 `json:"order,omitempty"`
 }
 
 // A TCO policy for logs.
@@ -344,6 +350,41 @@ type TCOLogsPoliciesStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Policies reports, when policies composition is enabled, whether each policy of this resource
+	// is part of the composed list and at what position.
+	// +optional
+	Policies []TCOPolicyStatus `json:"policies,omitempty"`
+}
+
+// TCOPolicyStatus is the composition result of a single TCO policy.
+type TCOPolicyStatus struct {
+	// Name of the policy.
+	Name string `json:"name"`
+
+	// Active is true if the policy is part of the composed list sent to Coralogix.
+	Active bool `json:"active"`
+
+	// Position is the zero-based index of the policy in the composed list, if active.
+	// +optional
+	Position *int32 `json:"position,omitempty"`
+
+	// Message explains why the policy is not active.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 func (t *TCOLogsPolicies) GetConditions() []metav1.Condition {
@@ -362,6 +403,30 @@ func (t *TCOLogsPolicies) SetPrintableStatus(printableStatus string) {
 	t.Status.PrintableStatus = printableStatus
 }
 
+func (t *TCOLogsPolicies) GetLastHandledReconcileAt() string {
+	return t.Status.LastHandledReconcileAt
+}
+
+func (t *TCOLogsPolicies) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	t.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (t *TCOLogsPolicies) GetNextReconcileAt() *metav1.Time {
+	return t.Status.NextReconcileAt
+}
+
+func (t *TCOLogsPolicies) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	t.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (t *TCOLogsPolicies) GetURL() string {
+	return t.Status.URL
+}
+
+func (t *TCOLogsPolicies) SetURL(url string) {
+	t.Status.URL = url
+}
+
 func (t *TCOLogsPolicies) HasIDInStatus() bool {
 	return true
 }
@@ -369,6 +434,7 @@ func (t *TCOLogsPolicies) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // TCOLogsPolicies is the Schema for the TCOLogsPolicies API.
 // NOTE: This resource performs an atomic overwrite of all existing TCO logs policies
@@ -377,6 +443,11 @@ func (t *TCOLogsPolicies) HasIDInStatus() bool {
 //
 // See also https://coralogix.com/docs/tco-optimizer-api
 //
+// Only the oldest selected TCOLogsPolicies resource in the cluster is applied to the account;
+// any other one reports a Conflict condition naming it and is ignored. When the operator runs with
+// `--tco-policies-composition`, the policies of all selected resources are instead merged by `spec.order`
+// into a single overwrite.
+//
 // **Added in v0.4.0**
 type TCOLogsPolicies struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -30,6 +30,12 @@ type TCORumPoliciesSpec struct {
 	// Coralogix TCO-Policies-List.
 	// +kubebuilder:validation:MaxItems=10000
 	Policies []TCORumPolicy `json:"policies"`
+
+	// Order of this resource's policies in the composed list, when the operator runs with
+	// policies composition enabled. Resources with a lower order come first; ties are broken by
+	// namespace and name. Ignored otherwise.
+	// +optional
+	Order *int32 `json:"order,omitempty"`
 }
 
 // A TCO policy for RUM (browser/mobile) events.
@@ -155,6 +161,24 @@ type TCORumPoliciesStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Policies reports, when policies composition is enabled, whether each policy of this resource
+	// is part of the composed list and at what position.
+	// +optional
+	Policies []TCOPolicyStatus `json:"policies,omitempty"`
 }
 
 func (t *TCORumPolicies) GetConditions() []metav1.Condition {
@@ -173,6 +197,30 @@ func (t *TCORumPolicies) SetPrintableStatus(printableStatus string) {
 	t.Status.PrintableStatus = printableStatus
 }
 
+func (t *TCORumPolicies) GetLastHandledReconcileAt() string {
+	return t.Status.LastHandledReconcileAt
+}
+
+func (t *TCORumPolicies) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	t.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (t *TCORumPolicies) GetNextReconcileAt() *metav1.Time {
+	return t.Status.NextReconcileAt
+}
+
+func (t *TCORumPolicies) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	t.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (t *TCORumPolicies) GetURL() string {
+	return t.Status.URL
+}
+
+func (t *TCORumPolicies) SetURL(url string) {
+	t.Status.URL = url
+}
+
 func (t *TCORumPolicies) HasIDInStatus() bool {
 	return true
 }
@@ -180,6 +228,7 @@ func (t *TCORumPolicies) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // TCORumPolicies is the Schema for the TCORumPolicies API.
 // NOTE: This resource performs an atomic overwrite of all existing TCO RUM policies
@@ -188,6 +237,11 @@ func (t *TCORumPolicies) HasIDInStatus() bool {
 //
 // See also https://coralogix.com/docs/tco-optimizer-api
 //
+// Only the oldest selected TCORumPolicies resource in the cluster is applied to the account;
+// any other one reports a Conflict condition naming it and is ignored. When the operator runs with
+// `--tco-policies-composition`, the policies of all selected resources are instead merged by `spec.order`
+// into a single overwrite.
+//
 // **Added in v0.5.0**
 type TCORumPolicies struct {
 	metav1.TypeMeta   `json:",inline"`
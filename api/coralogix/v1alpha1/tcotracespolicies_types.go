@@ -30,6 +30,12 @@ import (
 type TCOTracesPoliciesSpec struct {
 	// Coralogix TCO-Policies-List.
 	Policies []TCOTracesPolicy `json:"policies"`
+
+	// Order of this resource's policies in the composed list, when the operator runs with
+	// policies composition enabled. Resources with a lower order come first; ties are broken by
+	// namespace and name. Ignored otherwise.
+	// +optional
+	Order *int32 `json:"order,omitempty"`
 }
 
 // Coralogix TCO policy for traces.
@@ -177,6 +183,24 @@ type TCOTracesPoliciesStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Policies reports, when policies composition is enabled, whether each policy of this resource
+	// is part of the composed list and at what position.
+	// +optional
+	Policies []TCOPolicyStatus `json:"policies,omitempty"`
 }
 
 func (t *TCOTracesPolicies) GetConditions() []metav1.Condition {
@@ -199,7 +223,32 @@ func (t *TCOTracesPolicies) SetPrintableStatus(printableStatus string) {
 	t.Status.PrintableStatus = printableStatus
 }
 
+func (t *TCOTracesPolicies) GetLastHandledReconcileAt() string {
+	return t.Status.LastHandledReconcileAt
+}
+
+func (t *TCOTracesPolicies) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	t.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (t *TCOTracesPolicies) GetNextReconcileAt() *metav1.Time {
+	return t.Status.NextReconcileAt
+}
+
+func (t *TCOTracesPolicies) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	t.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (t *TCOTracesPolicies) GetURL() string {
+	return t.Status.URL
+}
+
+func (t *TCOTracesPolicies) SetURL(url string) {
+	t.Status.URL = url
+}
+
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
@@ -211,6 +260,11 @@ func (t *TCOTracesPolicies) SetPrintableStatus(printableStatus string) {
 //
 // See also https://coralogix.com/docs/tco-optimizer-api
 //
+// Only the oldest selected TCOTracesPolicies resource in the cluster is applied to the account;
+// any other one reports a Conflict condition naming it and is ignored. When the operator runs with
+// `--tco-policies-composition`, the policies of all selected resources are instead merged by `spec.order`
+// into a single overwrite.
+//
 // **Added in v0.4.0**
 type TCOTracesPolicies struct {
 	metav1.TypeMeta   `json:",inline"`
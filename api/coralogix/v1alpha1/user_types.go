@@ -0,0 +1,176 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cxsdk "github.com/coralogix/coralogix-management-sdk/go"
+)
+
+// UserSpec defines the desired state of a Coralogix User, provisioned through SCIM.
+type UserSpec struct {
+	//+kubebuilder:validation:MinLength=1
+	// User name the user logs in with, usually their email address.
+	UserName string `json:"userName"`
+
+	// Email address of the user. Defaults to the user name.
+	// +optional
+	Email *string `json:"email,omitempty"`
+
+	// Given and family names of the user.
+	// +optional
+	Name *UserFullName `json:"name,omitempty"`
+
+	//+kubebuilder:default=true
+	// Whether the user is active. Setting it to false deactivates the user without deleting it.
+	// +optional
+	Active bool `json:"active"`
+}
+
+// UserFullName is the full name of a user.
+type UserFullName struct {
+	// Given name of the user.
+	GivenName string `json:"givenName"`
+
+	// Family name of the user.
+	FamilyName string `json:"familyName"`
+}
+
+// ExtractSCIMUser returns the SCIM user for the spec, with the given remote ID if it already exists.
+func (s *UserSpec) ExtractSCIMUser(id *string) *cxsdk.SCIMUser {
+	email := s.UserName
+	if s.Email != nil {
+		email = *s.Email
+	}
+
+	var name *cxsdk.SCIMUserName
+	if s.Name != nil {
+		name = &cxsdk.SCIMUserName{
+			GivenName:  s.Name.GivenName,
+			FamilyName: s.Name.FamilyName,
+		}
+	}
+
+	return &cxsdk.SCIMUser{
+		Schemas:  []string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+		ID:       id,
+		UserName: s.UserName,
+		Active:   s.Active,
+		Name:     name,
+		Emails: []cxsdk.SCIMUserEmail{
+			{Value: email, Primary: true, Type: "work"},
+		},
+	}
+}
+
+// UserStatus defines the observed state of a Coralogix User.
+type UserStatus struct {
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+func (u *User) GetConditions() []metav1.Condition {
+	return u.Status.Conditions
+}
+
+func (u *User) SetConditions(conditions []metav1.Condition) {
+	u.Status.Conditions = conditions
+}
+
+func (u *User) GetPrintableStatus() string {
+	return u.Status.PrintableStatus
+}
+
+func (u *User) SetPrintableStatus(printableStatus string) {
+	u.Status.PrintableStatus = printableStatus
+}
+
+func (u *User) GetLastHandledReconcileAt() string {
+	return u.Status.LastHandledReconcileAt
+}
+
+func (u *User) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	u.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (u *User) GetNextReconcileAt() *metav1.Time {
+	return u.Status.NextReconcileAt
+}
+
+func (u *User) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	u.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (u *User) GetURL() string {
+	return u.Status.URL
+}
+
+func (u *User) SetURL(url string) {
+	u.Status.URL = url
+}
+
+func (u *User) HasIDInStatus() bool {
+	return u.Status.ID != nil && *u.Status.ID != ""
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="User Name",type="string",JSONPath=".spec.userName"
+// +kubebuilder:printcolumn:name="Active",type="boolean",JSONPath=".spec.active"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// User is the Schema for the Users API. Users are provisioned through SCIM, so that they can be added to
+// Groups before they first log in. Deleting a User deactivates the Coralogix user, which is never deleted.
+// See also https://coralogix.com/docs/user-guides/account-management/user-management/scim/
+type User struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserSpec   `json:"spec,omitempty"`
+	Status UserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserList contains a list of Users.
+type UserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []User `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&User{}, &UserList{})
+}
@@ -217,6 +217,19 @@ type ViewStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (v *View) GetConditions() []metav1.Condition {
@@ -235,6 +248,30 @@ func (v *View) SetPrintableStatus(printableStatus string) {
 	v.Status.PrintableStatus = printableStatus
 }
 
+func (v *View) GetLastHandledReconcileAt() string {
+	return v.Status.LastHandledReconcileAt
+}
+
+func (v *View) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	v.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (v *View) GetNextReconcileAt() *metav1.Time {
+	return v.Status.NextReconcileAt
+}
+
+func (v *View) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	v.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (v *View) GetURL() string {
+	return v.Status.URL
+}
+
+func (v *View) SetURL(url string) {
+	v.Status.URL = url
+}
+
 func (v *View) HasIDInStatus() bool {
 	return v.Status.ID != nil && *v.Status.ID != ""
 }
@@ -242,6 +279,7 @@ func (v *View) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // View is the Schema for the Views API.
@@ -33,6 +33,19 @@ type ViewFolderStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 func (v *ViewFolder) GetConditions() []metav1.Condition {
@@ -51,6 +64,30 @@ func (v *ViewFolder) SetPrintableStatus(printableStatus string) {
 	v.Status.PrintableStatus = printableStatus
 }
 
+func (v *ViewFolder) GetLastHandledReconcileAt() string {
+	return v.Status.LastHandledReconcileAt
+}
+
+func (v *ViewFolder) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	v.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (v *ViewFolder) GetNextReconcileAt() *metav1.Time {
+	return v.Status.NextReconcileAt
+}
+
+func (v *ViewFolder) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	v.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (v *ViewFolder) GetURL() string {
+	return v.Status.URL
+}
+
+func (v *ViewFolder) SetURL(url string) {
+	v.Status.URL = url
+}
+
 func (v *ViewFolder) HasIDInStatus() bool {
 	return v.Status.ID != nil && *v.Status.ID != ""
 }
@@ -58,6 +95,7 @@ func (v *ViewFolder) HasIDInStatus() bool {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // ViewFolder is the Schema for the viewfolders API.
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -102,6 +103,11 @@ func (in *AICustomEvaluationCriterion) DeepCopyInto(out *AICustomEvaluationCrite
 		*out = new(string)
 		**out = **in
 	}
+	if in.FlagsFrom != nil {
+		in, out := &in.FlagsFrom, &out.FlagsFrom
+		*out = new(AICustomEvaluationValueSource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Examples != nil {
 		in, out := &in.Examples, &out.Examples
 		*out = make([]string, len(*in))
@@ -151,6 +157,22 @@ func (in *AICustomEvaluationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AICustomEvaluationRevision) DeepCopyInto(out *AICustomEvaluationRevision) {
+	*out = *in
+	in.AppliedAt.DeepCopyInto(&out.AppliedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AICustomEvaluationRevision.
+func (in *AICustomEvaluationRevision) DeepCopy() *AICustomEvaluationRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(AICustomEvaluationRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AICustomEvaluationSpec) DeepCopyInto(out *AICustomEvaluationSpec) {
 	*out = *in
@@ -159,6 +181,11 @@ func (in *AICustomEvaluationSpec) DeepCopyInto(out *AICustomEvaluationSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.InstructionsFrom != nil {
+		in, out := &in.InstructionsFrom, &out.InstructionsFrom
+		*out = new(AICustomEvaluationValueSource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ShouldIncludeSystemPrompt != nil {
 		in, out := &in.ShouldIncludeSystemPrompt, &out.ShouldIncludeSystemPrompt
 		*out = new(bool)
@@ -201,6 +228,17 @@ func (in *AICustomEvaluationStatus) DeepCopyInto(out *AICustomEvaluationStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]AICustomEvaluationRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AICustomEvaluationStatus.
@@ -213,6 +251,22 @@ func (in *AICustomEvaluationStatus) DeepCopy() *AICustomEvaluationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AICustomEvaluationValueSource) DeepCopyInto(out *AICustomEvaluationValueSource) {
+	*out = *in
+	in.ConfigMapKeyRef.DeepCopyInto(&out.ConfigMapKeyRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AICustomEvaluationValueSource.
+func (in *AICustomEvaluationValueSource) DeepCopy() *AICustomEvaluationValueSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AICustomEvaluationValueSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIEvaluation) DeepCopyInto(out *AIEvaluation) {
 	*out = *in
@@ -567,6 +621,162 @@ func (in *AIEvaluationSQLRestrictedTablesConfig) DeepCopy() *AIEvaluationSQLRest
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIEvaluationSet) DeepCopyInto(out *AIEvaluationSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIEvaluationSet.
+func (in *AIEvaluationSet) DeepCopy() *AIEvaluationSet {
+	if in == nil {
+		return nil
+	}
+	out := new(AIEvaluationSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AIEvaluationSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIEvaluationSetItem) DeepCopyInto(out *AIEvaluationSetItem) {
+	*out = *in
+	out.Threshold = in.Threshold.DeepCopy()
+	if in.IsEnabled != nil {
+		in, out := &in.IsEnabled, &out.IsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.Config.DeepCopyInto(&out.Config)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIEvaluationSetItem.
+func (in *AIEvaluationSetItem) DeepCopy() *AIEvaluationSetItem {
+	if in == nil {
+		return nil
+	}
+	out := new(AIEvaluationSetItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIEvaluationSetItemStatus) DeepCopyInto(out *AIEvaluationSetItemStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIEvaluationSetItemStatus.
+func (in *AIEvaluationSetItemStatus) DeepCopy() *AIEvaluationSetItemStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AIEvaluationSetItemStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIEvaluationSetList) DeepCopyInto(out *AIEvaluationSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AIEvaluationSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIEvaluationSetList.
+func (in *AIEvaluationSetList) DeepCopy() *AIEvaluationSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(AIEvaluationSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AIEvaluationSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIEvaluationSetSpec) DeepCopyInto(out *AIEvaluationSetSpec) {
+	*out = *in
+	if in.Evaluations != nil {
+		in, out := &in.Evaluations, &out.Evaluations
+		*out = make([]AIEvaluationSetItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIEvaluationSetSpec.
+func (in *AIEvaluationSetSpec) DeepCopy() *AIEvaluationSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AIEvaluationSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIEvaluationSetStatus) DeepCopyInto(out *AIEvaluationSetStatus) {
+	*out = *in
+	if in.Evaluations != nil {
+		in, out := &in.Evaluations, &out.Evaluations
+		*out = make([]AIEvaluationSetItemStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIEvaluationSetStatus.
+func (in *AIEvaluationSetStatus) DeepCopy() *AIEvaluationSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AIEvaluationSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIEvaluationSpec) DeepCopyInto(out *AIEvaluationSpec) {
 	*out = *in
@@ -604,6 +814,10 @@ func (in *AIEvaluationStatus) DeepCopyInto(out *AIEvaluationStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIEvaluationStatus.
@@ -649,6 +863,11 @@ func (in *AlertRef) DeepCopyInto(out *AlertRef) {
 		*out = new(ResourceRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AlertSetRef != nil {
+		in, out := &in.AlertSetRef, &out.AlertSetRef
+		*out = new(ResourceRef)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRef.
@@ -759,6 +978,10 @@ func (in *AlertSchedulerStatus) DeepCopyInto(out *AlertSchedulerStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSchedulerStatus.
@@ -822,6 +1045,16 @@ func (in *AlertSetItemStatus) DeepCopyInto(out *AlertSetItemStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AppliedDefaults != nil {
+		in, out := &in.AppliedDefaults, &out.AppliedDefaults
+		*out = new(v1beta1.AppliedDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(v1beta1.AlertRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSetItemStatus.
@@ -905,6 +1138,10 @@ func (in *AlertSetStatus) DeepCopyInto(out *AlertSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSetStatus.
@@ -1062,6 +1299,10 @@ func (in *ApiKeyStatus) DeepCopyInto(out *ApiKeyStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiKeyStatus.
@@ -1173,6 +1414,10 @@ func (in *ArchiveLogsTargetStatus) DeepCopyInto(out *ArchiveLogsTargetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveLogsTargetStatus.
@@ -1289,6 +1534,10 @@ func (in *ArchiveMetricsTargetStatus) DeepCopyInto(out *ArchiveMetricsTargetStat
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveMetricsTargetStatus.
@@ -1578,6 +1827,10 @@ func (in *ConnectorStatus) DeepCopyInto(out *ConnectorStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectorStatus.
@@ -1591,26 +1844,25 @@ func (in *ConnectorStatus) DeepCopy() *ConnectorStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomEnrichment) DeepCopyInto(out *CustomEnrichment) {
+func (in *CoralogixDefaults) DeepCopyInto(out *CoralogixDefaults) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichment.
-func (in *CustomEnrichment) DeepCopy() *CustomEnrichment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoralogixDefaults.
+func (in *CoralogixDefaults) DeepCopy() *CoralogixDefaults {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomEnrichment)
+	out := new(CoralogixDefaults)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CustomEnrichment) DeepCopyObject() runtime.Object {
+func (in *CoralogixDefaults) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1618,46 +1870,31 @@ func (in *CustomEnrichment) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomEnrichmentBackendRef) DeepCopyInto(out *CustomEnrichmentBackendRef) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentBackendRef.
-func (in *CustomEnrichmentBackendRef) DeepCopy() *CustomEnrichmentBackendRef {
-	if in == nil {
-		return nil
-	}
-	out := new(CustomEnrichmentBackendRef)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomEnrichmentList) DeepCopyInto(out *CustomEnrichmentList) {
+func (in *CoralogixDefaultsList) DeepCopyInto(out *CoralogixDefaultsList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CustomEnrichment, len(*in))
+		*out = make([]CoralogixDefaults, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentList.
-func (in *CustomEnrichmentList) DeepCopy() *CustomEnrichmentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoralogixDefaultsList.
+func (in *CoralogixDefaultsList) DeepCopy() *CoralogixDefaultsList {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomEnrichmentList)
+	out := new(CoralogixDefaultsList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CustomEnrichmentList) DeepCopyObject() runtime.Object {
+func (in *CoralogixDefaultsList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1665,76 +1902,560 @@ func (in *CustomEnrichmentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomEnrichmentRef) DeepCopyInto(out *CustomEnrichmentRef) {
+func (in *CoralogixDefaultsSpec) DeepCopyInto(out *CoralogixDefaultsSpec) {
 	*out = *in
-	if in.BackendRef != nil {
-		in, out := &in.BackendRef, &out.BackendRef
-		*out = new(CustomEnrichmentBackendRef)
-		**out = **in
+	if in.NotificationGroup != nil {
+		in, out := &in.NotificationGroup, &out.NotificationGroup
+		*out = new(v1beta1.NotificationGroup)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ResourceRef != nil {
-		in, out := &in.ResourceRef, &out.ResourceRef
-		*out = new(ResourceRef)
+	if in.EntityLabels != nil {
+		in, out := &in.EntityLabels, &out.EntityLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RoutingLabels != nil {
+		in, out := &in.RoutingLabels, &out.RoutingLabels
+		*out = new(RoutingLabels)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DataSources != nil {
+		in, out := &in.DataSources, &out.DataSources
+		*out = make([]v1beta1.AlertDataSource, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentRef.
-func (in *CustomEnrichmentRef) DeepCopy() *CustomEnrichmentRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoralogixDefaultsSpec.
+func (in *CoralogixDefaultsSpec) DeepCopy() *CoralogixDefaultsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomEnrichmentRef)
+	out := new(CoralogixDefaultsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomEnrichmentSpec) DeepCopyInto(out *CustomEnrichmentSpec) {
+func (in *CoralogixTenant) DeepCopyInto(out *CoralogixTenant) {
 	*out = *in
-	if in.CSV != nil {
-		in, out := &in.CSV, &out.CSV
-		*out = new(string)
-		**out = **in
-	}
-	if in.ConfigMapRef != nil {
-		in, out := &in.ConfigMapRef, &out.ConfigMapRef
-		*out = new(corev1.ConfigMapKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentSpec.
-func (in *CustomEnrichmentSpec) DeepCopy() *CustomEnrichmentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoralogixTenant.
+func (in *CoralogixTenant) DeepCopy() *CoralogixTenant {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomEnrichmentSpec)
+	out := new(CoralogixTenant)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomEnrichmentStatus) DeepCopyInto(out *CustomEnrichmentStatus) {
-	*out = *in
-	if in.Id != nil {
-		in, out := &in.Id, &out.Id
-		*out = new(string)
-		**out = **in
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CoralogixTenant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentStatus.
-func (in *CustomEnrichmentStatus) DeepCopy() *CustomEnrichmentStatus {
-	if in == nil {
-		return nil
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoralogixTenantApiKey) DeepCopyInto(out *CoralogixTenantApiKey) {
+	*out = *in
+	if in.Presets != nil {
+		in, out := &in.Presets, &out.Presets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoralogixTenantApiKey.
+func (in *CoralogixTenantApiKey) DeepCopy() *CoralogixTenantApiKey {
+	if in == nil {
+		return nil
+	}
+	out := new(CoralogixTenantApiKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoralogixTenantFilters) DeepCopyInto(out *CoralogixTenantFilters) {
+	*out = *in
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subsystems != nil {
+		in, out := &in.Subsystems, &out.Subsystems
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoralogixTenantFilters.
+func (in *CoralogixTenantFilters) DeepCopy() *CoralogixTenantFilters {
+	if in == nil {
+		return nil
+	}
+	out := new(CoralogixTenantFilters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoralogixTenantList) DeepCopyInto(out *CoralogixTenantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CoralogixTenant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoralogixTenantList.
+func (in *CoralogixTenantList) DeepCopy() *CoralogixTenantList {
+	if in == nil {
+		return nil
+	}
+	out := new(CoralogixTenantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CoralogixTenantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoralogixTenantResourceStatus) DeepCopyInto(out *CoralogixTenantResourceStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoralogixTenantResourceStatus.
+func (in *CoralogixTenantResourceStatus) DeepCopy() *CoralogixTenantResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CoralogixTenantResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoralogixTenantRole) DeepCopyInto(out *CoralogixTenantRole) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoralogixTenantRole.
+func (in *CoralogixTenantRole) DeepCopy() *CoralogixTenantRole {
+	if in == nil {
+		return nil
+	}
+	out := new(CoralogixTenantRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoralogixTenantSpec) DeepCopyInto(out *CoralogixTenantSpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = new(CoralogixTenantFilters)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Role.DeepCopyInto(&out.Role)
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]Member, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApiKey != nil {
+		in, out := &in.ApiKey, &out.ApiKey
+		*out = new(CoralogixTenantApiKey)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoralogixTenantSpec.
+func (in *CoralogixTenantSpec) DeepCopy() *CoralogixTenantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CoralogixTenantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoralogixTenantStatus) DeepCopyInto(out *CoralogixTenantStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]CoralogixTenantResourceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoralogixTenantStatus.
+func (in *CoralogixTenantStatus) DeepCopy() *CoralogixTenantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CoralogixTenantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomEnrichment) DeepCopyInto(out *CustomEnrichment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichment.
+func (in *CustomEnrichment) DeepCopy() *CustomEnrichment {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomEnrichment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomEnrichment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomEnrichmentBackendRef) DeepCopyInto(out *CustomEnrichmentBackendRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentBackendRef.
+func (in *CustomEnrichmentBackendRef) DeepCopy() *CustomEnrichmentBackendRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomEnrichmentBackendRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomEnrichmentColumn) DeepCopyInto(out *CustomEnrichmentColumn) {
+	*out = *in
+	if in.Field != nil {
+		in, out := &in.Field, &out.Field
+		*out = new(CustomEnrichmentField)
+		**out = **in
+	}
+	if in.Label != nil {
+		in, out := &in.Label, &out.Label
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotation != nil {
+		in, out := &in.Annotation, &out.Annotation
+		*out = new(string)
+		**out = **in
+	}
+	if in.NamespaceLabel != nil {
+		in, out := &in.NamespaceLabel, &out.NamespaceLabel
+		*out = new(string)
+		**out = **in
+	}
+	if in.NamespaceAnnotation != nil {
+		in, out := &in.NamespaceAnnotation, &out.NamespaceAnnotation
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentColumn.
+func (in *CustomEnrichmentColumn) DeepCopy() *CustomEnrichmentColumn {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomEnrichmentColumn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomEnrichmentGenerator) DeepCopyInto(out *CustomEnrichmentGenerator) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]CustomEnrichmentColumn, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Debounce != nil {
+		in, out := &in.Debounce, &out.Debounce
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentGenerator.
+func (in *CustomEnrichmentGenerator) DeepCopy() *CustomEnrichmentGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomEnrichmentGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomEnrichmentList) DeepCopyInto(out *CustomEnrichmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CustomEnrichment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentList.
+func (in *CustomEnrichmentList) DeepCopy() *CustomEnrichmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomEnrichmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomEnrichmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomEnrichmentRef) DeepCopyInto(out *CustomEnrichmentRef) {
+	*out = *in
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(CustomEnrichmentBackendRef)
+		**out = **in
+	}
+	if in.ResourceRef != nil {
+		in, out := &in.ResourceRef, &out.ResourceRef
+		*out = new(ResourceRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentRef.
+func (in *CustomEnrichmentRef) DeepCopy() *CustomEnrichmentRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomEnrichmentRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomEnrichmentSchema) DeepCopyInto(out *CustomEnrichmentSchema) {
+	*out = *in
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RequiredHeaders != nil {
+		in, out := &in.RequiredHeaders, &out.RequiredHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentSchema.
+func (in *CustomEnrichmentSchema) DeepCopy() *CustomEnrichmentSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomEnrichmentSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomEnrichmentSource) DeepCopyInto(out *CustomEnrichmentSource) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentSource.
+func (in *CustomEnrichmentSource) DeepCopy() *CustomEnrichmentSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomEnrichmentSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomEnrichmentSpec) DeepCopyInto(out *CustomEnrichmentSpec) {
+	*out = *in
+	if in.CSV != nil {
+		in, out := &in.CSV, &out.CSV
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]CustomEnrichmentSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Generator != nil {
+		in, out := &in.Generator, &out.Generator
+		*out = new(CustomEnrichmentGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(CustomEnrichmentSchema)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentSpec.
+func (in *CustomEnrichmentSpec) DeepCopy() *CustomEnrichmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomEnrichmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomEnrichmentStatus) DeepCopyInto(out *CustomEnrichmentStatus) {
+	*out = *in
+	if in.Id != nil {
+		in, out := &in.Id, &out.Id
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+	if in.RowCount != nil {
+		in, out := &in.RowCount, &out.RowCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEnrichmentStatus.
+func (in *CustomEnrichmentStatus) DeepCopy() *CustomEnrichmentStatus {
+	if in == nil {
+		return nil
 	}
 	out := new(CustomEnrichmentStatus)
 	in.DeepCopyInto(out)
@@ -1861,6 +2582,10 @@ func (in *CustomRoleStatus) DeepCopyInto(out *CustomRoleStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRoleStatus.
@@ -2037,6 +2762,21 @@ func (in *DashboardSpec) DeepCopyInto(out *DashboardSpec) {
 		*out = new(corev1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GrafanaJson != nil {
+		in, out := &in.GrafanaJson, &out.GrafanaJson
+		*out = new(string)
+		**out = **in
+	}
+	if in.GrafanaConfigMapRef != nil {
+		in, out := &in.GrafanaConfigMapRef, &out.GrafanaConfigMapRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(DashboardTemplateRef)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FolderRef != nil {
 		in, out := &in.FolderRef, &out.FolderRef
 		*out = new(DashboardFolderRef)
@@ -2069,6 +2809,15 @@ func (in *DashboardStatus) DeepCopyInto(out *DashboardStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UnsupportedPanels != nil {
+		in, out := &in.UnsupportedPanels, &out.UnsupportedPanels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardStatus.
@@ -2081,6 +2830,203 @@ func (in *DashboardStatus) DeepCopy() *DashboardStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardTemplate) DeepCopyInto(out *DashboardTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardTemplate.
+func (in *DashboardTemplate) DeepCopy() *DashboardTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DashboardTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardTemplateGenerator) DeepCopyInto(out *DashboardTemplateGenerator) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LabelParameters != nil {
+		in, out := &in.LabelParameters, &out.LabelParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FolderRef != nil {
+		in, out := &in.FolderRef, &out.FolderRef
+		*out = new(DashboardFolderRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardTemplateGenerator.
+func (in *DashboardTemplateGenerator) DeepCopy() *DashboardTemplateGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardTemplateGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardTemplateList) DeepCopyInto(out *DashboardTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DashboardTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardTemplateList.
+func (in *DashboardTemplateList) DeepCopy() *DashboardTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DashboardTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardTemplateParameter) DeepCopyInto(out *DashboardTemplateParameter) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardTemplateParameter.
+func (in *DashboardTemplateParameter) DeepCopy() *DashboardTemplateParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardTemplateParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardTemplateRef) DeepCopyInto(out *DashboardTemplateRef) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardTemplateRef.
+func (in *DashboardTemplateRef) DeepCopy() *DashboardTemplateRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardTemplateRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardTemplateSpec) DeepCopyInto(out *DashboardTemplateSpec) {
+	*out = *in
+	if in.Json != nil {
+		in, out := &in.Json, &out.Json
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]DashboardTemplateParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Generator != nil {
+		in, out := &in.Generator, &out.Generator
+		*out = new(DashboardTemplateGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardTemplateSpec.
+func (in *DashboardTemplateSpec) DeepCopy() *DashboardTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardTemplateStatus) DeepCopyInto(out *DashboardTemplateStatus) {
+	*out = *in
+	if in.GeneratedDashboards != nil {
+		in, out := &in.GeneratedDashboards, &out.GeneratedDashboards
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardTemplateStatus.
+func (in *DashboardTemplateStatus) DeepCopy() *DashboardTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DashboardsFolder) DeepCopyInto(out *DashboardsFolder) {
 	*out = *in
@@ -2185,6 +3131,10 @@ func (in *DashboardsFolderStatus) DeepCopyInto(out *DashboardsFolderStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardsFolderStatus.
@@ -2478,6 +3428,10 @@ func (in *EnrichmentStatus) DeepCopyInto(out *EnrichmentStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnrichmentStatus.
@@ -2662,6 +3616,10 @@ func (in *Events2MetricStatus) DeepCopyInto(out *Events2MetricStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Events2MetricStatus.
@@ -2704,6 +3662,26 @@ func (in *ExtractTimestamp) DeepCopy() *ExtractTimestamp {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtractedDashboard) DeepCopyInto(out *ExtractedDashboard) {
+	*out = *in
+	if in.UnsupportedPanels != nil {
+		in, out := &in.UnsupportedPanels, &out.UnsupportedPanels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtractedDashboard.
+func (in *ExtractedDashboard) DeepCopy() *ExtractedDashboard {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtractedDashboard)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FallbackTarget) DeepCopyInto(out *FallbackTarget) {
 	*out = *in
@@ -2992,6 +3970,10 @@ func (in *GlobalRouterStatus) DeepCopyInto(out *GlobalRouterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRouterStatus.
@@ -3079,6 +4061,31 @@ func (in *GroupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupMemberStatus) DeepCopyInto(out *GroupMemberStatus) {
+	*out = *in
+	if in.ResourceRef != nil {
+		in, out := &in.ResourceRef, &out.ResourceRef
+		*out = new(ResourceRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupMemberStatus.
+func (in *GroupMemberStatus) DeepCopy() *GroupMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupScope) DeepCopyInto(out *GroupScope) {
 	*out = *in
@@ -3111,7 +4118,9 @@ func (in *GroupSpec) DeepCopyInto(out *GroupSpec) {
 	if in.Members != nil {
 		in, out := &in.Members, &out.Members
 		*out = make([]Member, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.CustomRole != nil {
 		in, out := &in.CustomRole, &out.CustomRole
@@ -3150,6 +4159,17 @@ func (in *GroupStatus) DeepCopyInto(out *GroupStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]GroupMemberStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupStatus.
@@ -3305,6 +4325,10 @@ func (in *IPAccessStatus) DeepCopyInto(out *IPAccessStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAccessStatus.
@@ -3439,6 +4463,10 @@ func (in *IntegrationStatus) DeepCopyInto(out *IntegrationStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationStatus.
@@ -3529,6 +4557,11 @@ func (in *MatchEntityTypeAndSubType) DeepCopy() *MatchEntityTypeAndSubType {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Member) DeepCopyInto(out *Member) {
 	*out = *in
+	if in.ResourceRef != nil {
+		in, out := &in.ResourceRef, &out.ResourceRef
+		*out = new(ResourceRef)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
@@ -3724,6 +4757,193 @@ func (in *NCRef) DeepCopy() *NCRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityProfile) DeepCopyInto(out *ObservabilityProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityProfile.
+func (in *ObservabilityProfile) DeepCopy() *ObservabilityProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObservabilityProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityProfileAlert) DeepCopyInto(out *ObservabilityProfileAlert) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityProfileAlert.
+func (in *ObservabilityProfileAlert) DeepCopy() *ObservabilityProfileAlert {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityProfileAlert)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityProfileAlertSet) DeepCopyInto(out *ObservabilityProfileAlertSet) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityProfileAlertSet.
+func (in *ObservabilityProfileAlertSet) DeepCopy() *ObservabilityProfileAlertSet {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityProfileAlertSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityProfileDashboard) DeepCopyInto(out *ObservabilityProfileDashboard) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityProfileDashboard.
+func (in *ObservabilityProfileDashboard) DeepCopy() *ObservabilityProfileDashboard {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityProfileDashboard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityProfileList) DeepCopyInto(out *ObservabilityProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObservabilityProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityProfileList.
+func (in *ObservabilityProfileList) DeepCopy() *ObservabilityProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObservabilityProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityProfileSLO) DeepCopyInto(out *ObservabilityProfileSLO) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityProfileSLO.
+func (in *ObservabilityProfileSLO) DeepCopy() *ObservabilityProfileSLO {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityProfileSLO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityProfileSpec) DeepCopyInto(out *ObservabilityProfileSpec) {
+	*out = *in
+	if in.Dashboards != nil {
+		in, out := &in.Dashboards, &out.Dashboards
+		*out = make([]ObservabilityProfileDashboard, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = make([]ObservabilityProfileAlert, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AlertSets != nil {
+		in, out := &in.AlertSets, &out.AlertSets
+		*out = make([]ObservabilityProfileAlertSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SLOs != nil {
+		in, out := &in.SLOs, &out.SLOs
+		*out = make([]ObservabilityProfileSLO, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityProfileSpec.
+func (in *ObservabilityProfileSpec) DeepCopy() *ObservabilityProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityProfileWorkload) DeepCopyInto(out *ObservabilityProfileWorkload) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityProfileWorkload.
+func (in *ObservabilityProfileWorkload) DeepCopy() *ObservabilityProfileWorkload {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityProfileWorkload)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Opsgenie) DeepCopyInto(out *Opsgenie) {
 	*out = *in
@@ -3834,6 +5054,10 @@ func (in *OutboundWebhookStatus) DeepCopyInto(out *OutboundWebhookStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundWebhookStatus.
@@ -4062,6 +5286,10 @@ func (in *PresetStatus) DeepCopyInto(out *PresetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PresetStatus.
@@ -4201,6 +5429,10 @@ func (in *QuotaAllocationRuleSetStatus) DeepCopyInto(out *QuotaAllocationRuleSet
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaAllocationRuleSetStatus.
@@ -4353,6 +5585,10 @@ func (in *RecordingRuleGroupSetStatus) DeepCopyInto(out *RecordingRuleGroupSetSt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordingRuleGroupSetStatus.
@@ -4738,41 +5974,109 @@ func (in *RuleGroupSpec) DeepCopyInto(out *RuleGroupSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = make([]RuleGroupTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupSpec.
+func (in *RuleGroupSpec) DeepCopy() *RuleGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroupStatus) DeepCopyInto(out *RuleGroupStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = make([]RuleGroupTestResult, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupStatus.
+func (in *RuleGroupStatus) DeepCopy() *RuleGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleGroupTest) DeepCopyInto(out *RuleGroupTest) {
+	*out = *in
+	if in.Severity != nil {
+		in, out := &in.Severity, &out.Severity
+		*out = new(RuleSeverity)
+		**out = **in
+	}
+	if in.ExpectedOutput != nil {
+		in, out := &in.ExpectedOutput, &out.ExpectedOutput
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpectedMetadata != nil {
+		in, out := &in.ExpectedMetadata, &out.ExpectedMetadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExpectedTimestamp != nil {
+		in, out := &in.ExpectedTimestamp, &out.ExpectedTimestamp
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupSpec.
-func (in *RuleGroupSpec) DeepCopy() *RuleGroupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupTest.
+func (in *RuleGroupTest) DeepCopy() *RuleGroupTest {
 	if in == nil {
 		return nil
 	}
-	out := new(RuleGroupSpec)
+	out := new(RuleGroupTest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RuleGroupStatus) DeepCopyInto(out *RuleGroupStatus) {
+func (in *RuleGroupTestResult) DeepCopyInto(out *RuleGroupTestResult) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupStatus.
-func (in *RuleGroupStatus) DeepCopy() *RuleGroupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroupTestResult.
+func (in *RuleGroupTestResult) DeepCopy() *RuleGroupTestResult {
 	if in == nil {
 		return nil
 	}
-	out := new(RuleGroupStatus)
+	out := new(RuleGroupTestResult)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4876,6 +6180,105 @@ func (in *SLO) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SLOAlertThreshold) DeepCopyInto(out *SLOAlertThreshold) {
+	*out = *in
+	out.Threshold = in.Threshold.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOAlertThreshold.
+func (in *SLOAlertThreshold) DeepCopy() *SLOAlertThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(SLOAlertThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SLOAlerting) DeepCopyInto(out *SLOAlerting) {
+	*out = *in
+	if in.BurnRates != nil {
+		in, out := &in.BurnRates, &out.BurnRates
+		*out = make([]SLOBurnRateAlerting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ErrorBudget != nil {
+		in, out := &in.ErrorBudget, &out.ErrorBudget
+		*out = new(SLOErrorBudgetAlerting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NotificationGroup != nil {
+		in, out := &in.NotificationGroup, &out.NotificationGroup
+		*out = new(v1beta1.NotificationGroup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EntityLabels != nil {
+		in, out := &in.EntityLabels, &out.EntityLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOAlerting.
+func (in *SLOAlerting) DeepCopy() *SLOAlerting {
+	if in == nil {
+		return nil
+	}
+	out := new(SLOAlerting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SLOBurnRateAlerting) DeepCopyInto(out *SLOBurnRateAlerting) {
+	*out = *in
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = make([]SLOAlertThreshold, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOBurnRateAlerting.
+func (in *SLOBurnRateAlerting) DeepCopy() *SLOBurnRateAlerting {
+	if in == nil {
+		return nil
+	}
+	out := new(SLOBurnRateAlerting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SLOErrorBudgetAlerting) DeepCopyInto(out *SLOErrorBudgetAlerting) {
+	*out = *in
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = make([]SLOAlertThreshold, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOErrorBudgetAlerting.
+func (in *SLOErrorBudgetAlerting) DeepCopy() *SLOErrorBudgetAlerting {
+	if in == nil {
+		return nil
+	}
+	out := new(SLOErrorBudgetAlerting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SLOList) DeepCopyInto(out *SLOList) {
 	*out = *in
@@ -4930,6 +6333,11 @@ func (in *SLOSpec) DeepCopyInto(out *SLOSpec) {
 	in.SliType.DeepCopyInto(&out.SliType)
 	in.Window.DeepCopyInto(&out.Window)
 	out.TargetThresholdPercentage = in.TargetThresholdPercentage.DeepCopy()
+	if in.Alerting != nil {
+		in, out := &in.Alerting, &out.Alerting
+		*out = new(SLOAlerting)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOSpec.
@@ -4962,6 +6370,23 @@ func (in *SLOStatus) DeepCopyInto(out *SLOStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.AppliedDefaults != nil {
+		in, out := &in.AppliedDefaults, &out.AppliedDefaults
+		*out = new(v1beta1.AppliedDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOStatus.
@@ -5128,6 +6553,10 @@ func (in *ScopeStatus) DeepCopyInto(out *ScopeStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScopeStatus.
@@ -5436,6 +6865,11 @@ func (in *TCOLogsPoliciesSpec) DeepCopyInto(out *TCOLogsPoliciesSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCOLogsPoliciesSpec.
@@ -5458,6 +6892,17 @@ func (in *TCOLogsPoliciesStatus) DeepCopyInto(out *TCOLogsPoliciesStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]TCOPolicyStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCOLogsPoliciesStatus.
@@ -5589,6 +7034,26 @@ func (in *TCOPolicyRule) DeepCopy() *TCOPolicyRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCOPolicyStatus) DeepCopyInto(out *TCOPolicyStatus) {
+	*out = *in
+	if in.Position != nil {
+		in, out := &in.Position, &out.Position
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCOPolicyStatus.
+func (in *TCOPolicyStatus) DeepCopy() *TCOPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TCOPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TCOPolicyTag) DeepCopyInto(out *TCOPolicyTag) {
 	*out = *in
@@ -5724,6 +7189,11 @@ func (in *TCORumPoliciesSpec) DeepCopyInto(out *TCORumPoliciesSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCORumPoliciesSpec.
@@ -5746,6 +7216,17 @@ func (in *TCORumPoliciesStatus) DeepCopyInto(out *TCORumPoliciesStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]TCOPolicyStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCORumPoliciesStatus.
@@ -5882,6 +7363,11 @@ func (in *TCOTracesPoliciesSpec) DeepCopyInto(out *TCOTracesPoliciesSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCOTracesPoliciesSpec.
@@ -5904,6 +7390,17 @@ func (in *TCOTracesPoliciesStatus) DeepCopyInto(out *TCOTracesPoliciesStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]TCOPolicyStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCOTracesPoliciesStatus.
@@ -6038,6 +7535,136 @@ func (in *TimeSelection) DeepCopy() *TimeSelection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *User) DeepCopyInto(out *User) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User.
+func (in *User) DeepCopy() *User {
+	if in == nil {
+		return nil
+	}
+	out := new(User)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *User) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserFullName) DeepCopyInto(out *UserFullName) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserFullName.
+func (in *UserFullName) DeepCopy() *UserFullName {
+	if in == nil {
+		return nil
+	}
+	out := new(UserFullName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserList) DeepCopyInto(out *UserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]User, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserList.
+func (in *UserList) DeepCopy() *UserList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSpec) DeepCopyInto(out *UserSpec) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(UserFullName)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSpec.
+func (in *UserSpec) DeepCopy() *UserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserStatus) DeepCopyInto(out *UserStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserStatus.
+func (in *UserStatus) DeepCopy() *UserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *View) DeepCopyInto(out *View) {
 	*out = *in
@@ -6176,6 +7803,10 @@ func (in *ViewFolderStatus) DeepCopyInto(out *ViewFolderStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ViewFolderStatus.
@@ -6262,6 +7893,10 @@ func (in *ViewStatus) DeepCopyInto(out *ViewStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ViewStatus.
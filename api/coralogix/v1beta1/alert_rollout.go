@@ -0,0 +1,156 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	alerts "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/alert_definitions_service"
+)
+
+// ShadowAlertLabelKey is the entity label marking the phantom alert running a changed spec during a rollout.
+const ShadowAlertLabelKey = "coralogix-operator.rollout"
+
+// AlertRollout configures the progressive rollout of changes to an alert.
+type AlertRollout struct {
+	// SoakPeriod is how long a changed spec runs as a phantom alert next to the live alert before it replaces the
+	// live alert, e.g. `24h`.
+	SoakPeriod metav1.Duration `json:"soakPeriod"`
+}
+
+// AlertRolloutStatus is the observed state of the rollout of an alert.
+type AlertRolloutStatus struct {
+	// LiveSpecHash is the hash of the spec of the live alert.
+	// +optional
+	LiveSpecHash string `json:"liveSpecHash,omitempty"`
+
+	// ShadowID is the ID of the phantom alert running the changed spec.
+	// +optional
+	ShadowID *string `json:"shadowId,omitempty"`
+
+	// ShadowSpecHash is the hash of the spec of the shadow alert.
+	// +optional
+	ShadowSpecHash string `json:"shadowSpecHash,omitempty"`
+
+	// SoakDeadline is the time the shadow alert is promoted at.
+	// +optional
+	SoakDeadline *metav1.Time `json:"soakDeadline,omitempty"`
+
+	// RolledBackSpecHash is the hash of the last rolled back spec. It is not rolled out again until the spec changes.
+	// +optional
+	RolledBackSpecHash string `json:"rolledBackSpecHash,omitempty"`
+}
+
+// AlertRolloutStep is the action needed to move the rollout of an alert forward.
+type AlertRolloutStep string
+
+const (
+	// AlertRolloutStepSync replaces the live alert with the spec, as without a rollout.
+	AlertRolloutStepSync AlertRolloutStep = "Sync"
+	// AlertRolloutStepCreateShadow creates the shadow alert.
+	AlertRolloutStepCreateShadow AlertRolloutStep = "CreateShadow"
+	// AlertRolloutStepReplaceShadow replaces the shadow alert with a spec changed during the soak period.
+	AlertRolloutStepReplaceShadow AlertRolloutStep = "ReplaceShadow"
+	// AlertRolloutStepSoak waits for the soak deadline.
+	AlertRolloutStepSoak AlertRolloutStep = "Soak"
+	// AlertRolloutStepPromote replaces the live alert with the spec and deletes the shadow alert.
+	AlertRolloutStepPromote AlertRolloutStep = "Promote"
+	// AlertRolloutStepRollback deletes the shadow alert and leaves the live alert untouched.
+	AlertRolloutStepRollback AlertRolloutStep = "Rollback"
+	// AlertRolloutStepHold leaves a rolled back spec untouched.
+	AlertRolloutStepHold AlertRolloutStep = "Hold"
+)
+
+// RolloutHash returns the hash of the spec, without its rollout settings.
+func (in *AlertSpec) RolloutHash() string {
+	spec := *in
+	spec.Rollout = nil
+	data, _ := json.Marshal(spec)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// NextRolloutStep returns the next step of the rollout of the spec, and the rollout status once the step succeeded.
+// The ID of a created shadow alert is set by the caller. A spec is rolled out directly when it has no rollout
+// settings, or when no live alert was created with them yet.
+func (in *AlertSpec) NextRolloutStep(status *AlertRolloutStatus, rollback bool, now time.Time) (AlertRolloutStep, *AlertRolloutStatus) {
+	hasShadow := status != nil && status.ShadowID != nil
+	if in.Rollout == nil {
+		if hasShadow {
+			return AlertRolloutStepRollback, nil
+		}
+		return AlertRolloutStepSync, nil
+	}
+
+	hash := in.RolloutHash()
+	if status == nil || status.LiveSpecHash == "" {
+		return AlertRolloutStepSync, &AlertRolloutStatus{LiveSpecHash: hash}
+	}
+	if hash == status.LiveSpecHash {
+		if hasShadow {
+			return AlertRolloutStepRollback, &AlertRolloutStatus{LiveSpecHash: hash, RolledBackSpecHash: status.ShadowSpecHash}
+		}
+		return AlertRolloutStepSync, &AlertRolloutStatus{LiveSpecHash: hash, RolledBackSpecHash: status.RolledBackSpecHash}
+	}
+	if rollback {
+		return AlertRolloutStepRollback, &AlertRolloutStatus{LiveSpecHash: status.LiveSpecHash, RolledBackSpecHash: hash}
+	}
+	if hash == status.RolledBackSpecHash {
+		return AlertRolloutStepHold, status
+	}
+
+	deadline := metav1.NewTime(now.Add(in.Rollout.SoakPeriod.Duration))
+	switch {
+	case !hasShadow:
+		return AlertRolloutStepCreateShadow, &AlertRolloutStatus{LiveSpecHash: status.LiveSpecHash, ShadowSpecHash: hash, SoakDeadline: &deadline}
+	case status.ShadowSpecHash != hash:
+		return AlertRolloutStepReplaceShadow, &AlertRolloutStatus{LiveSpecHash: status.LiveSpecHash, ShadowID: status.ShadowID, ShadowSpecHash: hash, SoakDeadline: &deadline}
+	case status.SoakDeadline == nil || !now.Before(status.SoakDeadline.Time):
+		return AlertRolloutStepPromote, &AlertRolloutStatus{LiveSpecHash: hash}
+	default:
+		return AlertRolloutStepSoak, status
+	}
+}
+
+// PromotionDelay returns the delay until the shadow alert of the rollout is promoted, or 0 if no shadow alert is
+// soaking. Resources must be requeued by then, since nothing else changes when the soak period ends.
+func (in *AlertRolloutStatus) PromotionDelay(now time.Time) time.Duration {
+	if in == nil || in.ShadowID == nil || in.SoakDeadline == nil {
+		return 0
+	}
+	// A passed deadline is promoted by the next reconciliation, which must still be requeued.
+	return max(in.SoakDeadline.Sub(now), time.Second)
+}
+
+// ShadowAlertDefProperties turns the properties of an alert into the properties of its shadow alert, a phantom alert
+// that does not notify.
+func ShadowAlertDefProperties(props *alerts.AlertDefProperties) *alerts.AlertDefProperties {
+	props.Name = ptr.To(props.GetName() + " (shadow)")
+	props.PhantomMode = ptr.To(true)
+	labels := maps.Clone(props.GetEntityLabels())
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[ShadowAlertLabelKey] = "shadow"
+	props.EntityLabels = &labels
+	return props
+}
@@ -0,0 +1,174 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	alerts "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/alert_definitions_service"
+)
+
+func TestNextRolloutStep(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	rollout := &AlertRollout{SoakPeriod: metav1.Duration{Duration: time.Hour}}
+	live := AlertSpec{Name: "errors", Priority: AlertPriorityP3, Rollout: rollout}
+	changed := live
+	changed.Priority = AlertPriorityP1
+	liveHash, changedHash := live.RolloutHash(), changed.RolloutHash()
+	deadline := metav1.NewTime(now.Add(time.Hour))
+	passed := metav1.NewTime(now.Add(-time.Minute))
+
+	require.NotEqual(t, liveHash, changedHash)
+	withoutRollout := live
+	withoutRollout.Rollout = nil
+	require.Equal(t, liveHash, withoutRollout.RolloutHash(), "the rollout settings are not part of the hash")
+
+	for _, tc := range []struct {
+		name           string
+		spec           AlertSpec
+		status         *AlertRolloutStatus
+		rollback       bool
+		expectedStep   AlertRolloutStep
+		expectedStatus *AlertRolloutStatus
+	}{
+		{
+			name:         "without rollout",
+			spec:         withoutRollout,
+			expectedStep: AlertRolloutStepSync,
+		},
+		{
+			name:           "rollout enabled",
+			spec:           changed,
+			expectedStep:   AlertRolloutStepSync,
+			expectedStatus: &AlertRolloutStatus{LiveSpecHash: changedHash},
+		},
+		{
+			name:           "unchanged",
+			spec:           live,
+			status:         &AlertRolloutStatus{LiveSpecHash: liveHash},
+			expectedStep:   AlertRolloutStepSync,
+			expectedStatus: &AlertRolloutStatus{LiveSpecHash: liveHash},
+		},
+		{
+			name:         "changed",
+			spec:         changed,
+			status:       &AlertRolloutStatus{LiveSpecHash: liveHash},
+			expectedStep: AlertRolloutStepCreateShadow,
+			expectedStatus: &AlertRolloutStatus{
+				LiveSpecHash: liveHash, ShadowSpecHash: changedHash, SoakDeadline: &deadline,
+			},
+		},
+		{
+			name: "soaking",
+			spec: changed,
+			status: &AlertRolloutStatus{
+				LiveSpecHash: liveHash, ShadowID: ptr.To("shadow"), ShadowSpecHash: changedHash, SoakDeadline: &deadline,
+			},
+			expectedStep: AlertRolloutStepSoak,
+			expectedStatus: &AlertRolloutStatus{
+				LiveSpecHash: liveHash, ShadowID: ptr.To("shadow"), ShadowSpecHash: changedHash, SoakDeadline: &deadline,
+			},
+		},
+		{
+			name: "changed while soaking",
+			spec: changed,
+			status: &AlertRolloutStatus{
+				LiveSpecHash: liveHash, ShadowID: ptr.To("shadow"), ShadowSpecHash: "previous", SoakDeadline: &passed,
+			},
+			expectedStep: AlertRolloutStepReplaceShadow,
+			expectedStatus: &AlertRolloutStatus{
+				LiveSpecHash: liveHash, ShadowID: ptr.To("shadow"), ShadowSpecHash: changedHash, SoakDeadline: &deadline,
+			},
+		},
+		{
+			name: "soaked",
+			spec: changed,
+			status: &AlertRolloutStatus{
+				LiveSpecHash: liveHash, ShadowID: ptr.To("shadow"), ShadowSpecHash: changedHash, SoakDeadline: &passed,
+			},
+			expectedStep:   AlertRolloutStepPromote,
+			expectedStatus: &AlertRolloutStatus{LiveSpecHash: changedHash},
+		},
+		{
+			name: "rolled back",
+			spec: changed,
+			status: &AlertRolloutStatus{
+				LiveSpecHash: liveHash, ShadowID: ptr.To("shadow"), ShadowSpecHash: changedHash, SoakDeadline: &deadline,
+			},
+			rollback:       true,
+			expectedStep:   AlertRolloutStepRollback,
+			expectedStatus: &AlertRolloutStatus{LiveSpecHash: liveHash, RolledBackSpecHash: changedHash},
+		},
+		{
+			name:           "held after rollback",
+			spec:           changed,
+			status:         &AlertRolloutStatus{LiveSpecHash: liveHash, RolledBackSpecHash: changedHash},
+			expectedStep:   AlertRolloutStepHold,
+			expectedStatus: &AlertRolloutStatus{LiveSpecHash: liveHash, RolledBackSpecHash: changedHash},
+		},
+		{
+			name: "reverted while soaking",
+			spec: live,
+			status: &AlertRolloutStatus{
+				LiveSpecHash: liveHash, ShadowID: ptr.To("shadow"), ShadowSpecHash: changedHash, SoakDeadline: &deadline,
+			},
+			expectedStep:   AlertRolloutStepRollback,
+			expectedStatus: &AlertRolloutStatus{LiveSpecHash: liveHash, RolledBackSpecHash: changedHash},
+		},
+		{
+			name:         "rollout disabled while soaking",
+			spec:         withoutRollout,
+			status:       &AlertRolloutStatus{LiveSpecHash: liveHash, ShadowID: ptr.To("shadow"), ShadowSpecHash: changedHash},
+			expectedStep: AlertRolloutStepRollback,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			step, status := tc.spec.NextRolloutStep(tc.status, tc.rollback, now)
+			require.Equal(t, tc.expectedStep, step)
+			require.Equal(t, tc.expectedStatus, status)
+		})
+	}
+}
+
+func TestPromotionDelay(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	shadowID := "shadow-id"
+	soaking := &AlertRolloutStatus{ShadowID: &shadowID, SoakDeadline: &metav1.Time{Time: now.Add(time.Hour)}}
+	overdue := &AlertRolloutStatus{ShadowID: &shadowID, SoakDeadline: &metav1.Time{Time: now.Add(-time.Minute)}}
+
+	require.Equal(t, time.Hour, soaking.PromotionDelay(now))
+	require.Equal(t, time.Second, overdue.PromotionDelay(now), "an overdue shadow alert is still requeued")
+	require.Zero(t, (&AlertRolloutStatus{LiveSpecHash: "live"}).PromotionDelay(now))
+	require.Zero(t, (*AlertRolloutStatus)(nil).PromotionDelay(now))
+}
+
+func TestShadowAlertDefProperties(t *testing.T) {
+	labels := map[string]string{"team": "checkout"}
+	props := ShadowAlertDefProperties(&alerts.AlertDefProperties{
+		Name:         ptr.To("Errors"),
+		PhantomMode:  ptr.To(false),
+		EntityLabels: &labels,
+	})
+
+	require.Equal(t, "Errors (shadow)", props.GetName())
+	require.True(t, props.GetPhantomMode())
+	require.Equal(t, map[string]string{"team": "checkout", ShadowAlertLabelKey: "shadow"}, props.GetEntityLabels())
+	require.Equal(t, map[string]string{"team": "checkout"}, labels, "the labels of the live alert are not modified")
+}
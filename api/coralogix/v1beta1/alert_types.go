@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -34,6 +36,8 @@ import (
 	slos "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/slos_service"
 
 	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/provenance"
+	"github.com/coralogix/coralogix-operator/v2/internal/queryvalidation"
 	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 )
 
@@ -41,6 +45,7 @@ import (
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.printableStatus"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // Alert is the Schema for the Alerts API.
 //
@@ -308,6 +313,12 @@ type AlertSpec struct {
 	//+kubebuilder:default=false
 	PhantomMode bool `json:"phantomMode,omitempty"`
 
+	// Progressive rollout of changes to the alert. When set, a changed spec first runs as a phantom alert next to the
+	// live alert, and replaces it after the soak period. Annotating the resource with `app.coralogix.com/rollback`
+	// deletes the phantom alert and keeps the live alert until the spec changes again.
+	// +optional
+	Rollout *AlertRollout `json:"rollout,omitempty"`
+
 	// Alert activity schedule. Will be activated all the time if not specified.
 	// +optional
 	Schedule *AlertSchedule `json:"schedule,omitempty"`
@@ -337,6 +348,44 @@ type AlertStatus struct {
 
 	// +optional
 	PrintableStatus string `json:"printableStatus,omitempty"`
+
+	// LastHandledReconcileAt is the value of the app.coralogix.com/reconcile-requested-at annotation handled by the
+	// latest reconciliation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// NextReconcileAt is the time of the next periodic reconciliation.
+	// +optional
+	NextReconcileAt *metav1.Time `json:"nextReconcileAt,omitempty"`
+
+	// URL is the link to the remote object in the Coralogix UI.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// CreatedAt is the time the remote alert was created.
+	// +optional
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the remote alert was last updated.
+	// +optional
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+
+	// Namespace defaults merged into the spec of the alert.
+	// +optional
+	AppliedDefaults *AppliedDefaults `json:"appliedDefaults,omitempty"`
+
+	// Progressive rollout of the changes to the alert.
+	// +optional
+	Rollout *AlertRolloutStatus `json:"rollout,omitempty"`
+}
+
+// AppliedDefaults records the CoralogixDefaults merged into the spec of a resource.
+type AppliedDefaults struct {
+	// CoralogixDefaults the values come from, as `<namespace>/<name>`.
+	Sources []string `json:"sources"`
+
+	// Fields of the spec set from the defaults.
+	Fields []string `json:"fields"`
 }
 
 func (a *Alert) GetConditions() []metav1.Condition {
@@ -359,6 +408,30 @@ func (a *Alert) SetPrintableStatus(printableStatus string) {
 	a.Status.PrintableStatus = printableStatus
 }
 
+func (a *Alert) GetLastHandledReconcileAt() string {
+	return a.Status.LastHandledReconcileAt
+}
+
+func (a *Alert) SetLastHandledReconcileAt(lastHandledReconcileAt string) {
+	a.Status.LastHandledReconcileAt = lastHandledReconcileAt
+}
+
+func (a *Alert) GetNextReconcileAt() *metav1.Time {
+	return a.Status.NextReconcileAt
+}
+
+func (a *Alert) SetNextReconcileAt(nextReconcileAt *metav1.Time) {
+	a.Status.NextReconcileAt = nextReconcileAt
+}
+
+func (a *Alert) GetURL() string {
+	return a.Status.URL
+}
+
+func (a *Alert) SetURL(url string) {
+	a.Status.URL = url
+}
+
 // +kubebuilder:validation:Pattern=`^UTC[+-]\d{2}$`
 // +kubebuilder:default=UTC+00
 // A time zone expressed in UTC offsets.
@@ -1687,7 +1760,62 @@ func NewDefaultAlertStatus() *AlertStatus {
 	}
 }
 
+// ValidateQueries checks the syntax of the PromQL and Lucene queries of the alert type definition.
+func (in *AlertSpec) ValidateQueries() error {
+	definition := in.TypeDefinition
+	logsFilters := map[string]*LogsFilter{}
+	if definition.LogsImmediate != nil {
+		logsFilters["logsImmediate.logsFilter"] = definition.LogsImmediate.LogsFilter
+	}
+	if definition.LogsThreshold != nil {
+		logsFilters["logsThreshold.logsFilter"] = definition.LogsThreshold.LogsFilter
+	}
+	if definition.LogsRatioThreshold != nil {
+		logsFilters["logsRatioThreshold.numerator"] = &definition.LogsRatioThreshold.Numerator
+		logsFilters["logsRatioThreshold.denominator"] = &definition.LogsRatioThreshold.Denominator
+	}
+	if definition.LogsTimeRelativeThreshold != nil {
+		logsFilters["logsTimeRelativeThreshold.logsFilter"] = &definition.LogsTimeRelativeThreshold.LogsFilter
+	}
+	if definition.LogsAnomaly != nil {
+		logsFilters["logsAnomaly.logsFilter"] = definition.LogsAnomaly.LogsFilter
+	}
+	if definition.LogsNewValue != nil {
+		logsFilters["logsNewValue.logsFilter"] = definition.LogsNewValue.LogsFilter
+	}
+	if definition.LogsUniqueCount != nil {
+		logsFilters["logsUniqueCount.logsFilter"] = definition.LogsUniqueCount.LogsFilter
+	}
+
+	var errs []error
+	for _, path := range slices.Sorted(maps.Keys(logsFilters)) {
+		filter := logsFilters[path]
+		if filter == nil || filter.SimpleFilter.LuceneQuery == nil {
+			continue
+		}
+		if err := queryvalidation.Lucene(*filter.SimpleFilter.LuceneQuery); err != nil {
+			errs = append(errs, fmt.Errorf("spec.alertType.%s.simpleFilter.luceneQuery: %w", path, err))
+		}
+	}
+	if definition.MetricThreshold != nil {
+		if err := queryvalidation.PromQL(definition.MetricThreshold.MetricFilter.Promql); err != nil {
+			errs = append(errs, fmt.Errorf("spec.alertType.metricThreshold.metricFilter.promql: %w", err))
+		}
+	}
+	if definition.MetricAnomaly != nil {
+		if err := queryvalidation.PromQL(definition.MetricAnomaly.MetricFilter.Promql); err != nil {
+			errs = append(errs, fmt.Errorf("spec.alertType.metricAnomaly.metricFilter.promql: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func (in *AlertSpec) ExtractAlertDefProperties(listingAlertsAndWebhooksProperties *GetResourceRefProperties) (*alerts.AlertDefProperties, error) {
+	in, _ = in.WithDefaults(listingAlertsAndWebhooksProperties.Defaults)
+	if err := in.ValidateQueries(); err != nil {
+		return nil, err
+	}
+
 	notificationGroup, err := expandNotificationGroup(in.NotificationGroup, listingAlertsAndWebhooksProperties)
 	if err != nil {
 		return nil, fmt.Errorf("failed to expand notification group: %w", err)
@@ -2100,6 +2228,12 @@ func fillWebhookNameToId(properties *GetResourceRefProperties) error {
 			continue
 		}
 		properties.WebhookNameToId[*webhook.Name] = *webhook.ExternalId
+		// Webhooks managed by the operator are referenced by their name without the provenance suffix.
+		if name := provenance.StripNameSuffix(*webhook.Name); name != *webhook.Name {
+			if _, found := properties.WebhookNameToId[name]; !found {
+				properties.WebhookNameToId[name] = *webhook.ExternalId
+			}
+		}
 	}
 
 	return nil
@@ -3282,6 +3416,52 @@ type GetResourceRefProperties struct {
 	WebhookNameToId map[string]int64
 	ClientSet       *oapicxsdk.ClientSet
 	Namespace       string
+	Defaults        *AlertDefaults
+}
+
+// AlertDefaults are the namespace defaults of the alerts, resolved from CoralogixDefaults.
+// +k8s:deepcopy-gen=false
+type AlertDefaults struct {
+	// Sources are the CoralogixDefaults the values come from, as `<namespace>/<name>`.
+	Sources           []string
+	NotificationGroup *NotificationGroup
+	EntityLabels      map[string]string
+	DataSources       []AlertDataSource
+}
+
+// WithDefaults returns a copy of the spec with the defaults merged in, and the defaults that were applied.
+// The notification group and data sources are used only if the alert does not set them, and entity labels set on the
+// alert take precedence over the default ones.
+func (in *AlertSpec) WithDefaults(defaults *AlertDefaults) (*AlertSpec, *AppliedDefaults) {
+	if defaults == nil {
+		return in, nil
+	}
+
+	out := in.DeepCopy()
+	var fields []string
+	if out.NotificationGroup == nil && defaults.NotificationGroup != nil {
+		out.NotificationGroup = defaults.NotificationGroup.DeepCopy()
+		fields = append(fields, "notificationGroup")
+	}
+	if len(out.DataSources) == 0 && len(defaults.DataSources) > 0 {
+		out.DataSources = append([]AlertDataSource(nil), defaults.DataSources...)
+		fields = append(fields, "dataSources")
+	}
+	for _, key := range slices.Sorted(maps.Keys(defaults.EntityLabels)) {
+		if _, found := out.EntityLabels[key]; found {
+			continue
+		}
+		if out.EntityLabels == nil {
+			out.EntityLabels = make(map[string]string)
+		}
+		out.EntityLabels[key] = defaults.EntityLabels[key]
+		fields = append(fields, "entityLabels."+key)
+	}
+
+	if len(fields) == 0 {
+		return out, nil
+	}
+	return out, &AppliedDefaults{Sources: defaults.Sources, Fields: fields}
 }
 
 func convertCRNameToIntegrationID(name string, properties *GetResourceRefProperties) (*int64, error) {
@@ -194,6 +194,46 @@ func (in *AlertRef) DeepCopy() *AlertRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRollout) DeepCopyInto(out *AlertRollout) {
+	*out = *in
+	out.SoakPeriod = in.SoakPeriod
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRollout.
+func (in *AlertRollout) DeepCopy() *AlertRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRolloutStatus) DeepCopyInto(out *AlertRolloutStatus) {
+	*out = *in
+	if in.ShadowID != nil {
+		in, out := &in.ShadowID, &out.ShadowID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SoakDeadline != nil {
+		in, out := &in.SoakDeadline, &out.SoakDeadline
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRolloutStatus.
+func (in *AlertRolloutStatus) DeepCopy() *AlertRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertSchedule) DeepCopyInto(out *AlertSchedule) {
 	*out = *in
@@ -256,6 +296,11 @@ func (in *AlertSpec) DeepCopyInto(out *AlertSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(AlertRollout)
+		**out = **in
+	}
 	if in.Schedule != nil {
 		in, out := &in.Schedule, &out.Schedule
 		*out = new(AlertSchedule)
@@ -289,6 +334,28 @@ func (in *AlertStatus) DeepCopyInto(out *AlertStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextReconcileAt != nil {
+		in, out := &in.NextReconcileAt, &out.NextReconcileAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.AppliedDefaults != nil {
+		in, out := &in.AppliedDefaults, &out.AppliedDefaults
+		*out = new(AppliedDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(AlertRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertStatus.
@@ -397,6 +464,31 @@ func (in *AnomalyAlertSettings) DeepCopy() *AnomalyAlertSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedDefaults) DeepCopyInto(out *AppliedDefaults) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedDefaults.
+func (in *AppliedDefaults) DeepCopy() *AppliedDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BurnRate) DeepCopyInto(out *BurnRate) {
 	*out = *in
@@ -25,6 +25,7 @@ import (
 	"github.com/go-logr/logr"
 	prometheus "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	prometheusv1alpha "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
@@ -51,6 +52,7 @@ import (
 	v1alpha1controllers "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/v1alpha1"
 	v1beta1controllers "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/v1beta1"
 	"github.com/coralogix/coralogix-operator/v2/internal/monitoring"
+	"github.com/coralogix/coralogix-operator/v2/internal/orphangc"
 	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 	//+kubebuilder:scaffold:imports
 )
@@ -80,6 +82,7 @@ func init() {
 func main() {
 	config.InitScheme(scheme)
 	cfg := config.InitConfig(setupLog)
+	cfg.OperatorVersion = OperatorVersion
 
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
@@ -214,10 +217,24 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "Group")
 		os.Exit(1)
 	}
+	if err = (&v1alpha1controllers.UserReconciler{
+		UsersClient: usersClient,
+		Interval:    cfg.ReconcileIntervals[utils.UserKind],
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "User")
+		os.Exit(1)
+	}
+	if err = (&v1alpha1controllers.CoralogixTenantReconciler{
+		Interval: cfg.ReconcileIntervals[utils.CoralogixTenantKind],
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "CoralogixTenant")
+		os.Exit(1)
+	}
 	if err = (&v1alpha1controllers.TCOLogsPoliciesReconciler{
 		TCOPoliciesClient:       oapiClientSet.TCOPolicies(),
 		ArchiveRetentionsClient: oapiClientSet.ArchiveRetentions(),
 		Interval:                cfg.ReconcileIntervals[utils.TCOLogsPoliciesKind],
+		ComposePolicies:         cfg.TCOPoliciesComposition,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "TCOLogsPolicies")
 		os.Exit(1)
@@ -226,6 +243,7 @@ func main() {
 		TCOPoliciesClient:       oapiClientSet.TCOPolicies(),
 		ArchiveRetentionsClient: oapiClientSet.ArchiveRetentions(),
 		Interval:                cfg.ReconcileIntervals[utils.TCOTracesPoliciesKind],
+		ComposePolicies:         cfg.TCOPoliciesComposition,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "TCOTracesPolicies")
 		os.Exit(1)
@@ -234,6 +252,7 @@ func main() {
 		TCOPoliciesClient:       oapiClientSet.TCOPolicies(),
 		ArchiveRetentionsClient: oapiClientSet.ArchiveRetentions(),
 		Interval:                cfg.ReconcileIntervals[utils.TCORumPoliciesKind],
+		ComposePolicies:         cfg.TCOPoliciesComposition,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "TCORumPolicies")
 		os.Exit(1)
@@ -273,6 +292,12 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "DashboardsFolder")
 		os.Exit(1)
 	}
+	if err = (&v1alpha1controllers.DashboardTemplateReconciler{
+		Interval: cfg.ReconcileIntervals[utils.DashboardTemplateKind],
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DashboardTemplate")
+		os.Exit(1)
+	}
 	if err = (&v1alpha1controllers.ViewReconciler{
 		ViewsClient: oapiClientSet.Views(),
 		Interval:    cfg.ReconcileIntervals[utils.ViewKind],
@@ -349,6 +374,7 @@ func main() {
 	if err = (&v1alpha1controllers.CustomEnrichmentReconciler{
 		CustomEnrichmentsClient: oapiClientSet.CustomEnrichments(),
 		Interval:                cfg.ReconcileIntervals[utils.CustomEnrichmentKind],
+		EnableGenerator:         cfg.CustomEnrichmentGenerator,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "CustomEnrichment")
 		os.Exit(1)
@@ -367,6 +393,13 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "AIEvaluation")
 		os.Exit(1)
 	}
+	if err = (&v1alpha1controllers.AIEvaluationSetReconciler{
+		AIEvaluationsClient: oapiClientSet.AIEvaluations(),
+		Interval:            cfg.ReconcileIntervals[utils.AIEvaluationSetKind],
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AIEvaluationSet")
+		os.Exit(1)
+	}
 	if err = (&v1alpha1controllers.AICustomEvaluationReconciler{
 		AIApplicationsClient: oapiClientSet.AIApplications(),
 		AIEvaluationsClient:  oapiClientSet.AIEvaluations(),
@@ -395,6 +428,86 @@ func main() {
 		}
 	}
 
+	enableAlertmanagerConfigController, err := shouldEnableAlertmanagerConfigController(
+		context.Background(),
+		setupLog,
+		cfg,
+		mgr.GetAPIReader(),
+	)
+	if err != nil {
+		setupLog.Error(err, "unable to determine whether to enable AlertmanagerConfig controller")
+		os.Exit(1)
+	}
+	if enableAlertmanagerConfigController {
+		if err = (&controllers.AlertmanagerConfigReconciler{
+			Interval: cfg.ReconcileIntervals[utils.AlertmanagerConfigKind],
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AlertmanagerConfig")
+			os.Exit(1)
+		}
+	}
+
+	enablePrometheusServiceLevelController, err := shouldEnablePrometheusServiceLevelController(
+		context.Background(),
+		setupLog,
+		cfg,
+		mgr.GetAPIReader(),
+	)
+	if err != nil {
+		setupLog.Error(err, "unable to determine whether to enable PrometheusServiceLevel controller")
+		os.Exit(1)
+	}
+	if enablePrometheusServiceLevelController {
+		if err = (&controllers.PrometheusServiceLevelReconciler{
+			Interval: cfg.ReconcileIntervals[utils.PrometheusServiceLevelKind],
+			Recorder: mgr.GetEventRecorderFor("coralogix-operator"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "PrometheusServiceLevel")
+			os.Exit(1)
+		}
+	}
+
+	if cfg.ObservabilityProfileController {
+		for _, workload := range []struct {
+			kind    string
+			new     func() client.Object
+			newList func() client.ObjectList
+		}{
+			{kind: "Deployment", new: func() client.Object { return &appsv1.Deployment{} }, newList: func() client.ObjectList { return &appsv1.DeploymentList{} }},
+			{kind: "StatefulSet", new: func() client.Object { return &appsv1.StatefulSet{} }, newList: func() client.ObjectList { return &appsv1.StatefulSetList{} }},
+		} {
+			if err = (&controllers.ObservabilityProfileReconciler{
+				NewWorkload:     workload.new,
+				NewWorkloadList: workload.newList,
+				Interval:        cfg.ReconcileIntervals[utils.ObservabilityProfileKind],
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", workload.kind+" observability profile")
+				os.Exit(1)
+			}
+		}
+	}
+
+	if cfg.AlertMuteController {
+		if err = (&controllers.AlertMuteReconciler{}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AlertMute")
+			os.Exit(1)
+		}
+	}
+
+	if cfg.ProvenanceLabels && cfg.OrphanGC != string(orphangc.ModeDisabled) {
+		if err = (&orphangc.Sweeper{
+			ClientSet:    oapiClientSet,
+			Reader:       mgr.GetAPIReader(),
+			Recorder:     mgr.GetEventRecorderFor("coralogix-operator"),
+			Mode:         orphangc.Mode(cfg.OrphanGC),
+			Interval:     cfg.OrphanGCInterval,
+			MaxDeletions: cfg.OrphanGCMaxDeletions,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to set up orphaned remote objects sweeper")
+			os.Exit(1)
+		}
+	}
+
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -449,9 +562,63 @@ func shouldEnablePromRuleController(ctx context.Context,
 	return true, nil
 }
 
+func shouldEnableAlertmanagerConfigController(ctx context.Context,
+	log logr.Logger,
+	cfg *config.Config,
+	c client.Reader,
+) (bool, error) {
+	if !cfg.AlertmanagerConfigController {
+		log.Info("AlertmanagerConfig controller disabled via configuration")
+		return false, nil
+	}
+
+	exists, err := crdExists(ctx, c, "alertmanagerconfigs.monitoring.coreos.com")
+	if err != nil {
+		return false, fmt.Errorf("failed to check AlertmanagerConfig CRD existence: %w", err)
+	}
+
+	if !exists {
+		log.Info(
+			"AlertmanagerConfig controller requested but CRD not found; controller will be disabled")
+		return false, nil
+	}
+
+	log.Info("Enabling AlertmanagerConfig controller")
+	return true, nil
+}
+
+func shouldEnablePrometheusServiceLevelController(ctx context.Context,
+	log logr.Logger,
+	cfg *config.Config,
+	c client.Reader,
+) (bool, error) {
+	if !cfg.PrometheusServiceLevelController {
+		log.Info("PrometheusServiceLevel controller disabled via configuration")
+		return false, nil
+	}
+
+	exists, err := crdExists(ctx, c, "prometheusservicelevels.sloth.slok.dev")
+	if err != nil {
+		return false, fmt.Errorf("failed to check PrometheusServiceLevel CRD existence: %w", err)
+	}
+
+	if !exists {
+		log.Info(
+			"PrometheusServiceLevel controller requested but CRD not found; controller will be disabled")
+		return false, nil
+	}
+
+	log.Info("Enabling PrometheusServiceLevel controller")
+	return true, nil
+}
+
 func prometheusRuleCRDExists(ctx context.Context, c client.Reader) (bool, error) {
+	return crdExists(ctx, c, "prometheusrules.monitoring.coreos.com")
+}
+
+func crdExists(ctx context.Context, c client.Reader, name string) (bool, error) {
 	crd := &apiextensionsv1.CustomResourceDefinition{}
-	err := c.Get(ctx, types.NamespacedName{Name: "prometheusrules.monitoring.coreos.com"}, crd)
+	err := c.Get(ctx, types.NamespacedName{Name: name}, crd)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			return false, nil
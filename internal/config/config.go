@@ -25,6 +25,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/strings/slices"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,28 +36,58 @@ import (
 	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 )
 
+// MinReconcileInterval is the shortest interval between succeeding reconciliations of a resource.
+const MinReconcileInterval = 30 * time.Second
+
 var (
 	cfg          = &Config{}
 	CrClient     client.Client
 	scheme       *runtime.Scheme
 	once         sync.Once
 	validRegions = []string{"AP1", "AP2", "AP3", "EU1", "EU2", "US1", "US2", "US3"}
+	// regionDomains are the domains of the Coralogix UI of the regions.
+	regionDomains = map[string]string{
+		"AP1": "coralogix.in",
+		"AP2": "coralogixsg.com",
+		"AP3": "ap3.coralogix.com",
+		"EU1": "coralogix.com",
+		"EU2": "eu2.coralogix.com",
+		"US1": "coralogix.us",
+		"US2": "cx498.coralogix.com",
+		"US3": "us3.coralogix.com",
+	}
+	// validOrphanGCModes are the modes of the orphaned remote objects sweeper, see package orphangc.
+	validOrphanGCModes = []string{"disabled", "dry-run", "delete"}
 )
 
 type Config struct {
-	CoralogixApiKey             string
-	CoralogixRegionOrDomain     string
-	CoralogixOpenApiUrl         string
-	Selector                    Selector
-	ReconcileIntervals          map[string]time.Duration
-	PrometheusRuleController    bool
-	RecordingRuleGroupSetSuffix string
-	MetricsAddr                 string
-	ProbeAddr                   string
-	EnableLeaderElection        bool
-	LeaderElectionID            string
-	SecureMetrics               bool
-	EnableHTTP2                 bool
+	CoralogixApiKey                  string
+	CoralogixRegionOrDomain          string
+	CoralogixOpenApiUrl              string
+	CoralogixUIUrl                   string
+	Selector                         Selector
+	ReconcileIntervals               map[string]time.Duration
+	ReconcileJitter                  float64
+	PrometheusRuleController         bool
+	AlertmanagerConfigController     bool
+	PrometheusServiceLevelController bool
+	CustomEnrichmentGenerator        bool
+	ObservabilityProfileController   bool
+	AlertMuteController              bool
+	TCOPoliciesComposition           bool
+	RecordingRuleGroupSetSuffix      string
+	ProvenanceLabels                 bool
+	ClusterName                      string
+	OperatorVersion                  string
+	OrphanGC                         string
+	OrphanGCInterval                 time.Duration
+	OrphanGCMaxDeletions             int
+	MetricsAddr                      string
+	ProbeAddr                        string
+	EnableLeaderElection             bool
+	LeaderElectionID                 string
+	SecureMetrics                    bool
+	EnableHTTP2                      bool
 }
 
 func InitConfig(setupLog logr.Logger) *Config {
@@ -74,8 +105,31 @@ func InitConfig(setupLog logr.Logger) *Config {
 			"If set, HTTP/2 will be enabled for the metrics and webhook servers")
 		flag.BoolVar(&cfg.PrometheusRuleController, "prometheus-rule-controller", true,
 			"Determine if the prometheus rule controller should be started. Default is true.")
+		flag.BoolVar(&cfg.AlertmanagerConfigController, "alertmanager-config-controller", false,
+			"Determine if the alertmanager config controller should be started. Default is false.")
+		flag.BoolVar(&cfg.PrometheusServiceLevelController, "prometheus-service-level-controller", false,
+			"Determine if the Sloth prometheus service level controller should be started. Default is false.")
+		flag.BoolVar(&cfg.CustomEnrichmentGenerator, "custom-enrichment-generator", false,
+			"If set, the pods, nodes and namespaces of the cluster are watched to generate the CSV data of CustomEnrichments with a generator. Default is false.")
+		flag.BoolVar(&cfg.ObservabilityProfileController, "observability-profile-controller", false,
+			"If set, the deployments and statefulsets of the cluster are watched to generate the resources of their observability profiles. Default is false.")
+		flag.BoolVar(&cfg.AlertMuteController, "alert-mute-controller", false,
+			"If set, the namespaces of the cluster are watched to mute the Alerts and AlertSets annotated with app.coralogix.com/mute-until through AlertSchedulers. Default is false.")
+		flag.BoolVar(&cfg.TCOPoliciesComposition, "tco-policies-composition", false,
+			"If set, the policies of all selected TCO policies resources of a kind are merged into a single overwrite. Default is false.")
 		flag.StringVar(&cfg.RecordingRuleGroupSetSuffix, "recording-rule-group-set-suffix", "",
 			"Suffix to be added to the RecordingRuleGroupSet")
+		flag.BoolVar(&cfg.ProvenanceLabels, "provenance-labels", false,
+			"If set, remote objects are labeled with the cluster, namespace, name and UID of their resource and the operator version, "+
+				"and objects labeled as managed by another resource are never updated nor deleted. Default is false.")
+
+		clusterName := os.Getenv("CLUSTER_NAME")
+		flag.StringVar(&cfg.ClusterName, "cluster-name", clusterName, "The name of the cluster, written into the provenance labels of remote objects.")
+		flag.StringVar(&cfg.OrphanGC, "orphan-gc", "dry-run",
+			fmt.Sprintf("What to do with remote objects labeled with this cluster's provenance that have no matching resource. "+
+				"Can be one of %q. Requires provenance-labels. Default is dry-run, which only reports them.", validOrphanGCModes))
+		flag.DurationVar(&cfg.OrphanGCInterval, "orphan-gc-interval", time.Hour, "The interval between sweeps for orphaned remote objects.")
+		flag.IntVar(&cfg.OrphanGCMaxDeletions, "orphan-gc-max-deletions", 10, "The maximum number of orphaned remote objects deleted by a single sweep.")
 
 		region := os.Getenv("CORALOGIX_REGION")
 		flag.StringVar(&region, "region", region, fmt.Sprintf("The region of your Coralogix cluster. Can be one of %q. Conflicts with 'domain'.", validRegions))
@@ -83,6 +137,11 @@ func InitConfig(setupLog logr.Logger) *Config {
 		domain := os.Getenv("CORALOGIX_DOMAIN")
 		flag.StringVar(&domain, "domain", domain, "The domain of your Coralogix cluster. Conflicts with 'region'.")
 
+		teamName := os.Getenv("CORALOGIX_TEAM_NAME")
+		flag.StringVar(&teamName, "team-name", teamName,
+			"The name of your Coralogix team, used to link resources to the Coralogix UI in their status.url. "+
+				"The links are not set if it is empty.")
+
 		apiKey := os.Getenv("CORALOGIX_API_KEY")
 		flag.StringVar(&cfg.CoralogixApiKey, "api-key", apiKey, "The proper api-key based on your Coralogix cluster's region.")
 
@@ -94,6 +153,11 @@ func InitConfig(setupLog logr.Logger) *Config {
 
 		reconcileIntervals := getReconcileIntervals()
 
+		reconcileJitter := os.Getenv("RECONCILE_JITTER")
+		flag.StringVar(&reconcileJitter, "reconcile-jitter", reconcileJitter,
+			"The maximum deviation of the intervals between succeeding reconciliations, as a fraction of the intervals, "+
+				"e.g. 0.1 for ±10%. Spreads the reconciliations of resources of the same kind over time. Default is 0.")
+
 		opts := zap.Options{}
 		opts.BindFlags(flag.CommandLine)
 		flag.Parse()
@@ -107,12 +171,14 @@ func InitConfig(setupLog logr.Logger) *Config {
 			os.Exit(1)
 		}
 
-		cfg.CoralogixOpenApiUrl, err = getCoralogixOpenApiUrl(strings.ToUpper(region), domain)
+		cfg.CoralogixOpenApiUrl, err = GetCoralogixOpenApiUrl(strings.ToUpper(region), domain)
 		if err != nil {
 			setupLog.Error(err, "invalid arguments for running operator")
 			os.Exit(1)
 		}
 
+		cfg.CoralogixUIUrl = getCoralogixUIUrl(strings.ToUpper(region), domain, teamName)
+
 		if cfg.CoralogixApiKey == "" {
 			setupLog.Error(fmt.Errorf("api-key can not be empty"),
 				"invalid arguments for running operator")
@@ -131,6 +197,29 @@ func InitConfig(setupLog logr.Logger) *Config {
 			setupLog.Error(err, "invalid arguments for running operator")
 			os.Exit(1)
 		}
+
+		cfg.ReconcileJitter, err = parseReconcileJitter(reconcileJitter)
+		if err != nil {
+			setupLog.Error(err, "invalid arguments for running operator")
+			os.Exit(1)
+		}
+
+		if cfg.ProvenanceLabels && cfg.ClusterName == "" {
+			setupLog.Error(fmt.Errorf("cluster-name can not be empty when provenance-labels is set"),
+				"invalid arguments for running operator")
+			os.Exit(1)
+		}
+
+		if !slices.Contains(validOrphanGCModes, cfg.OrphanGC) {
+			setupLog.Error(fmt.Errorf("orphan-gc must be one of %q", validOrphanGCModes),
+				"invalid arguments for running operator")
+			os.Exit(1)
+		}
+		if cfg.OrphanGCInterval <= 0 || cfg.OrphanGCMaxDeletions < 0 {
+			setupLog.Error(fmt.Errorf("orphan-gc-interval must be positive and orphan-gc-max-deletions can not be negative"),
+				"invalid arguments for running operator")
+			os.Exit(1)
+		}
 	})
 
 	return cfg
@@ -142,7 +231,9 @@ func GetConfig() *Config {
 
 func getReconcileIntervals() map[string]*string {
 	result := make(map[string]*string)
-	gvks := utils.GetGVKs(GetScheme())
+	// The Sloth CRD is optional, so its kind is not part of the kinds the operator always manages.
+	gvks := append(utils.GetGVKs(GetScheme()),
+		schema.GroupVersionKind{Group: utils.SlothAPIGroup, Version: utils.V1APIVersion, Kind: utils.PrometheusServiceLevelKind})
 	for _, gvk := range gvks {
 		interval := os.Getenv(fmt.Sprintf("%s_RECONCILE_INTERVAL_SECONDS", strings.ToUpper(gvk.Kind)))
 		flag.StringVar(
@@ -173,7 +264,7 @@ func parseReconcileIntervals(intervals map[string]*string) (map[string]time.Dura
 			return nil, fmt.Errorf("invalid interval value for %s: %w", crd, err)
 		}
 
-		if numericInterval != 0 && numericInterval < 30 {
+		if numericInterval != 0 && time.Second*time.Duration(numericInterval) < MinReconcileInterval {
 			return nil, fmt.Errorf("interval value should be at least 30 seconds")
 		}
 
@@ -182,6 +273,22 @@ func parseReconcileIntervals(intervals map[string]*string) (map[string]time.Dura
 	return result, nil
 }
 
+func parseReconcileJitter(jitter string) (float64, error) {
+	if jitter == "" {
+		return 0, nil
+	}
+
+	result, err := strconv.ParseFloat(jitter, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid reconcile jitter value: %w", err)
+	}
+
+	if result < 0 || result >= 1 {
+		return 0, fmt.Errorf("reconcile jitter value should be at least 0 and less than 1")
+	}
+	return result, nil
+}
+
 // getCoralogixRegionOrDomain returns the raw region identifier or custom domain, as
 // provided. The SCIM users client derives its own endpoint from this value; every other
 // client is built from CoralogixOpenApiUrl.
@@ -200,7 +307,8 @@ func getCoralogixRegionOrDomain(region, domain string) (string, error) {
 	return domain, nil
 }
 
-func getCoralogixOpenApiUrl(region, domain string) (string, error) {
+// GetCoralogixOpenApiUrl returns the OpenAPI URL of a Coralogix region or domain. Exactly one of them must be set.
+func GetCoralogixOpenApiUrl(region, domain string) (string, error) {
 	if err := validateRegionAndDomain(region, domain); err != nil {
 		return "", err
 	}
@@ -219,6 +327,19 @@ func getCoralogixOpenApiUrl(region, domain string) (string, error) {
 	return openapicxsdk.URLFromDomain(domain), nil
 }
 
+// getCoralogixUIUrl returns the URL of the Coralogix UI of a team, or an empty string if the team is not set. The region
+// or domain must be valid.
+func getCoralogixUIUrl(region, domain, teamName string) string {
+	if teamName == "" {
+		return ""
+	}
+
+	if region != "" {
+		domain = regionDomains[region]
+	}
+	return fmt.Sprintf("https://%s.app.%s", teamName, domain)
+}
+
 func validateRegionAndDomain(region, domain string) error {
 	if region != "" && domain != "" {
 		return fmt.Errorf("region and domain flags are mutually exclusive")
@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -68,6 +69,28 @@ func (s Selector) Matches(resourceLabels map[string]string, namespace string) bo
 	return true
 }
 
+// Labels returns labels matching the label selector, so that the resources generated by the operator are selected
+// as well. An equality or set-based requirement gets its first value, and an existence requirement an empty value.
+func (s Selector) Labels() map[string]string {
+	result := make(map[string]string)
+	if s.LabelSelector == nil {
+		return result
+	}
+
+	requirements, _ := s.LabelSelector.Requirements()
+	for _, requirement := range requirements {
+		switch requirement.Operator() {
+		case selection.Equals, selection.DoubleEquals, selection.In:
+			if values := requirement.Values().List(); len(values) > 0 {
+				result[requirement.Key()] = values[0]
+			}
+		case selection.Exists:
+			result[requirement.Key()] = ""
+		}
+	}
+	return result
+}
+
 func isNamespaceMatch(selector labels.Selector, namespace string) (bool, error) {
 	ns := &corev1.Namespace{}
 	if err := GetClient().Get(context.Background(), client.ObjectKey{Name: namespace}, ns); err != nil {
@@ -0,0 +1,1059 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	prometheusv1alpha "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+const (
+	defaultOpsGenieAPIURL  = "https://api.opsgenie.com/"
+	alertsEntityType       = "alerts"
+	alertNameMatcherLabel  = "alertname"
+	opsGenieHeadersKeyName = "additionalHeaders"
+)
+
+//+kubebuilder:rbac:groups=monitoring.coreos.com,resources=alertmanagerconfigs,verbs=get;list;watch
+
+//+kubebuilder:rbac:groups=coralogix.com,resources=connectors,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=coralogix.com,resources=globalrouters,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// AlertmanagerConfigReconciler reconciles an AlertmanagerConfig object
+type AlertmanagerConfigReconciler struct {
+	Interval time.Duration
+}
+
+// alertmanagerReceiverConnectors holds the Connectors (and the generated Secrets backing them)
+// that were translated from a single AlertmanagerConfig receiver.
+type alertmanagerReceiverConnectors struct {
+	Connectors []coralogixv1alpha1.Connector
+	Secrets    []corev1.Secret
+}
+
+func (r *AlertmanagerConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	amConfig := &prometheusv1alpha.AlertmanagerConfig{}
+	if err := config.GetClient().Get(ctx, req.NamespacedName, amConfig); err != nil {
+		if k8serrors.IsNotFound(err) {
+			// Owned Connectors, GlobalRouters and Secrets are garbage collected via their owner references.
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !shouldTrackAlertmanagerConfig(amConfig) {
+		if err := r.deleteChildren(ctx, amConfig); err != nil {
+			log.Error(err, "Received an error while trying to delete AlertmanagerConfig children")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	var errs error
+	receivers := make(map[string]alertmanagerReceiverConnectors)
+	for _, receiver := range amConfig.Spec.Receivers {
+		// Integrations that could be converted are kept even if a sibling integration of the same receiver failed.
+		converted, err := r.alertmanagerReceiverToConnectors(ctx, log, amConfig, receiver)
+		if err != nil {
+			log.Error(err, "Received an error while trying to convert AlertmanagerConfig receiver", "receiver", receiver.Name)
+			errs = errors.Join(errs, err)
+			// The existing children of the receiver are kept until it converts again, so that a transient error,
+			// e.g. getting a Secret, does not delete its remote connectors and break the routes using them.
+			if converted, err = withExistingReceiverChildren(ctx, amConfig, receiver.Name, converted); err != nil {
+				log.Error(err, "Received an error while trying to list the children of AlertmanagerConfig receiver", "receiver", receiver.Name)
+				return ctrl.Result{}, errors.Join(errs, err)
+			}
+		}
+		receivers[receiver.Name] = converted
+	}
+
+	if err := r.syncConnectors(ctx, amConfig, receivers); err != nil {
+		log.Error(err, "Received an error while trying to convert AlertmanagerConfig to Connector CRDs")
+		errs = errors.Join(errs, err)
+	}
+
+	if err := r.syncGlobalRouter(ctx, log, amConfig, receivers); err != nil {
+		log.Error(err, "Received an error while trying to convert AlertmanagerConfig to GlobalRouter CRD")
+		errs = errors.Join(errs, err)
+	}
+
+	if errs != nil {
+		return ctrl.Result{}, errs
+	}
+
+	return reconcile.Result{RequeueAfter: r.Interval}, nil
+}
+
+func (r *AlertmanagerConfigReconciler) alertmanagerReceiverToConnectors(
+	ctx context.Context,
+	log logr.Logger,
+	amConfig *prometheusv1alpha.AlertmanagerConfig,
+	receiver prometheusv1alpha.Receiver,
+) (alertmanagerReceiverConnectors, error) {
+	var result alertmanagerReceiverConnectors
+	var errs error
+
+	for i, slackConfig := range receiver.SlackConfigs {
+		spec, err := slackConfigToConnectorSpec(amConfig, receiver.Name, slackConfig)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("receiver %s slackConfigs[%d]: %w", receiver.Name, i, err))
+			continue
+		}
+		result.Connectors = append(result.Connectors, newAlertmanagerConnector(amConfig, receiver.Name, "slack", i, spec))
+	}
+
+	for i, pagerDutyConfig := range receiver.PagerDutyConfigs {
+		spec, err := pagerDutyConfigToConnectorSpec(amConfig, receiver.Name, pagerDutyConfig)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("receiver %s pagerdutyConfigs[%d]: %w", receiver.Name, i, err))
+			continue
+		}
+		result.Connectors = append(result.Connectors, newAlertmanagerConnector(amConfig, receiver.Name, "pagerduty", i, spec))
+	}
+
+	for i, opsGenieConfig := range receiver.OpsGenieConfigs {
+		connector := newAlertmanagerConnector(amConfig, receiver.Name, "opsgenie", i, coralogixv1alpha1.ConnectorSpec{})
+		secret, err := r.opsGenieHeadersSecret(ctx, amConfig, connector.Name, opsGenieConfig)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("receiver %s opsgenieConfigs[%d]: %w", receiver.Name, i, err))
+			continue
+		}
+		connector.Spec = opsGenieConfigToConnectorSpec(amConfig, receiver.Name, opsGenieConfig, secret.Name)
+		result.Connectors = append(result.Connectors, connector)
+		result.Secrets = append(result.Secrets, *secret)
+	}
+
+	for i, webhookConfig := range receiver.WebhookConfigs {
+		spec, err := webhookConfigToConnectorSpec(amConfig, receiver.Name, webhookConfig)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("receiver %s webhookConfigs[%d]: %w", receiver.Name, i, err))
+			continue
+		}
+		result.Connectors = append(result.Connectors, newAlertmanagerConnector(amConfig, receiver.Name, "webhook", i, spec))
+	}
+
+	for i, emailConfig := range receiver.EmailConfigs {
+		spec, err := emailConfigToConnectorSpec(amConfig, receiver.Name, emailConfig)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("receiver %s emailConfigs[%d]: %w", receiver.Name, i, err))
+			continue
+		}
+		result.Connectors = append(result.Connectors, newAlertmanagerConnector(amConfig, receiver.Name, "email", i, spec))
+	}
+
+	if unsupported := unsupportedReceiverIntegrations(receiver); len(unsupported) > 0 {
+		log.V(int(zapcore.WarnLevel)).Info("Skipping unsupported AlertmanagerConfig receiver integrations",
+			"receiver", receiver.Name, "integrations", unsupported)
+	}
+
+	return result, errs
+}
+
+func (r *AlertmanagerConfigReconciler) syncConnectors(
+	ctx context.Context,
+	amConfig *prometheusv1alpha.AlertmanagerConfig,
+	receivers map[string]alertmanagerReceiverConnectors,
+) error {
+	var errorsEncountered []error
+	connectorsToKeep := make(map[string]bool)
+	secretsToKeep := make(map[string]bool)
+	for _, converted := range receivers {
+		for _, secret := range converted.Secrets {
+			secretsToKeep[secret.Name] = true
+			if err := r.applySecret(ctx, amConfig, secret); err != nil {
+				errorsEncountered = append(errorsEncountered, err)
+			}
+		}
+
+		for _, desired := range converted.Connectors {
+			connectorsToKeep[desired.Name] = true
+			if err := r.applyConnector(ctx, amConfig, desired); err != nil {
+				errorsEncountered = append(errorsEncountered, err)
+			}
+		}
+	}
+
+	var childConnectors coralogixv1alpha1.ConnectorList
+	if err := config.GetClient().List(
+		ctx,
+		&childConnectors,
+		client.InNamespace(amConfig.Namespace),
+		client.MatchingLabels{managedByLabelKey: truncateLabelValue(amConfig.Name)}); err != nil {
+		return fmt.Errorf("received an error while trying to list Connectors: %w", err)
+	}
+
+	for _, connector := range childConnectors.Items {
+		if !connectorsToKeep[connector.Name] && isOwnedBy(connector.OwnerReferences, amConfig.UID) {
+			if err := config.GetClient().Delete(ctx, &connector); err != nil && !k8serrors.IsNotFound(err) {
+				errorsEncountered = append(errorsEncountered, fmt.Errorf("error deleting Connector CRD %s: %w", connector.Name, err))
+			}
+		}
+	}
+
+	var childSecrets corev1.SecretList
+	if err := config.GetClient().List(
+		ctx,
+		&childSecrets,
+		client.InNamespace(amConfig.Namespace),
+		client.MatchingLabels{managedByLabelKey: truncateLabelValue(amConfig.Name)}); err != nil {
+		return fmt.Errorf("received an error while trying to list Secrets: %w", err)
+	}
+
+	for _, secret := range childSecrets.Items {
+		if !secretsToKeep[secret.Name] && isOwnedBy(secret.OwnerReferences, amConfig.UID) {
+			if err := config.GetClient().Delete(ctx, &secret); err != nil && !k8serrors.IsNotFound(err) {
+				errorsEncountered = append(errorsEncountered, fmt.Errorf("error deleting Secret %s: %w", secret.Name, err))
+			}
+		}
+	}
+
+	if len(errorsEncountered) > 0 {
+		return errors.Join(errorsEncountered...)
+	}
+	return nil
+}
+
+func (r *AlertmanagerConfigReconciler) applyConnector(
+	ctx context.Context,
+	amConfig *prometheusv1alpha.AlertmanagerConfig,
+	desired coralogixv1alpha1.Connector,
+) error {
+	connector := &coralogixv1alpha1.Connector{}
+	if err := config.GetClient().Get(ctx, client.ObjectKey{Namespace: amConfig.Namespace, Name: desired.Name}, connector); err != nil {
+		if k8serrors.IsNotFound(err) {
+			utils.PropagateReconcileRequest(amConfig, &desired)
+			if err = config.GetClient().Create(ctx, &desired); err != nil {
+				return fmt.Errorf("error creating Connector CRD %s: %w", desired.Name, err)
+			}
+			return nil
+		}
+		return fmt.Errorf("error getting Connector CRD %s: %w", desired.Name, err)
+	}
+
+	if !isOwnedBy(connector.OwnerReferences, amConfig.UID) {
+		return fmt.Errorf("connector %s already exists and is not managed by AlertmanagerConfig %s", desired.Name, amConfig.Name)
+	}
+
+	updated := false
+	if !reflect.DeepEqual(connector.Labels, desired.Labels) {
+		connector.Labels = desired.Labels
+		updated = true
+	}
+
+	if !reflect.DeepEqual(connector.OwnerReferences, desired.OwnerReferences) {
+		connector.OwnerReferences = desired.OwnerReferences
+		updated = true
+	}
+
+	if !reflect.DeepEqual(connector.Spec, desired.Spec) {
+		connector.Spec = desired.Spec
+		updated = true
+	}
+
+	if utils.PropagateReconcileRequest(amConfig, connector) {
+		updated = true
+	}
+
+	if updated {
+		if err := config.GetClient().Update(ctx, connector); err != nil {
+			return fmt.Errorf("error updating Connector CRD %s: %w", desired.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *AlertmanagerConfigReconciler) applySecret(
+	ctx context.Context,
+	amConfig *prometheusv1alpha.AlertmanagerConfig,
+	desired corev1.Secret,
+) error {
+	secret := &corev1.Secret{}
+	if err := config.GetClient().Get(ctx, client.ObjectKey{Namespace: amConfig.Namespace, Name: desired.Name}, secret); err != nil {
+		if k8serrors.IsNotFound(err) {
+			if err = config.GetClient().Create(ctx, &desired); err != nil {
+				return fmt.Errorf("error creating Secret %s: %w", desired.Name, err)
+			}
+			return nil
+		}
+		return fmt.Errorf("error getting Secret %s: %w", desired.Name, err)
+	}
+
+	if !isOwnedBy(secret.OwnerReferences, amConfig.UID) {
+		return fmt.Errorf("secret %s already exists and is not managed by AlertmanagerConfig %s", desired.Name, amConfig.Name)
+	}
+
+	if !reflect.DeepEqual(secret.Data, desired.Data) || !reflect.DeepEqual(secret.Labels, desired.Labels) {
+		secret.Data = desired.Data
+		secret.Labels = desired.Labels
+		if err := config.GetClient().Update(ctx, secret); err != nil {
+			return fmt.Errorf("error updating Secret %s: %w", desired.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *AlertmanagerConfigReconciler) syncGlobalRouter(
+	ctx context.Context,
+	log logr.Logger,
+	amConfig *prometheusv1alpha.AlertmanagerConfig,
+	receivers map[string]alertmanagerReceiverConnectors,
+) error {
+	desiredSpec, err := alertmanagerConfigToGlobalRouterSpec(log, amConfig, receivers)
+	if err != nil {
+		return err
+	}
+
+	globalRouter := &coralogixv1alpha1.GlobalRouter{}
+	if err := config.GetClient().Get(ctx, client.ObjectKey{Namespace: amConfig.Namespace, Name: amConfig.Name}, globalRouter); err != nil {
+		if k8serrors.IsNotFound(err) {
+			globalRouter.Name = amConfig.Name
+			globalRouter.Namespace = amConfig.Namespace
+			globalRouter.Labels = alertmanagerChildLabels(amConfig)
+			globalRouter.OwnerReferences = []metav1.OwnerReference{getAlertmanagerConfigOwnerReference(amConfig)}
+			globalRouter.Spec = *desiredSpec
+			utils.PropagateReconcileRequest(amConfig, globalRouter)
+			if err = config.GetClient().Create(ctx, globalRouter); err != nil {
+				return fmt.Errorf("received an error while trying to create GlobalRouter CRD: %w", err)
+			}
+			return nil
+		}
+		return fmt.Errorf("received an error while trying to get GlobalRouter CRD: %w", err)
+	}
+
+	if !isOwnedBy(globalRouter.OwnerReferences, amConfig.UID) {
+		return fmt.Errorf("globalRouter %s already exists and is not managed by AlertmanagerConfig %s", amConfig.Name, amConfig.Name)
+	}
+
+	updated := false
+	desiredLabels := alertmanagerChildLabels(amConfig)
+	if !reflect.DeepEqual(globalRouter.Labels, desiredLabels) {
+		globalRouter.Labels = desiredLabels
+		updated = true
+	}
+
+	desiredOwnerReferences := []metav1.OwnerReference{getAlertmanagerConfigOwnerReference(amConfig)}
+	if !reflect.DeepEqual(globalRouter.OwnerReferences, desiredOwnerReferences) {
+		globalRouter.OwnerReferences = desiredOwnerReferences
+		updated = true
+	}
+
+	if !reflect.DeepEqual(globalRouter.Spec, *desiredSpec) {
+		globalRouter.Spec = *desiredSpec
+		updated = true
+	}
+
+	if utils.PropagateReconcileRequest(amConfig, globalRouter) {
+		updated = true
+	}
+
+	if updated {
+		if err := config.GetClient().Update(ctx, globalRouter); err != nil {
+			return fmt.Errorf("received an error while trying to update GlobalRouter CRD: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *AlertmanagerConfigReconciler) deleteChildren(ctx context.Context, amConfig *prometheusv1alpha.AlertmanagerConfig) error {
+	matchingLabels := client.MatchingLabels{managedByLabelKey: truncateLabelValue(amConfig.Name)}
+
+	var errs error
+	var globalRouters coralogixv1alpha1.GlobalRouterList
+	if err := config.GetClient().List(ctx, &globalRouters, client.InNamespace(amConfig.Namespace), matchingLabels); err != nil {
+		return fmt.Errorf("received an error while trying to list GlobalRouters: %w", err)
+	}
+	for _, globalRouter := range globalRouters.Items {
+		if !isOwnedBy(globalRouter.OwnerReferences, amConfig.UID) {
+			continue
+		}
+		if err := config.GetClient().Delete(ctx, &globalRouter); err != nil && !k8serrors.IsNotFound(err) {
+			errs = errors.Join(errs, fmt.Errorf("received an error while trying to delete GlobalRouter CRD %s: %w", globalRouter.Name, err))
+		}
+	}
+
+	var connectors coralogixv1alpha1.ConnectorList
+	if err := config.GetClient().List(ctx, &connectors, client.InNamespace(amConfig.Namespace), matchingLabels); err != nil {
+		return fmt.Errorf("received an error while trying to list Connectors: %w", err)
+	}
+	for _, connector := range connectors.Items {
+		if !isOwnedBy(connector.OwnerReferences, amConfig.UID) {
+			continue
+		}
+		if err := config.GetClient().Delete(ctx, &connector); err != nil && !k8serrors.IsNotFound(err) {
+			errs = errors.Join(errs, fmt.Errorf("received an error while trying to delete Connector CRD %s: %w", connector.Name, err))
+		}
+	}
+
+	var secrets corev1.SecretList
+	if err := config.GetClient().List(ctx, &secrets, client.InNamespace(amConfig.Namespace), matchingLabels); err != nil {
+		return fmt.Errorf("received an error while trying to list Secrets: %w", err)
+	}
+	for _, secret := range secrets.Items {
+		if !isOwnedBy(secret.OwnerReferences, amConfig.UID) {
+			continue
+		}
+		if err := config.GetClient().Delete(ctx, &secret); err != nil && !k8serrors.IsNotFound(err) {
+			errs = errors.Join(errs, fmt.Errorf("received an error while trying to delete Secret %s: %w", secret.Name, err))
+		}
+	}
+
+	return errs
+}
+
+// opsGenieHeadersSecret renders the OpsGenie API key referenced by the receiver into an owned Secret
+// holding the generic HTTPS connector's additionalHeaders, so the key itself never lands in the Connector spec.
+func (r *AlertmanagerConfigReconciler) opsGenieHeadersSecret(
+	ctx context.Context,
+	amConfig *prometheusv1alpha.AlertmanagerConfig,
+	name string,
+	opsGenieConfig prometheusv1alpha.OpsGenieConfig,
+) (*corev1.Secret, error) {
+	if opsGenieConfig.APIKey == nil {
+		return nil, fmt.Errorf("apiKey is required")
+	}
+
+	source := &corev1.Secret{}
+	if err := config.GetClient().Get(ctx, client.ObjectKey{Namespace: amConfig.Namespace, Name: opsGenieConfig.APIKey.Name}, source); err != nil {
+		return nil, fmt.Errorf("failed to get secret %s: %w", opsGenieConfig.APIKey.Name, err)
+	}
+
+	apiKey, ok := source.Data[opsGenieConfig.APIKey.Key]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in secret %s", opsGenieConfig.APIKey.Key, opsGenieConfig.APIKey.Name)
+	}
+
+	headers, err := json.Marshal(map[string]string{
+		"Authorization": "GenieKey " + strings.TrimSpace(string(apiKey)),
+		"Content-Type":  "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       amConfig.Namespace,
+			Labels:          alertmanagerChildLabels(amConfig),
+			OwnerReferences: []metav1.OwnerReference{getAlertmanagerConfigOwnerReference(amConfig)},
+		},
+		Data: map[string][]byte{opsGenieHeadersKeyName: headers},
+	}, nil
+}
+
+// withExistingReceiverChildren adds the existing Connectors and Secrets of a receiver that are missing from its
+// converted ones.
+func withExistingReceiverChildren(
+	ctx context.Context,
+	amConfig *prometheusv1alpha.AlertmanagerConfig,
+	receiverName string,
+	converted alertmanagerReceiverConnectors,
+) (alertmanagerReceiverConnectors, error) {
+	matchingLabels := client.MatchingLabels{managedByLabelKey: truncateLabelValue(amConfig.Name)}
+	isMissingChild := func(obj client.Object, converted []string) bool {
+		return isOwnedBy(obj.GetOwnerReferences(), amConfig.UID) &&
+			isAlertmanagerReceiverChild(amConfig.Name, receiverName, obj.GetName()) &&
+			!slices.Contains(converted, obj.GetName())
+	}
+
+	var connectors coralogixv1alpha1.ConnectorList
+	if err := config.GetClient().List(ctx, &connectors, client.InNamespace(amConfig.Namespace), matchingLabels); err != nil {
+		return converted, fmt.Errorf("received an error while trying to list Connectors: %w", err)
+	}
+	var convertedConnectors []string
+	for _, connector := range converted.Connectors {
+		convertedConnectors = append(convertedConnectors, connector.Name)
+	}
+	for _, connector := range connectors.Items {
+		if isMissingChild(&connector, convertedConnectors) {
+			converted.Connectors = append(converted.Connectors, connector)
+		}
+	}
+
+	var secrets corev1.SecretList
+	if err := config.GetClient().List(ctx, &secrets, client.InNamespace(amConfig.Namespace), matchingLabels); err != nil {
+		return converted, fmt.Errorf("received an error while trying to list Secrets: %w", err)
+	}
+	var convertedSecrets []string
+	for _, secret := range converted.Secrets {
+		convertedSecrets = append(convertedSecrets, secret.Name)
+	}
+	for _, secret := range secrets.Items {
+		if isMissingChild(&secret, convertedSecrets) {
+			converted.Secrets = append(converted.Secrets, secret)
+		}
+	}
+
+	return converted, nil
+}
+
+// alertmanagerChildIntegrationSuffix matches the end of the names of the Connectors and Secrets of a receiver.
+var alertmanagerChildIntegrationSuffix = regexp.MustCompile(`^(slack|pagerduty|opsgenie|webhook|email)-[0-9]+$`)
+
+// isAlertmanagerReceiverChild returns true if a Connector or Secret is named after a receiver of an AlertmanagerConfig.
+func isAlertmanagerReceiverChild(amConfigName, receiverName, name string) bool {
+	suffix, ok := strings.CutPrefix(name, amConfigName+"-"+sanitizeName(receiverName)+"-")
+	return ok && alertmanagerChildIntegrationSuffix.MatchString(suffix)
+}
+
+func newAlertmanagerConnector(
+	amConfig *prometheusv1alpha.AlertmanagerConfig,
+	receiverName, integration string,
+	index int,
+	spec coralogixv1alpha1.ConnectorSpec,
+) coralogixv1alpha1.Connector {
+	return coralogixv1alpha1.Connector{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            alertmanagerConnectorName(amConfig.Name, receiverName, integration, index),
+			Namespace:       amConfig.Namespace,
+			Labels:          alertmanagerChildLabels(amConfig),
+			OwnerReferences: []metav1.OwnerReference{getAlertmanagerConfigOwnerReference(amConfig)},
+		},
+		Spec: spec,
+	}
+}
+
+func alertmanagerConnectorName(amConfigName, receiverName, integration string, index int) string {
+	return fmt.Sprintf("%s-%s-%s-%d", amConfigName, sanitizeName(receiverName), integration, index)
+}
+
+func alertmanagerConnectorDescription(amConfig *prometheusv1alpha.AlertmanagerConfig, receiverName string) string {
+	return fmt.Sprintf("Generated from receiver %s of AlertmanagerConfig %s/%s", receiverName, amConfig.Namespace, amConfig.Name)
+}
+
+func slackConfigToConnectorSpec(amConfig *prometheusv1alpha.AlertmanagerConfig, receiverName string, slackConfig prometheusv1alpha.SlackConfig) (coralogixv1alpha1.ConnectorSpec, error) {
+	integrationID := amConfig.Annotations[utils.AlertmanagerConfigSlackIntegrationIDAnnotationKey]
+	if integrationID == "" {
+		return coralogixv1alpha1.ConnectorSpec{}, fmt.Errorf("annotation %s is required for slack receivers",
+			utils.AlertmanagerConfigSlackIntegrationIDAnnotationKey)
+	}
+	if slackConfig.Channel == "" {
+		return coralogixv1alpha1.ConnectorSpec{}, fmt.Errorf("channel is required")
+	}
+
+	channel := strings.TrimPrefix(slackConfig.Channel, "#")
+	return coralogixv1alpha1.ConnectorSpec{
+		Name:        receiverName,
+		Description: alertmanagerConnectorDescription(amConfig, receiverName),
+		Type:        "slack",
+		ConnectorConfig: coralogixv1alpha1.ConnectorConfig{
+			Fields: []coralogixv1alpha1.ConnectorConfigField{
+				{FieldName: "integrationId", Value: ptr.To(integrationID)},
+				{FieldName: "fallbackChannel", Value: ptr.To(channel)},
+				{FieldName: "channel", Value: ptr.To(channel)},
+			},
+		},
+	}, nil
+}
+
+func pagerDutyConfigToConnectorSpec(amConfig *prometheusv1alpha.AlertmanagerConfig, receiverName string, pagerDutyConfig prometheusv1alpha.PagerDutyConfig) (coralogixv1alpha1.ConnectorSpec, error) {
+	integrationKey := pagerDutyConfig.RoutingKey
+	if integrationKey == nil {
+		integrationKey = pagerDutyConfig.ServiceKey
+	}
+	if integrationKey == nil {
+		return coralogixv1alpha1.ConnectorSpec{}, fmt.Errorf("one of routingKey or serviceKey is required")
+	}
+
+	return coralogixv1alpha1.ConnectorSpec{
+		Name:        receiverName,
+		Description: alertmanagerConnectorDescription(amConfig, receiverName),
+		Type:        "pagerDuty",
+		ConnectorConfig: coralogixv1alpha1.ConnectorConfig{
+			Fields: []coralogixv1alpha1.ConnectorConfigField{
+				{FieldName: "integrationKey", SecretKeyRef: integrationKey.DeepCopy()},
+			},
+		},
+	}, nil
+}
+
+func opsGenieConfigToConnectorSpec(amConfig *prometheusv1alpha.AlertmanagerConfig, receiverName string, opsGenieConfig prometheusv1alpha.OpsGenieConfig, headersSecretName string) coralogixv1alpha1.ConnectorSpec {
+	apiURL := opsGenieConfig.APIURL
+	if apiURL == "" {
+		apiURL = defaultOpsGenieAPIURL
+	}
+	apiURL = strings.TrimSuffix(apiURL, "/") + "/v2/alerts"
+
+	return coralogixv1alpha1.ConnectorSpec{
+		Name:        receiverName,
+		Description: alertmanagerConnectorDescription(amConfig, receiverName),
+		Type:        "genericHttps",
+		ConnectorConfig: coralogixv1alpha1.ConnectorConfig{
+			Fields: []coralogixv1alpha1.ConnectorConfigField{
+				{FieldName: "url", Value: ptr.To(apiURL)},
+				{FieldName: "method", Value: ptr.To("POST")},
+				{
+					FieldName: opsGenieHeadersKeyName,
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: headersSecretName},
+						Key:                  opsGenieHeadersKeyName,
+					},
+				},
+			},
+		},
+	}
+}
+
+func webhookConfigToConnectorSpec(amConfig *prometheusv1alpha.AlertmanagerConfig, receiverName string, webhookConfig prometheusv1alpha.WebhookConfig) (coralogixv1alpha1.ConnectorSpec, error) {
+	urlField := coralogixv1alpha1.ConnectorConfigField{FieldName: "url"}
+	switch {
+	case webhookConfig.URLSecret != nil:
+		urlField.SecretKeyRef = webhookConfig.URLSecret.DeepCopy()
+	case webhookConfig.URL != nil && *webhookConfig.URL != "":
+		urlField.Value = ptr.To(*webhookConfig.URL)
+	default:
+		return coralogixv1alpha1.ConnectorSpec{}, fmt.Errorf("one of url or urlSecret is required")
+	}
+
+	return coralogixv1alpha1.ConnectorSpec{
+		Name:        receiverName,
+		Description: alertmanagerConnectorDescription(amConfig, receiverName),
+		Type:        "genericHttps",
+		ConnectorConfig: coralogixv1alpha1.ConnectorConfig{
+			Fields: []coralogixv1alpha1.ConnectorConfigField{
+				urlField,
+				{FieldName: "method", Value: ptr.To("POST")},
+			},
+		},
+	}, nil
+}
+
+func emailConfigToConnectorSpec(amConfig *prometheusv1alpha.AlertmanagerConfig, receiverName string, emailConfig prometheusv1alpha.EmailConfig) (coralogixv1alpha1.ConnectorSpec, error) {
+	var addresses []string
+	for _, address := range strings.Split(emailConfig.To, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) == 0 {
+		return coralogixv1alpha1.ConnectorSpec{}, fmt.Errorf("to is required")
+	}
+
+	emailAddresses, err := json.Marshal(addresses)
+	if err != nil {
+		return coralogixv1alpha1.ConnectorSpec{}, err
+	}
+
+	return coralogixv1alpha1.ConnectorSpec{
+		Name:        receiverName,
+		Description: alertmanagerConnectorDescription(amConfig, receiverName),
+		Type:        "email",
+		ConnectorConfig: coralogixv1alpha1.ConnectorConfig{
+			Fields: []coralogixv1alpha1.ConnectorConfigField{
+				{FieldName: "emailAddresses", Value: ptr.To(string(emailAddresses))},
+			},
+		},
+	}, nil
+}
+
+func unsupportedReceiverIntegrations(receiver prometheusv1alpha.Receiver) []string {
+	var unsupported []string
+	for _, integration := range []struct {
+		name  string
+		count int
+	}{
+		{"discordConfigs", len(receiver.DiscordConfigs)},
+		{"wechatConfigs", len(receiver.WeChatConfigs)},
+		{"victoropsConfigs", len(receiver.VictorOpsConfigs)},
+		{"pushoverConfigs", len(receiver.PushoverConfigs)},
+		{"snsConfigs", len(receiver.SNSConfigs)},
+		{"telegramConfigs", len(receiver.TelegramConfigs)},
+		{"webexConfigs", len(receiver.WebexConfigs)},
+		{"msteamsConfigs", len(receiver.MSTeamsConfigs)},
+		{"msteamsv2Configs", len(receiver.MSTeamsV2Configs)},
+	} {
+		if integration.count > 0 {
+			unsupported = append(unsupported, integration.name)
+		}
+	}
+	return unsupported
+}
+
+// alertmanagerConfigToGlobalRouterSpec translates the AlertmanagerConfig route tree into GlobalRouter rules.
+// Alertmanager hands an alert to the first matching child route only, unless that route sets `continue`, and a route
+// notifies its own receiver only when none of its child routes matches. The GlobalRouter notifies the targets of every
+// matching rule instead, so the condition of each route's rule also excludes the alerts of its child routes and of the
+// earlier sibling routes that don't continue. The root route's receiver becomes the fallback target when the alerts
+// that match no rule are exactly the ones it handles, and gets a rule of its own otherwise.
+func alertmanagerConfigToGlobalRouterSpec(
+	log logr.Logger,
+	amConfig *prometheusv1alpha.AlertmanagerConfig,
+	receivers map[string]alertmanagerReceiverConnectors,
+) (*coralogixv1alpha1.GlobalRouterSpec, error) {
+	spec := &coralogixv1alpha1.GlobalRouterSpec{
+		Name:          fmt.Sprintf("%s/%s", amConfig.Namespace, amConfig.Name),
+		Description:   fmt.Sprintf("Generated from AlertmanagerConfig %s/%s", amConfig.Namespace, amConfig.Name),
+		RoutingLabels: alertmanagerConfigRoutingLabels(amConfig),
+	}
+
+	route := amConfig.Spec.Route
+	if route == nil {
+		return spec, nil
+	}
+
+	var rootConditions []string
+	if matched := matchersToCondition(route.Matchers); matched != "" {
+		rootConditions = append(rootConditions, matched)
+	}
+
+	rules, skipped, err := routeToRoutingRules(log, route, receivers, route.Receiver, rootConditions, "route")
+	if err != nil {
+		return nil, err
+	}
+
+	ownConditions, handlesAlerts, err := routeOwnConditions(route, rootConditions, "route")
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !handlesAlerts:
+	case len(rootConditions) == 0 && !skipped:
+		for _, target := range routingTargetsForReceiver(receivers, route.Receiver) {
+			spec.FallbackTargets = append(spec.FallbackTargets, coralogixv1alpha1.FallbackTarget{
+				EntityType: alertsEntityType,
+				Target:     target,
+			})
+		}
+	default:
+		// The fallback targets would also be notified of the alerts that the root route doesn't match, or that
+		// belong to a skipped route.
+		if rule, ok := newAlertmanagerRoutingRule(log, receivers, route.Receiver, ownConditions, "route"); ok {
+			rules = append([]coralogixv1alpha1.RoutingRule{rule}, rules...)
+		}
+	}
+	spec.Rules = rules
+
+	return spec, nil
+}
+
+// routeToRoutingRules returns the rules of the routes nested in a route, given the conditions of the alerts that reach
+// the route, and whether any of them was skipped because its receiver has no supported integrations.
+func routeToRoutingRules(
+	log logr.Logger,
+	route *prometheusv1alpha.Route,
+	receivers map[string]alertmanagerReceiverConnectors,
+	parentReceiver string,
+	parentConditions []string,
+	path string,
+) ([]coralogixv1alpha1.RoutingRule, bool, error) {
+	childRoutes, err := route.ChildRoutes()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to parse child routes of %s: %w", path, err)
+	}
+
+	var rules []coralogixv1alpha1.RoutingRule
+	skipped := false
+	// stoppedConditions exclude the alerts matched by earlier sibling routes that don't continue.
+	var stoppedConditions []string
+	for i := range childRoutes {
+		child := &childRoutes[i]
+		childPath := fmt.Sprintf("%s.routes[%d]", path, i)
+
+		receiver := child.Receiver
+		if receiver == "" {
+			receiver = parentReceiver
+		}
+
+		conditions := append(append([]string{}, parentConditions...), stoppedConditions...)
+		matched := matchersToCondition(child.Matchers)
+		if matched != "" {
+			conditions = append(conditions, matched)
+		}
+
+		ownConditions, handlesAlerts, err := routeOwnConditions(child, conditions, childPath)
+		if err != nil {
+			return nil, false, err
+		}
+		if handlesAlerts {
+			if rule, ok := newAlertmanagerRoutingRule(log, receivers, receiver, ownConditions, childPath); ok {
+				rules = append(rules, rule)
+			} else {
+				skipped = true
+			}
+		}
+
+		nested, nestedSkipped, err := routeToRoutingRules(log, child, receivers, receiver, conditions, childPath)
+		if err != nil {
+			return nil, false, err
+		}
+		rules = append(rules, nested...)
+		skipped = skipped || nestedSkipped
+
+		if !child.Continue {
+			if matched == "" {
+				// The route matches every alert that reaches it, so the later sibling routes are never evaluated.
+				if i < len(childRoutes)-1 {
+					log.V(int(zapcore.WarnLevel)).Info("Skipping AlertmanagerConfig routes shadowed by a route without matchers",
+						"route", childPath)
+				}
+				break
+			}
+			stoppedConditions = append(stoppedConditions, "!("+matched+")")
+		}
+	}
+
+	return rules, skipped, nil
+}
+
+// routeOwnConditions returns the conditions of the alerts that a route notifies its own receiver of, which are the
+// alerts that reach it and match none of its child routes, and false if there are no such alerts.
+func routeOwnConditions(route *prometheusv1alpha.Route, conditions []string, path string) ([]string, bool, error) {
+	childRoutes, err := route.ChildRoutes()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to parse child routes of %s: %w", path, err)
+	}
+
+	ownConditions := append([]string{}, conditions...)
+	for _, child := range childRoutes {
+		matched := matchersToCondition(child.Matchers)
+		if matched == "" {
+			return nil, false, nil
+		}
+		ownConditions = append(ownConditions, "!("+matched+")")
+	}
+
+	return ownConditions, true, nil
+}
+
+func newAlertmanagerRoutingRule(
+	log logr.Logger,
+	receivers map[string]alertmanagerReceiverConnectors,
+	receiver string,
+	conditions []string,
+	path string,
+) (coralogixv1alpha1.RoutingRule, bool) {
+	targets := routingTargetsForReceiver(receivers, receiver)
+	if len(targets) == 0 {
+		log.V(int(zapcore.WarnLevel)).Info("Skipping AlertmanagerConfig route without supported receiver integrations",
+			"route", path, "receiver", receiver)
+		return coralogixv1alpha1.RoutingRule{}, false
+	}
+
+	condition := "true"
+	if len(conditions) > 0 {
+		condition = strings.Join(conditions, " && ")
+	}
+
+	return coralogixv1alpha1.RoutingRule{
+		Name:       fmt.Sprintf("%s-%s", path, sanitizeName(receiver)),
+		EntityType: ptr.To(alertsEntityType),
+		Condition:  condition,
+		Targets:    targets,
+	}, true
+}
+
+func routingTargetsForReceiver(receivers map[string]alertmanagerReceiverConnectors, receiverName string) []coralogixv1alpha1.RoutingTarget {
+	converted, ok := receivers[receiverName]
+	if !ok {
+		return nil
+	}
+
+	var targets []coralogixv1alpha1.RoutingTarget
+	for _, connector := range converted.Connectors {
+		targets = append(targets, coralogixv1alpha1.RoutingTarget{
+			Connector: coralogixv1alpha1.NCRef{
+				ResourceRef: &coralogixv1alpha1.ResourceRef{Name: connector.Name},
+			},
+		})
+	}
+	return targets
+}
+
+// matchersToCondition returns the conjunction of the conditions of a route's matchers, or an empty string if the
+// route has no matchers and so matches every alert.
+func matchersToCondition(matchers []prometheusv1alpha.Matcher) string {
+	conditions := make([]string, 0, len(matchers))
+	for _, matcher := range matchers {
+		conditions = append(conditions, matcherToCondition(matcher))
+	}
+	return strings.Join(conditions, " && ")
+}
+
+// matcherToCondition translates an Alertmanager matcher into a Notification Center condition expression.
+// The `alertname` label maps to the alert definition name; every other label is matched against the
+// alert definition's entity labels, which is where the PrometheusRule controller puts rule labels.
+func matcherToCondition(matcher prometheusv1alpha.Matcher) string {
+	subject := fmt.Sprintf("alertDef.entityLabels[%s]", strconv.Quote(matcher.Name))
+	if matcher.Name == alertNameMatcherLabel {
+		subject = "alertDef.name"
+	}
+
+	matchType := matcher.MatchType
+	if matchType == "" {
+		matchType = prometheusv1alpha.MatchEqual
+		if matcher.Regex {
+			matchType = prometheusv1alpha.MatchRegexp
+		}
+	}
+
+	switch matchType {
+	case prometheusv1alpha.MatchNotEqual:
+		return fmt.Sprintf("%s != %s", subject, strconv.Quote(matcher.Value))
+	case prometheusv1alpha.MatchRegexp:
+		return fmt.Sprintf("%s.matches(%s)", subject, strconv.Quote("^(?:"+matcher.Value+")$"))
+	case prometheusv1alpha.MatchNotRegexp:
+		return fmt.Sprintf("!%s.matches(%s)", subject, strconv.Quote("^(?:"+matcher.Value+")$"))
+	default:
+		return fmt.Sprintf("%s == %s", subject, strconv.Quote(matcher.Value))
+	}
+}
+
+func alertmanagerConfigRoutingLabels(amConfig *prometheusv1alpha.AlertmanagerConfig) *coralogixv1alpha1.RoutingLabels {
+	routingLabels := &coralogixv1alpha1.RoutingLabels{}
+	if value, ok := amConfig.Annotations[utils.AlertmanagerConfigRoutingEnvironmentAnnotationKey]; ok && value != "" {
+		routingLabels.Environment = ptr.To(value)
+	}
+	if value, ok := amConfig.Annotations[utils.AlertmanagerConfigRoutingServiceAnnotationKey]; ok && value != "" {
+		routingLabels.Service = ptr.To(value)
+	}
+	if value, ok := amConfig.Annotations[utils.AlertmanagerConfigRoutingTeamAnnotationKey]; ok && value != "" {
+		routingLabels.Team = ptr.To(value)
+	}
+
+	// Routing labels are required for non-default routers; the namespace is the natural team boundary
+	// for an AlertmanagerConfig, so it is used when no routing annotation is set.
+	if routingLabels.Environment == nil && routingLabels.Service == nil && routingLabels.Team == nil {
+		routingLabels.Team = ptr.To(amConfig.Namespace)
+	}
+
+	return routingLabels
+}
+
+func alertmanagerChildLabels(amConfig *prometheusv1alpha.AlertmanagerConfig) map[string]string {
+	labels := make(map[string]string, len(amConfig.Labels)+1)
+	for key, value := range amConfig.Labels {
+		labels[key] = value
+	}
+	labels[managedByLabelKey] = truncateLabelValue(amConfig.Name)
+	return labels
+}
+
+func getAlertmanagerConfigOwnerReference(amConfig *prometheusv1alpha.AlertmanagerConfig) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: prometheusv1alpha.SchemeGroupVersion.String(),
+		Kind:       prometheusv1alpha.AlertmanagerConfigKind,
+		Name:       amConfig.Name,
+		UID:        amConfig.UID,
+	}
+}
+
+func isOwnedBy(ownerReferences []metav1.OwnerReference, uid types.UID) bool {
+	for _, ownerReference := range ownerReferences {
+		if ownerReference.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+func shouldTrackAlertmanagerConfig(amConfig *prometheusv1alpha.AlertmanagerConfig) bool {
+	if value, ok := amConfig.Labels[utils.TrackAlertmanagerConfigLabelKey]; ok && value == "true" {
+		return true
+	}
+	return false
+}
+
+// enqueueAlertmanagerConfigsForSecret enqueues the tracked AlertmanagerConfigs whose receivers read a Secret, so that
+// rotated credentials are rendered into their generated Secrets.
+func enqueueAlertmanagerConfigsForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	var amConfigs prometheusv1alpha.AlertmanagerConfigList
+	if err := config.GetClient().List(ctx, &amConfigs, client.InNamespace(secret.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Error listing AlertmanagerConfigs reading the Secret")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, amConfig := range amConfigs.Items {
+		if !shouldTrackAlertmanagerConfig(&amConfig) {
+			continue
+		}
+		if slices.ContainsFunc(amConfig.Spec.Receivers, func(receiver prometheusv1alpha.Receiver) bool {
+			return slices.ContainsFunc(receiver.OpsGenieConfigs, func(opsGenieConfig prometheusv1alpha.OpsGenieConfig) bool {
+				return opsGenieConfig.APIKey != nil && opsGenieConfig.APIKey.Name == secret.GetName()
+			})
+		}) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&amConfig)})
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AlertmanagerConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	shouldTrack := func(labels map[string]string) bool {
+		value, ok := labels[utils.TrackAlertmanagerConfigLabelKey]
+		return ok && value == "true"
+	}
+
+	selector := config.GetConfig().Selector
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&prometheusv1alpha.AlertmanagerConfig{}, builder.WithPredicates(predicate.Funcs{
+			CreateFunc: func(e event.CreateEvent) bool {
+				return shouldTrack(e.Object.GetLabels()) &&
+					selector.Matches(e.Object.GetLabels(), e.Object.GetNamespace())
+			},
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return (shouldTrack(e.ObjectNew.GetLabels()) || shouldTrack(e.ObjectOld.GetLabels())) &&
+					(selector.Matches(e.ObjectNew.GetLabels(), e.ObjectNew.GetNamespace()) ||
+						selector.Matches(e.ObjectOld.GetLabels(), e.ObjectOld.GetNamespace()))
+			},
+			DeleteFunc: func(e event.DeleteEvent) bool {
+				return shouldTrack(e.Object.GetLabels()) &&
+					selector.Matches(e.Object.GetLabels(), e.Object.GetNamespace())
+			},
+		})).
+		// The children are not controlled by the AlertmanagerConfig, as its owner reference is not a controller one.
+		Owns(&coralogixv1alpha1.Connector{}, builder.MatchEveryOwner).
+		Owns(&coralogixv1alpha1.GlobalRouter{}, builder.MatchEveryOwner).
+		Owns(&corev1.Secret{}, builder.MatchEveryOwner).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(enqueueAlertmanagerConfigsForSecret)).
+		Complete(r)
+}
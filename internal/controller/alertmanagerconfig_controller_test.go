@@ -0,0 +1,434 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	prometheusv1alpha "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+func TestAlertmanagerMatcherToCondition(t *testing.T) {
+	tests := []struct {
+		name     string
+		matcher  prometheusv1alpha.Matcher
+		expected string
+	}{
+		{
+			name:     "equal",
+			matcher:  prometheusv1alpha.Matcher{Name: "severity", Value: "critical"},
+			expected: `alertDef.entityLabels["severity"] == "critical"`,
+		},
+		{
+			name:     "not equal",
+			matcher:  prometheusv1alpha.Matcher{Name: "severity", Value: "info", MatchType: prometheusv1alpha.MatchNotEqual},
+			expected: `alertDef.entityLabels["severity"] != "info"`,
+		},
+		{
+			name:     "regex",
+			matcher:  prometheusv1alpha.Matcher{Name: "namespace", Value: "payments-.*", MatchType: prometheusv1alpha.MatchRegexp},
+			expected: `alertDef.entityLabels["namespace"].matches("^(?:payments-.*)$")`,
+		},
+		{
+			name:     "deprecated regex flag",
+			matcher:  prometheusv1alpha.Matcher{Name: "namespace", Value: "payments-.*", Regex: true},
+			expected: `alertDef.entityLabels["namespace"].matches("^(?:payments-.*)$")`,
+		},
+		{
+			name:     "not regex",
+			matcher:  prometheusv1alpha.Matcher{Name: "namespace", Value: "kube-.*", MatchType: prometheusv1alpha.MatchNotRegexp},
+			expected: `!alertDef.entityLabels["namespace"].matches("^(?:kube-.*)$")`,
+		},
+		{
+			name:     "alertname",
+			matcher:  prometheusv1alpha.Matcher{Name: "alertname", Value: "app-latency"},
+			expected: `alertDef.name == "app-latency"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, matcherToCondition(tt.matcher))
+		})
+	}
+}
+
+func TestAlertmanagerConfigToGlobalRouterSpec(t *testing.T) {
+	amConfig := &prometheusv1alpha.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example-config",
+			Namespace: "default",
+		},
+		Spec: prometheusv1alpha.AlertmanagerConfigSpec{
+			Route: &prometheusv1alpha.Route{
+				Receiver: "slack",
+				Routes: []apiextensionsv1.JSON{
+					routeJSON(t, prometheusv1alpha.Route{
+						Receiver: "pagerduty",
+						Matchers: []prometheusv1alpha.Matcher{{Name: "severity", Value: "critical"}},
+						Routes: []apiextensionsv1.JSON{
+							routeJSON(t, prometheusv1alpha.Route{
+								Matchers: []prometheusv1alpha.Matcher{{Name: "alertname", Value: "app-latency"}},
+							}),
+						},
+					}),
+					routeJSON(t, prometheusv1alpha.Route{
+						Receiver: "unsupported",
+						Matchers: []prometheusv1alpha.Matcher{{Name: "team", Value: "payments"}},
+					}),
+				},
+			},
+		},
+	}
+
+	receivers := map[string]alertmanagerReceiverConnectors{
+		"slack": {Connectors: []coralogixv1alpha1.Connector{{ObjectMeta: metav1.ObjectMeta{Name: "example-config-slack-slack-0"}}}},
+		"pagerduty": {Connectors: []coralogixv1alpha1.Connector{
+			{ObjectMeta: metav1.ObjectMeta{Name: "example-config-pagerduty-pagerduty-0"}},
+		}},
+		"unsupported": {},
+	}
+
+	spec, err := alertmanagerConfigToGlobalRouterSpec(logr.Discard(), amConfig, receivers)
+	assert.NoError(t, err)
+
+	pagerDutyTarget := coralogixv1alpha1.RoutingTarget{
+		Connector: coralogixv1alpha1.NCRef{ResourceRef: &coralogixv1alpha1.ResourceRef{Name: "example-config-pagerduty-pagerduty-0"}},
+	}
+	slackTarget := coralogixv1alpha1.RoutingTarget{
+		Connector: coralogixv1alpha1.NCRef{ResourceRef: &coralogixv1alpha1.ResourceRef{Name: "example-config-slack-slack-0"}},
+	}
+	// The alerts of the skipped route must not reach the root route's receiver, so it gets a rule instead of being
+	// the fallback target.
+	expected := &coralogixv1alpha1.GlobalRouterSpec{
+		Name:          "default/example-config",
+		Description:   "Generated from AlertmanagerConfig default/example-config",
+		RoutingLabels: &coralogixv1alpha1.RoutingLabels{Team: ptr.To("default")},
+		Rules: []coralogixv1alpha1.RoutingRule{
+			{
+				Name:       "route-slack",
+				EntityType: ptr.To("alerts"),
+				Condition:  `!(alertDef.entityLabels["severity"] == "critical") && !(alertDef.entityLabels["team"] == "payments")`,
+				Targets:    []coralogixv1alpha1.RoutingTarget{slackTarget},
+			},
+			{
+				Name:       "route.routes[0]-pagerduty",
+				EntityType: ptr.To("alerts"),
+				Condition:  `alertDef.entityLabels["severity"] == "critical" && !(alertDef.name == "app-latency")`,
+				Targets:    []coralogixv1alpha1.RoutingTarget{pagerDutyTarget},
+			},
+			{
+				Name:       "route.routes[0].routes[0]-pagerduty",
+				EntityType: ptr.To("alerts"),
+				Condition:  `alertDef.entityLabels["severity"] == "critical" && alertDef.name == "app-latency"`,
+				Targets:    []coralogixv1alpha1.RoutingTarget{pagerDutyTarget},
+			},
+		},
+	}
+	assert.Equal(t, expected, spec)
+}
+
+func TestAlertmanagerConfigRoutesToRoutingRules(t *testing.T) {
+	receivers := map[string]alertmanagerReceiverConnectors{
+		"slack":     {Connectors: []coralogixv1alpha1.Connector{{ObjectMeta: metav1.ObjectMeta{Name: "example-config-slack-slack-0"}}}},
+		"pagerduty": {Connectors: []coralogixv1alpha1.Connector{{ObjectMeta: metav1.ObjectMeta{Name: "example-config-pagerduty-pagerduty-0"}}}},
+	}
+	team := prometheusv1alpha.Matcher{Name: "team", Value: "payments"}
+	critical := prometheusv1alpha.Matcher{Name: "severity", Value: "critical"}
+	warning := prometheusv1alpha.Matcher{Name: "severity", Value: "warning"}
+
+	tests := []struct {
+		name               string
+		route              prometheusv1alpha.Route
+		expectedConditions map[string]string
+		expectedFallback   bool
+	}{
+		{
+			name: "overlapping sibling routes",
+			route: prometheusv1alpha.Route{
+				Receiver: "slack",
+				Routes: []apiextensionsv1.JSON{
+					routeJSON(t, prometheusv1alpha.Route{Receiver: "pagerduty", Matchers: []prometheusv1alpha.Matcher{team}, Continue: true}),
+					routeJSON(t, prometheusv1alpha.Route{Receiver: "pagerduty", Matchers: []prometheusv1alpha.Matcher{critical}}),
+					routeJSON(t, prometheusv1alpha.Route{Matchers: []prometheusv1alpha.Matcher{warning}}),
+				},
+			},
+			expectedConditions: map[string]string{
+				"route.routes[0]-pagerduty": `alertDef.entityLabels["team"] == "payments"`,
+				"route.routes[1]-pagerduty": `alertDef.entityLabels["severity"] == "critical"`,
+				"route.routes[2]-slack":     `!(alertDef.entityLabels["severity"] == "critical") && alertDef.entityLabels["severity"] == "warning"`,
+			},
+			expectedFallback: true,
+		},
+		{
+			name: "nested routes",
+			route: prometheusv1alpha.Route{
+				Receiver: "slack",
+				Routes: []apiextensionsv1.JSON{
+					routeJSON(t, prometheusv1alpha.Route{
+						Receiver: "pagerduty",
+						Matchers: []prometheusv1alpha.Matcher{team},
+						Routes: []apiextensionsv1.JSON{
+							routeJSON(t, prometheusv1alpha.Route{Receiver: "slack", Matchers: []prometheusv1alpha.Matcher{warning}}),
+						},
+					}),
+					routeJSON(t, prometheusv1alpha.Route{Receiver: "pagerduty", Matchers: []prometheusv1alpha.Matcher{critical}}),
+				},
+			},
+			expectedConditions: map[string]string{
+				"route.routes[0]-pagerduty":       `alertDef.entityLabels["team"] == "payments" && !(alertDef.entityLabels["severity"] == "warning")`,
+				"route.routes[0].routes[0]-slack": `alertDef.entityLabels["team"] == "payments" && alertDef.entityLabels["severity"] == "warning"`,
+				"route.routes[1]-pagerduty":       `!(alertDef.entityLabels["team"] == "payments") && alertDef.entityLabels["severity"] == "critical"`,
+			},
+			expectedFallback: true,
+		},
+		{
+			name: "route without matchers",
+			route: prometheusv1alpha.Route{
+				Receiver: "slack",
+				Routes: []apiextensionsv1.JSON{
+					routeJSON(t, prometheusv1alpha.Route{Receiver: "pagerduty", Matchers: []prometheusv1alpha.Matcher{critical}}),
+					routeJSON(t, prometheusv1alpha.Route{Receiver: "pagerduty"}),
+					routeJSON(t, prometheusv1alpha.Route{Receiver: "slack", Matchers: []prometheusv1alpha.Matcher{warning}}),
+				},
+			},
+			expectedConditions: map[string]string{
+				"route.routes[0]-pagerduty": `alertDef.entityLabels["severity"] == "critical"`,
+				"route.routes[1]-pagerduty": `!(alertDef.entityLabels["severity"] == "critical")`,
+			},
+		},
+		{
+			name: "root route with matchers",
+			route: prometheusv1alpha.Route{
+				Receiver: "slack",
+				Matchers: []prometheusv1alpha.Matcher{team},
+				Routes: []apiextensionsv1.JSON{
+					routeJSON(t, prometheusv1alpha.Route{Receiver: "pagerduty", Matchers: []prometheusv1alpha.Matcher{critical}}),
+				},
+			},
+			expectedConditions: map[string]string{
+				"route-slack":               `alertDef.entityLabels["team"] == "payments" && !(alertDef.entityLabels["severity"] == "critical")`,
+				"route.routes[0]-pagerduty": `alertDef.entityLabels["team"] == "payments" && alertDef.entityLabels["severity"] == "critical"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amConfig := &prometheusv1alpha.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "example-config", Namespace: "default"},
+				Spec:       prometheusv1alpha.AlertmanagerConfigSpec{Route: &tt.route},
+			}
+
+			spec, err := alertmanagerConfigToGlobalRouterSpec(logr.Discard(), amConfig, receivers)
+			assert.NoError(t, err)
+
+			conditions := make(map[string]string, len(spec.Rules))
+			for _, rule := range spec.Rules {
+				conditions[rule.Name] = rule.Condition
+			}
+			assert.Equal(t, tt.expectedConditions, conditions)
+			assert.Equal(t, tt.expectedFallback, len(spec.FallbackTargets) > 0)
+		})
+	}
+}
+
+func TestAlertmanagerConfigRoutingLabels(t *testing.T) {
+	amConfig := &prometheusv1alpha.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example-config",
+			Namespace: "default",
+			Annotations: map[string]string{
+				utils.AlertmanagerConfigRoutingServiceAnnotationKey: "checkout",
+			},
+		},
+	}
+
+	assert.Equal(t, &coralogixv1alpha1.RoutingLabels{Service: ptr.To("checkout")}, alertmanagerConfigRoutingLabels(amConfig))
+}
+
+func TestAlertmanagerReceiverConfigsToConnectorSpecs(t *testing.T) {
+	amConfig := &prometheusv1alpha.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-config", Namespace: "default"},
+	}
+
+	_, err := slackConfigToConnectorSpec(amConfig, "slack", prometheusv1alpha.SlackConfig{Channel: "#alerts"})
+	assert.Error(t, err, "slack receivers require the integration id annotation")
+
+	amConfig.Annotations = map[string]string{utils.AlertmanagerConfigSlackIntegrationIDAnnotationKey: "some-integration-id"}
+	slackSpec, err := slackConfigToConnectorSpec(amConfig, "slack", prometheusv1alpha.SlackConfig{Channel: "#alerts"})
+	assert.NoError(t, err)
+	assert.Equal(t, []coralogixv1alpha1.ConnectorConfigField{
+		{FieldName: "integrationId", Value: ptr.To("some-integration-id")},
+		{FieldName: "fallbackChannel", Value: ptr.To("alerts")},
+		{FieldName: "channel", Value: ptr.To("alerts")},
+	}, slackSpec.ConnectorConfig.Fields)
+
+	serviceKey := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "pagerduty"}, Key: "serviceKey"}
+	pagerDutySpec, err := pagerDutyConfigToConnectorSpec(amConfig, "pagerduty", prometheusv1alpha.PagerDutyConfig{ServiceKey: serviceKey})
+	assert.NoError(t, err)
+	assert.Equal(t, "pagerDuty", pagerDutySpec.Type)
+	assert.Equal(t, []coralogixv1alpha1.ConnectorConfigField{
+		{FieldName: "integrationKey", SecretKeyRef: serviceKey},
+	}, pagerDutySpec.ConnectorConfig.Fields)
+
+	_, err = webhookConfigToConnectorSpec(amConfig, "webhook", prometheusv1alpha.WebhookConfig{})
+	assert.Error(t, err)
+
+	webhookSpec, err := webhookConfigToConnectorSpec(amConfig, "webhook", prometheusv1alpha.WebhookConfig{URL: ptr.To("https://example.com/alerts")})
+	assert.NoError(t, err)
+	assert.Equal(t, []coralogixv1alpha1.ConnectorConfigField{
+		{FieldName: "url", Value: ptr.To("https://example.com/alerts")},
+		{FieldName: "method", Value: ptr.To("POST")},
+	}, webhookSpec.ConnectorConfig.Fields)
+
+	emailSpec, err := emailConfigToConnectorSpec(amConfig, "email", prometheusv1alpha.EmailConfig{To: "oncall@example.com, sre@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, []coralogixv1alpha1.ConnectorConfigField{
+		{FieldName: "emailAddresses", Value: ptr.To(`["oncall@example.com","sre@example.com"]`)},
+	}, emailSpec.ConnectorConfig.Fields)
+
+	opsGenieSpec := opsGenieConfigToConnectorSpec(amConfig, "opsgenie", prometheusv1alpha.OpsGenieConfig{}, "example-config-opsgenie-opsgenie-0")
+	assert.Equal(t, ptr.To("https://api.opsgenie.com/v2/alerts"), opsGenieSpec.ConnectorConfig.Fields[0].Value)
+	assert.Equal(t, "example-config-opsgenie-opsgenie-0", opsGenieSpec.ConnectorConfig.Fields[2].SecretKeyRef.Name)
+}
+
+func routeJSON(t *testing.T, route prometheusv1alpha.Route) apiextensionsv1.JSON {
+	raw, err := json.Marshal(route)
+	assert.NoError(t, err)
+	return apiextensionsv1.JSON{Raw: raw}
+}
+
+func TestAlertmanagerConfigDeletesOnlyOwnedChildren(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+	assert.NoError(t, prometheusv1alpha.AddToScheme(scheme))
+
+	// The AlertmanagerConfig is no longer tracked, so its children are deleted.
+	amConfig := &prometheusv1alpha.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-config", Namespace: "default", UID: "am-config-uid"},
+	}
+	childLabels := map[string]string{managedByLabelKey: "example-config"}
+	ownerReferences := []metav1.OwnerReference{getAlertmanagerConfigOwnerReference(amConfig)}
+	ownedRouter := &coralogixv1alpha1.GlobalRouter{ObjectMeta: metav1.ObjectMeta{
+		Name: "example-config", Namespace: "default", Labels: childLabels, OwnerReferences: ownerReferences,
+	}}
+	ownedConnector := &coralogixv1alpha1.Connector{ObjectMeta: metav1.ObjectMeta{
+		Name: "example-config-slack-slack-0", Namespace: "default", Labels: childLabels, OwnerReferences: ownerReferences,
+	}}
+	foreignRouter := &coralogixv1alpha1.GlobalRouter{ObjectMeta: metav1.ObjectMeta{
+		Name: "hand-made", Namespace: "default", Labels: childLabels,
+	}}
+	foreignConnector := &coralogixv1alpha1.Connector{ObjectMeta: metav1.ObjectMeta{
+		Name: "hand-made", Namespace: "default", Labels: childLabels,
+	}}
+
+	originalClient := config.GetClient()
+	t.Cleanup(func() { config.InitClient(originalClient) })
+	config.InitClient(fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(amConfig, ownedRouter, ownedConnector, foreignRouter, foreignConnector).Build())
+
+	ctx := context.Background()
+	_, err := (&AlertmanagerConfigReconciler{}).Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(amConfig)})
+	assert.NoError(t, err)
+
+	var globalRouters coralogixv1alpha1.GlobalRouterList
+	assert.NoError(t, config.GetClient().List(ctx, &globalRouters, client.InNamespace("default")))
+	assert.Len(t, globalRouters.Items, 1)
+	assert.Equal(t, "hand-made", globalRouters.Items[0].Name)
+
+	var connectors coralogixv1alpha1.ConnectorList
+	assert.NoError(t, config.GetClient().List(ctx, &connectors, client.InNamespace("default")))
+	assert.Len(t, connectors.Items, 1)
+	assert.Equal(t, "hand-made", connectors.Items[0].Name)
+}
+
+func TestAlertmanagerConfigKeepsChildrenOfFailedReceivers(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+	assert.NoError(t, prometheusv1alpha.AddToScheme(scheme))
+
+	// The Secret holding the OpsGenie API key cannot be read, so the receiver fails to convert.
+	amConfig := &prometheusv1alpha.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example-config",
+			Namespace: "default",
+			UID:       "am-config-uid",
+			Labels:    map[string]string{utils.TrackAlertmanagerConfigLabelKey: "true"},
+		},
+		Spec: prometheusv1alpha.AlertmanagerConfigSpec{
+			Route: &prometheusv1alpha.Route{Receiver: "opsgenie"},
+			Receivers: []prometheusv1alpha.Receiver{{
+				Name: "opsgenie",
+				OpsGenieConfigs: []prometheusv1alpha.OpsGenieConfig{{
+					APIKey: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "opsgenie-api-key"},
+						Key:                  "apiKey",
+					},
+				}},
+			}},
+		},
+	}
+	childLabels := alertmanagerChildLabels(amConfig)
+	ownerReferences := []metav1.OwnerReference{getAlertmanagerConfigOwnerReference(amConfig)}
+	existingConnector := &coralogixv1alpha1.Connector{ObjectMeta: metav1.ObjectMeta{
+		Name: "example-config-opsgenie-opsgenie-0", Namespace: "default", Labels: childLabels, OwnerReferences: ownerReferences,
+	}}
+	existingSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name: "example-config-opsgenie-opsgenie-0", Namespace: "default", Labels: childLabels, OwnerReferences: ownerReferences,
+	}}
+	staleConnector := &coralogixv1alpha1.Connector{ObjectMeta: metav1.ObjectMeta{
+		Name: "example-config-removed-slack-0", Namespace: "default", Labels: childLabels, OwnerReferences: ownerReferences,
+	}}
+
+	originalClient := config.GetClient()
+	t.Cleanup(func() { config.InitClient(originalClient) })
+	config.InitClient(fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(amConfig, existingConnector, existingSecret, staleConnector).Build())
+
+	ctx := context.Background()
+	_, err := (&AlertmanagerConfigReconciler{}).Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(amConfig)})
+	assert.ErrorContains(t, err, "opsgenie-api-key")
+
+	var connectors coralogixv1alpha1.ConnectorList
+	assert.NoError(t, config.GetClient().List(ctx, &connectors, client.InNamespace("default")))
+	assert.Len(t, connectors.Items, 1)
+	assert.Equal(t, existingConnector.Name, connectors.Items[0].Name)
+	assert.NoError(t, config.GetClient().Get(ctx, client.ObjectKeyFromObject(existingSecret), &corev1.Secret{}))
+
+	globalRouter := &coralogixv1alpha1.GlobalRouter{}
+	assert.NoError(t, config.GetClient().Get(ctx, client.ObjectKeyFromObject(amConfig), globalRouter))
+	assert.Len(t, globalRouter.Spec.FallbackTargets, 1)
+	assert.Equal(t, existingConnector.Name, globalRouter.Spec.FallbackTargets[0].Target.Connector.ResourceRef.Name)
+}
@@ -0,0 +1,324 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	coralogixreconciler "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=coralogix.com,resources=alerts;alertsets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=coralogix.com,resources=alertschedulers,verbs=get;list;watch;create;update;patch;delete
+
+const (
+	// alertMuteManagedByLabelValue is the managedByLabelKey value of the AlertSchedulers created for muted alerts.
+	alertMuteManagedByLabelValue = "alert-mute"
+	// alertSchedulerTimeLayout is the layout of the AlertScheduler time frames.
+	alertSchedulerTimeLayout = "2006-01-02T15:04:05.000"
+)
+
+// AlertMuteReconciler mutes the Alerts and AlertSets annotated with utils.MuteUntilAnnotationKey, or all of them
+// in an annotated namespace, by managing a one-time AlertScheduler per annotated resource. The AlertScheduler is
+// deleted once the time in the annotation has passed, or when the annotation is removed.
+// Namespaces are reconciled, since a muted namespace covers every alert created in it.
+type AlertMuteReconciler struct{}
+
+// alertMute is the mute window of an annotated resource.
+type alertMute struct {
+	owner  client.Object
+	name   string
+	until  time.Time
+	reason string
+	alerts []coralogixv1alpha1.AlertRef
+}
+
+func (r *AlertMuteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	namespace := &corev1.Namespace{}
+	if err := config.GetClient().Get(ctx, client.ObjectKey{Name: req.Name}, namespace); err != nil {
+		if k8serrors.IsNotFound(err) {
+			// The AlertSchedulers of the namespace are deleted with it.
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	var alerts coralogixv1beta1.AlertList
+	if err := config.GetClient().List(ctx, &alerts, client.InNamespace(namespace.Name)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error listing alerts: %w", err)
+	}
+	var alertSets coralogixv1alpha1.AlertSetList
+	if err := config.GetClient().List(ctx, &alertSets, client.InNamespace(namespace.Name)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error listing alert sets: %w", err)
+	}
+
+	now := time.Now()
+	mutes, invalid := getAlertMutes(namespace, alerts.Items, alertSets.Items, now)
+	for _, err := range invalid {
+		log.Error(err, "Ignoring invalid mute annotation")
+	}
+
+	if err := r.syncAlertSchedulers(ctx, namespace.Name, mutes, now); err != nil {
+		log.Error(err, "Received an error while trying to sync alert mutes")
+		return ctrl.Result{}, err
+	}
+
+	var result ctrl.Result
+	for _, mute := range mutes {
+		if requeueAfter := mute.until.Sub(now); result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter {
+			result.RequeueAfter = requeueAfter
+		}
+	}
+	return result, nil
+}
+
+// getAlertMutes returns the mute windows of the namespace, its Alerts and its AlertSets that have not expired yet,
+// and the errors of invalid annotations.
+func getAlertMutes(
+	namespace *corev1.Namespace,
+	alerts []coralogixv1beta1.Alert,
+	alertSets []coralogixv1alpha1.AlertSet,
+	now time.Time,
+) ([]alertMute, []error) {
+	selector := config.GetConfig().Selector
+	var mutes []alertMute
+	var invalid []error
+	addMute := func(owner client.Object, name string, refs []coralogixv1alpha1.AlertRef) {
+		until, found, err := parseMuteUntil(owner)
+		if err != nil {
+			invalid = append(invalid, err)
+			return
+		}
+		if !found || !until.After(now) || len(refs) == 0 {
+			return
+		}
+		mutes = append(mutes, alertMute{
+			owner:  owner,
+			name:   name,
+			until:  until,
+			reason: owner.GetAnnotations()[utils.MuteReasonAnnotationKey],
+			alerts: refs,
+		})
+	}
+
+	// A muted namespace covers the alerts that are already created, since the AlertScheduler needs their IDs. It is
+	// updated as soon as the other alerts are created. No AlertScheduler is created for a namespace without alerts,
+	// since an AlertScheduler without alerts mutes all the alerts of the account.
+	var namespaceRefs []coralogixv1alpha1.AlertRef
+	for i := range alerts {
+		alert := &alerts[i]
+		if !selector.Matches(alert.Labels, alert.Namespace) {
+			continue
+		}
+		ref := []coralogixv1alpha1.AlertRef{{ResourceRef: &coralogixv1alpha1.ResourceRef{Name: alert.Name}}}
+		addMute(alert, "mute-alert-"+alert.Name, ref)
+		if alert.Status.ID != nil {
+			namespaceRefs = append(namespaceRefs, ref...)
+		}
+	}
+	for i := range alertSets {
+		alertSet := &alertSets[i]
+		if !selector.Matches(alertSet.Labels, alertSet.Namespace) {
+			continue
+		}
+		ref := []coralogixv1alpha1.AlertRef{{AlertSetRef: &coralogixv1alpha1.ResourceRef{Name: alertSet.Name}}}
+		addMute(alertSet, "mute-alertset-"+alertSet.Name, ref)
+		if alertSetCreated(alertSet) {
+			namespaceRefs = append(namespaceRefs, ref...)
+		}
+	}
+	addMute(namespace, "mute-namespace", namespaceRefs)
+
+	return mutes, invalid
+}
+
+func parseMuteUntil(obj client.Object) (time.Time, bool, error) {
+	value, found := obj.GetAnnotations()[utils.MuteUntilAnnotationKey]
+	if !found {
+		return time.Time{}, false, nil
+	}
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("annotation %s of %s is not an RFC3339 time: %w",
+			utils.MuteUntilAnnotationKey, client.ObjectKeyFromObject(obj), err)
+	}
+	return until, true, nil
+}
+
+func alertSetCreated(alertSet *coralogixv1alpha1.AlertSet) bool {
+	if len(alertSet.Status.Alerts) == 0 {
+		return false
+	}
+	for _, item := range alertSet.Status.Alerts {
+		if item.ID == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// syncAlertSchedulers creates and updates the AlertSchedulers of the mutes, and deletes the other AlertSchedulers
+// created for mutes in the namespace.
+func (r *AlertMuteReconciler) syncAlertSchedulers(ctx context.Context, namespace string, mutes []alertMute, now time.Time) error {
+	var existing coralogixv1alpha1.AlertSchedulerList
+	if err := config.GetClient().List(ctx, &existing, client.InNamespace(namespace),
+		client.MatchingLabels{managedByLabelKey: alertMuteManagedByLabelValue}); err != nil {
+		return fmt.Errorf("received an error while trying to list AlertSchedulers: %w", err)
+	}
+	existingByName := make(map[string]*coralogixv1alpha1.AlertScheduler, len(existing.Items))
+	for i := range existing.Items {
+		existingByName[existing.Items[i].Name] = &existing.Items[i]
+	}
+
+	var errorsEncountered []error
+	for _, mute := range mutes {
+		current := existingByName[mute.name]
+		delete(existingByName, mute.name)
+		if err := r.applyAlertScheduler(ctx, namespace, mute, current, now); err != nil {
+			errorsEncountered = append(errorsEncountered, err)
+		}
+	}
+
+	for _, alertScheduler := range existingByName {
+		if err := config.GetClient().Delete(ctx, alertScheduler); err != nil && !k8serrors.IsNotFound(err) {
+			errorsEncountered = append(errorsEncountered, fmt.Errorf("error deleting AlertScheduler %s: %w", alertScheduler.Name, err))
+		}
+	}
+
+	return errors.Join(errorsEncountered...)
+}
+
+func (r *AlertMuteReconciler) applyAlertScheduler(
+	ctx context.Context,
+	namespace string,
+	mute alertMute,
+	current *coralogixv1alpha1.AlertScheduler,
+	now time.Time,
+) error {
+	// The start of the window is kept once created, so that the AlertScheduler is not updated on every reconcile.
+	startTime := now.UTC().Format(alertSchedulerTimeLayout)
+	if current != nil && current.Spec.Schedule.OneTime != nil {
+		startTime = current.Spec.Schedule.OneTime.StartTime
+	}
+
+	description := fmt.Sprintf("Muted through the %s annotation.", utils.MuteUntilAnnotationKey)
+	if mute.reason != "" {
+		description = mute.reason
+	}
+
+	desired := &coralogixv1alpha1.AlertScheduler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mute.name,
+			Namespace: namespace,
+			Labels:    alertMuteChildLabels(),
+		},
+		Spec: coralogixv1alpha1.AlertSchedulerSpec{
+			Name:        fmt.Sprintf("Mute %s until %s", mute.name, mute.until.UTC().Format(time.RFC3339)),
+			Description: description,
+			Enabled:     true,
+			Filter: coralogixv1alpha1.Filter{
+				Alerts: mute.alerts,
+			},
+			Schedule: coralogixv1alpha1.Schedule{
+				Operation: "mute",
+				OneTime: &coralogixv1alpha1.TimeFrame{
+					StartTime: startTime,
+					EndTime:   ptr.To(mute.until.UTC().Format(alertSchedulerTimeLayout)),
+					Timezone:  "UTC+0",
+				},
+			},
+		},
+	}
+	if err := controllerutil.SetOwnerReference(mute.owner, desired, config.GetClient().Scheme()); err != nil {
+		return fmt.Errorf("error setting owner of AlertScheduler %s: %w", desired.Name, err)
+	}
+
+	if current == nil {
+		if err := config.GetClient().Create(ctx, desired); err != nil {
+			return fmt.Errorf("error creating AlertScheduler %s: %w", desired.Name, err)
+		}
+		return nil
+	}
+
+	if reflect.DeepEqual(current.Labels, desired.Labels) &&
+		reflect.DeepEqual(current.OwnerReferences, desired.OwnerReferences) &&
+		reflect.DeepEqual(current.Spec, desired.Spec) {
+		return nil
+	}
+
+	current.Labels = desired.Labels
+	current.OwnerReferences = desired.OwnerReferences
+	current.Spec = desired.Spec
+	if err := config.GetClient().Update(ctx, current); err != nil {
+		return fmt.Errorf("error updating AlertScheduler %s: %w", desired.Name, err)
+	}
+	return nil
+}
+
+// alertMuteChildLabels returns the labels of the AlertSchedulers created for mutes. They are derived from the label
+// selector of the operator rather than from the muted resource, since a muted namespace has no selected labels.
+func alertMuteChildLabels() map[string]string {
+	labels := config.GetConfig().Selector.Labels()
+	labels[managedByLabelKey] = alertMuteManagedByLabelValue
+	return labels
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AlertMuteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	selector := config.GetConfig().Selector
+	enqueueNamespace := handler.EnqueueRequestsFromMapFunc(func(_ context.Context, obj client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: obj.GetNamespace()}}}
+	})
+	muteAnnotated := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, found := obj.GetAnnotations()[utils.MuteUntilAnnotationKey]
+		return found
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("alertmute").
+		For(&corev1.Namespace{}, builder.WithPredicates(predicate.Or(muteAnnotated, predicate.AnnotationChangedPredicate{}))).
+		Watches(&coralogixv1beta1.Alert{}, enqueueNamespace, builder.WithPredicates(selector.Predicate())).
+		Watches(&coralogixv1alpha1.AlertSet{}, enqueueNamespace, builder.WithPredicates(selector.Predicate())).
+		Watches(&coralogixv1alpha1.AlertScheduler{}, enqueueNamespace, builder.WithPredicates(
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.GetLabels()[managedByLabelKey] == alertMuteManagedByLabelValue
+			}))).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
+		Complete(r)
+}
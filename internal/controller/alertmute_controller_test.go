@@ -0,0 +1,157 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+func TestAlertMuteReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+	require.NoError(t, coralogixv1beta1.AddToScheme(scheme))
+
+	until := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "checkout",
+		UID:    "namespace-uid",
+		Labels: map[string]string{corev1.LabelMetadataName: "checkout"},
+	}}
+	mutedAlert := &coralogixv1beta1.Alert{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "errors",
+			Namespace: "checkout",
+			UID:       "alert-uid",
+			Labels:    map[string]string{"team": "checkout"},
+			Annotations: map[string]string{
+				utils.MuteUntilAnnotationKey:  until.Format(time.RFC3339),
+				utils.MuteReasonAnnotationKey: "Deploying checkout v2",
+			},
+		},
+		Status: coralogixv1beta1.AlertStatus{ID: ptr.To("alert-1")},
+	}
+	expiredAlertSet := &coralogixv1alpha1.AlertSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "latency",
+			Namespace:   "checkout",
+			Annotations: map[string]string{utils.MuteUntilAnnotationKey: time.Now().Add(-time.Hour).Format(time.RFC3339)},
+		},
+	}
+	expiredMute := &coralogixv1alpha1.AlertScheduler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mute-alertset-latency",
+			Namespace: "checkout",
+			Labels:    map[string]string{managedByLabelKey: alertMuteManagedByLabelValue},
+		},
+	}
+
+	selector, err := labels.Parse("team=checkout")
+	require.NoError(t, err)
+	originalClient := config.GetClient()
+	originalSelector := config.GetConfig().Selector
+	t.Cleanup(func() {
+		config.InitClient(originalClient)
+		config.GetConfig().Selector = originalSelector
+	})
+	config.InitClient(fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(namespace, mutedAlert, expiredAlertSet, expiredMute).Build())
+	config.GetConfig().Selector = config.Selector{LabelSelector: selector}
+
+	ctx := context.Background()
+	reconciler := &AlertMuteReconciler{}
+	result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Name: "checkout"}})
+	require.NoError(t, err)
+	require.InDelta(t, time.Hour, result.RequeueAfter, float64(time.Minute))
+
+	var schedulers coralogixv1alpha1.AlertSchedulerList
+	require.NoError(t, config.GetClient().List(ctx, &schedulers, client.InNamespace("checkout")))
+	require.Len(t, schedulers.Items, 1, "the expired mute is deleted")
+
+	scheduler := schedulers.Items[0]
+	require.Equal(t, "mute-alert-errors", scheduler.Name)
+	require.Equal(t, map[string]string{"team": "checkout", managedByLabelKey: alertMuteManagedByLabelValue}, scheduler.Labels)
+	require.Len(t, scheduler.OwnerReferences, 1)
+	require.Equal(t, mutedAlert.UID, scheduler.OwnerReferences[0].UID)
+	require.Equal(t, "Deploying checkout v2", scheduler.Spec.Description)
+	require.Equal(t, []coralogixv1alpha1.AlertRef{{ResourceRef: &coralogixv1alpha1.ResourceRef{Name: "errors"}}}, scheduler.Spec.Filter.Alerts)
+	require.Equal(t, "mute", scheduler.Spec.Schedule.Operation)
+	require.Equal(t, until.Format(alertSchedulerTimeLayout), *scheduler.Spec.Schedule.OneTime.EndTime)
+	startTime := scheduler.Spec.Schedule.OneTime.StartTime
+
+	// Muting the whole namespace.
+	namespace.Annotations = map[string]string{utils.MuteUntilAnnotationKey: until.Format(time.RFC3339)}
+	require.NoError(t, config.GetClient().Update(ctx, namespace))
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Name: "checkout"}})
+	require.NoError(t, err)
+
+	namespaceMute := &coralogixv1alpha1.AlertScheduler{}
+	require.NoError(t, config.GetClient().Get(ctx, client.ObjectKey{Name: "mute-namespace", Namespace: "checkout"}, namespaceMute))
+	require.Equal(t, []coralogixv1alpha1.AlertRef{{ResourceRef: &coralogixv1alpha1.ResourceRef{Name: "errors"}}},
+		namespaceMute.Spec.Filter.Alerts, "alert sets without created alerts are not muted yet")
+	require.Equal(t, namespace.UID, namespaceMute.OwnerReferences[0].UID)
+	require.Equal(t, map[string]string{"team": "checkout", managedByLabelKey: alertMuteManagedByLabelValue}, namespaceMute.Labels,
+		"the labels match the selector of the operator rather than those of the namespace")
+
+	alertMute := &coralogixv1alpha1.AlertScheduler{}
+	require.NoError(t, config.GetClient().Get(ctx, client.ObjectKey{Name: "mute-alert-errors", Namespace: "checkout"}, alertMute))
+	require.Equal(t, startTime, alertMute.Spec.Schedule.OneTime.StartTime, "the start of the window is kept")
+
+	// Unmuting the namespace and the alert.
+	namespace.Annotations = nil
+	require.NoError(t, config.GetClient().Update(ctx, namespace))
+	mutedAlert = &coralogixv1beta1.Alert{}
+	require.NoError(t, config.GetClient().Get(ctx, client.ObjectKey{Name: "errors", Namespace: "checkout"}, mutedAlert))
+	mutedAlert.Annotations = nil
+	require.NoError(t, config.GetClient().Update(ctx, mutedAlert))
+	result, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Name: "checkout"}})
+	require.NoError(t, err)
+	require.Zero(t, result.RequeueAfter)
+
+	require.NoError(t, config.GetClient().List(ctx, &schedulers, client.InNamespace("checkout")))
+	require.Empty(t, schedulers.Items)
+}
+
+func TestAlertMutesIgnoreInvalidAnnotations(t *testing.T) {
+	alert := coralogixv1beta1.Alert{ObjectMeta: metav1.ObjectMeta{
+		Name:        "errors",
+		Namespace:   "checkout",
+		Annotations: map[string]string{utils.MuteUntilAnnotationKey: "tomorrow"},
+	}}
+
+	mutes, invalid := getAlertMutes(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "checkout"}},
+		[]coralogixv1beta1.Alert{alert}, nil, time.Now())
+	require.Empty(t, mutes)
+	require.Len(t, invalid, 1)
+	require.ErrorContains(t, invalid[0], "annotation app.coralogix.com/mute-until of checkout/errors is not an RFC3339 time")
+}
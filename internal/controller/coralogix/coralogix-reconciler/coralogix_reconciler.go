@@ -38,6 +38,9 @@ import (
 	"github.com/coralogix/coralogix-operator/v2/api/coralogix"
 	"github.com/coralogix/coralogix-operator/v2/internal/config"
 	"github.com/coralogix/coralogix-operator/v2/internal/monitoring"
+	"github.com/coralogix/coralogix-operator/v2/internal/provenance"
+	"github.com/coralogix/coralogix-operator/v2/internal/queryvalidation"
+	"github.com/coralogix/coralogix-operator/v2/internal/ruleengine"
 	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 )
 
@@ -50,6 +53,14 @@ type CoralogixReconciler interface {
 	RequeueInterval() time.Duration
 }
 
+// DeadlineReconciler is a CoralogixReconciler whose resources may have to be reconciled again before their next
+// periodic reconciliation, e.g. to promote the shadow alert of a rollout at the end of its soak period.
+type DeadlineReconciler interface {
+	CoralogixReconciler
+	// RequeueDeadline returns the delay until the resource must be reconciled again, or 0 if it has no deadline.
+	RequeueDeadline(obj client.Object) time.Duration
+}
+
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 func ReconcileResource(ctx context.Context, req ctrl.Request, obj coralogix.Object, r CoralogixReconciler) (ctrl.Result, error) {
@@ -67,9 +78,33 @@ func ReconcileResource(ctx context.Context, req ctrl.Request, obj coralogix.Obje
 		"namespace", req.Namespace)
 	log = log.V(logVerbosity(obj))
 
+	if list := singletonList(r); list != nil &&
+		obj.GetDeletionTimestamp().IsZero() &&
+		config.GetConfig().Selector.Matches(obj.GetLabels(), obj.GetNamespace()) {
+		winner, err := ElectSingleton(ctx, obj, list)
+		if err != nil {
+			return ManageErrorWithRequeue(ctx, obj, utils.ReasonInternalK8sError, err)
+		}
+		if winner.GetUID() != obj.GetUID() {
+			log.Info("Another selected resource manages the account-wide settings; skipping",
+				"electedNamespace", winner.GetNamespace(),
+				"electedName", winner.GetName())
+			return ManageConflictWithRequeue(ctx, obj, winner, r.RequeueInterval())
+		}
+	}
+
 	if !obj.HasIDInStatus() {
 		log.Info("Resource ID is missing; handling creation for resource")
 		if err := r.HandleCreation(ctx, log, obj); err != nil {
+			if queryvalidation.IsError(err) {
+				return ManageErrorWithRequeue(ctx, obj, utils.ReasonInvalidQuery, err)
+			}
+			if ruleengine.IsTestsFailedError(err) {
+				return ManageErrorWithoutRetry(ctx, obj, utils.ReasonRuleGroupTestsFailed, err)
+			}
+			if provenance.IsForeignError(err) {
+				return ManageErrorWithRequeue(ctx, obj, utils.ReasonForeignRemoteObject, err)
+			}
 			if oapisdk.IsDeserializationError(err) {
 				return ManageErrorWithRequeue(ctx, obj, utils.ReasonDeserializationError, err)
 			}
@@ -87,12 +122,17 @@ func ReconcileResource(ctx context.Context, req ctrl.Request, obj coralogix.Obje
 			return ManageErrorWithRequeue(ctx, obj, utils.ReasonInternalK8sError, err)
 		}
 
-		return ManageSuccessWithRequeue(ctx, obj, r.RequeueInterval())
+		result, err := ManageSuccessWithRequeue(ctx, obj, r.RequeueInterval())
+		return requeueAtDeadline(result, err, obj, r)
 	}
 
 	if !obj.GetDeletionTimestamp().IsZero() {
 		log.Info("Resource is being deleted; handling deletion")
-		if err := r.HandleDeletion(ctx, log, obj); err != nil {
+		if err := handleDeletion(ctx, log, obj, r); err != nil {
+			if delay, waiting := IsWaitingError(err); waiting {
+				log.Info("Waiting before deleting from remote", "reason", err.Error())
+				return ctrl.Result{RequeueAfter: delay}, nil
+			}
 			log.Error(err, "Error deleting from remote")
 			if oapisdk.IsDeserializationError(err) {
 				return ManageErrorWithRequeue(ctx, obj, utils.ReasonDeserializationError, err)
@@ -115,7 +155,11 @@ func ReconcileResource(ctx context.Context, req ctrl.Request, obj coralogix.Obje
 
 	if !config.GetConfig().Selector.Matches(obj.GetLabels(), obj.GetNamespace()) {
 		log.Info("Resource doesn't match selector; handling deletion")
-		if err := r.HandleDeletion(ctx, log, obj); err != nil {
+		if err := handleDeletion(ctx, log, obj, r); err != nil {
+			if delay, waiting := IsWaitingError(err); waiting {
+				log.Info("Waiting before deleting from remote", "reason", err.Error())
+				return ctrl.Result{RequeueAfter: delay}, nil
+			}
 			log.Error(err, "Error deleting from remote")
 			return ManageErrorWithRequeue(ctx, obj, utils.ReasonRemoteDeletionFailed, err)
 		}
@@ -141,7 +185,13 @@ func ReconcileResource(ctx context.Context, req ctrl.Request, obj coralogix.Obje
 	log.Info("Handling update")
 	if err := r.HandleUpdate(ctx, log, obj); err != nil {
 		log.Error(err, "Error handling update")
-		if cxsdk.Code(err) == codes.NotFound || oapisdk.IsNotFound(err) {
+		if queryvalidation.IsError(err) {
+			return ManageErrorWithRequeue(ctx, obj, utils.ReasonInvalidQuery, err)
+		} else if ruleengine.IsTestsFailedError(err) {
+			return ManageErrorWithoutRetry(ctx, obj, utils.ReasonRuleGroupTestsFailed, err)
+		} else if provenance.IsForeignError(err) {
+			return ManageErrorWithRequeue(ctx, obj, utils.ReasonForeignRemoteObject, err)
+		} else if cxsdk.Code(err) == codes.NotFound || oapisdk.IsNotFound(err) {
 			log.Info("resource not found on remote")
 			if err := removeField(ctx, obj, "status", "id"); err != nil {
 				log.Error(err, "Error removing id from status")
@@ -155,7 +205,24 @@ func ReconcileResource(ctx context.Context, req ctrl.Request, obj coralogix.Obje
 		return ManageErrorWithRequeue(ctx, obj, utils.ReasonRemoteUpdateFailed, fmt.Errorf("error on updating %s: %w", gvk, err))
 	}
 
-	return ManageSuccessWithRequeue(ctx, obj, r.RequeueInterval())
+	result, err := ManageSuccessWithRequeue(ctx, obj, r.RequeueInterval())
+	return requeueAtDeadline(result, err, obj, r)
+}
+
+// requeueAtDeadline requeues a successfully reconciled resource by its deadline, if its reconciler has deadlines.
+func requeueAtDeadline(result ctrl.Result, err error, obj client.Object, r CoralogixReconciler) (ctrl.Result, error) {
+	if deadlines, ok := r.(DeadlineReconciler); ok && err == nil {
+		result = RequeueWithin(result, deadlines.RequeueDeadline(obj))
+	}
+	return result, err
+}
+
+// RequeueWithin shortens the requeue of a result to the given delay, unless the delay is 0.
+func RequeueWithin(result ctrl.Result, delay time.Duration) ctrl.Result {
+	if delay > 0 && (result.RequeueAfter == 0 || delay < result.RequeueAfter) {
+		result.RequeueAfter = delay
+	}
+	return result
 }
 
 func removeField(ctx context.Context, obj client.Object, fields ...string) error {
@@ -189,9 +256,23 @@ func clearRemoteStatus(ctx context.Context, obj client.Object) error {
 		{"status", "printableStatus"},
 		{"status", "externalId"}, // OutboundWebhook
 		{"status", "revision"},   // SLO
+		{"status", "appliedDefaults"},
+		{"status", "nextReconcileAt"},
+		{"status", "url"},
+		{"status", "createdAt"}, // Alert, SLO
+		{"status", "updatedAt"}, // Alert, SLO
 	})
 }
 
+// RemoteTime returns a time of a remote object as recorded in the status, which is precise to the second.
+func RemoteTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	remoteTime := metav1.NewTime(*t).Rfc3339Copy()
+	return &remoteTime
+}
+
 func AddFinalizer(ctx context.Context, log logr.Logger, obj client.Object, r CoralogixReconciler) error {
 	if !controllerutil.ContainsFinalizer(obj, r.FinalizerName()) {
 		log.Info("Adding finalizer")
@@ -224,7 +305,9 @@ func ManageErrorWithRequeue(ctx context.Context, obj coralogix.Object, reason st
 		return reconcile.Result{}, nil
 	}
 
-	if utils.SetSyncedConditionFalse(&conditions, obj.GetGeneration(), reason, err.Error()) || obj.GetPrintableStatus() != "RemoteUnsynced" {
+	requestAcknowledged := AcknowledgeReconcileRequest(obj)
+	unscheduled := UnscheduleNextReconcile(obj)
+	if utils.SetSyncedConditionFalse(&conditions, obj.GetGeneration(), reason, err.Error()) || requestAcknowledged || unscheduled || obj.GetPrintableStatus() != "RemoteUnsynced" {
 		obj.SetConditions(conditions)
 		obj.SetPrintableStatus("RemoteUnsynced")
 		if err := config.GetClient().Status().Update(ctx, obj); err != nil {
@@ -243,9 +326,20 @@ func ManageErrorWithRequeue(ctx context.Context, obj coralogix.Object, reason st
 	return reconcile.Result{}, err
 }
 
+// ManageErrorWithoutRetry marks the resource as unsynced as ManageErrorWithRequeue does, but without retrying it, for
+// errors that persist until its spec changes, e.g. failed rule-group tests.
+func ManageErrorWithoutRetry(ctx context.Context, obj coralogix.Object, reason string, err error) (reconcile.Result, error) {
+	result, _ := ManageErrorWithRequeue(ctx, obj, reason, err)
+	return result, nil
+}
+
 func ManageSuccessWithRequeue(ctx context.Context, obj coralogix.Object, interval time.Duration) (reconcile.Result, error) {
 	conditions := obj.GetConditions()
-	if utils.SetSyncedConditionTrue(&conditions, obj.GetGeneration(), utils.ReasonRemoteSyncedSuccessfully) || obj.GetPrintableStatus() != "RemoteSynced" {
+	conflictRemoved := utils.RemoveConflictCondition(&conditions)
+	requestAcknowledged := AcknowledgeReconcileRequest(obj)
+	requeueAfter, scheduled := ScheduleNextReconcile(obj, interval)
+	urlChanged := setRemoteURL(obj)
+	if utils.SetSyncedConditionTrue(&conditions, obj.GetGeneration(), utils.ReasonRemoteSyncedSuccessfully) || conflictRemoved || requestAcknowledged || scheduled || urlChanged || obj.GetPrintableStatus() != "RemoteSynced" {
 		obj.SetConditions(conditions)
 		obj.SetPrintableStatus("RemoteSynced")
 		if err := config.GetClient().Status().Update(ctx, obj); err != nil {
@@ -259,7 +353,25 @@ func ManageSuccessWithRequeue(ctx context.Context, obj coralogix.Object, interva
 		obj.GetNamespace(),
 	)
 
-	return reconcile.Result{RequeueAfter: interval}, nil
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// ReconcileRequested reports whether the reconcile-requested-at annotation of a resource changed since its latest
+// reconciliation. Controllers that skip updating remote objects that look up to date must update them when it does.
+func ReconcileRequested(obj coralogix.Object) bool {
+	requestedAt := obj.GetAnnotations()[utils.ReconcileRequestedAtAnnotationKey]
+	return requestedAt != "" && requestedAt != obj.GetLastHandledReconcileAt()
+}
+
+// AcknowledgeReconcileRequest records the reconcile-requested-at annotation of a resource in its
+// status.lastHandledReconcileAt, so that clients can wait for the requested reconciliation to complete. It returns true
+// if the status changed.
+func AcknowledgeReconcileRequest(obj coralogix.Object) bool {
+	if !ReconcileRequested(obj) {
+		return false
+	}
+	obj.SetLastHandledReconcileAt(obj.GetAnnotations()[utils.ReconcileRequestedAtAnnotationKey])
+	return true
 }
 
 func objToGVK(obj client.Object) string {
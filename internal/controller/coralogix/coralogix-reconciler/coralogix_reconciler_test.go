@@ -21,6 +21,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -32,6 +33,8 @@ import (
 
 	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
 	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/ruleengine"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 )
 
 // noopReconciler is a stub CoralogixReconciler used to drive ReconcileResource in tests
@@ -63,6 +66,154 @@ func (n *noopReconciler) RequeueInterval() time.Duration {
 	return time.Minute
 }
 
+// deadlineReconciler is a noopReconciler whose resources must be reconciled again after a fixed delay.
+type deadlineReconciler struct {
+	noopReconciler
+	deadline time.Duration
+}
+
+func (d *deadlineReconciler) RequeueDeadline(obj client.Object) time.Duration {
+	return d.deadline
+}
+
+func TestReconcileResourceRequeuesAtDeadline(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+
+	dashboardID := "some-remote-id"
+	dashboard := &coralogixv1alpha1.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "dashboard", Namespace: "default"},
+		Status:     coralogixv1alpha1.DashboardStatus{ID: &dashboardID},
+	}
+	controllerutil.AddFinalizer(dashboard, (&noopReconciler{}).FinalizerName())
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(dashboard).
+		WithStatusSubresource(dashboard).
+		Build()
+
+	originalClient := config.GetClient()
+	originalScheme := config.GetScheme()
+	t.Cleanup(func() {
+		config.InitClient(originalClient)
+		config.InitScheme(originalScheme)
+	})
+	config.InitClient(fakeClient)
+	config.InitScheme(scheme)
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: dashboard.Name, Namespace: dashboard.Namespace}}
+	result, err := ReconcileResource(context.Background(), req, &coralogixv1alpha1.Dashboard{}, &deadlineReconciler{deadline: 10 * time.Second})
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Second, result.RequeueAfter)
+
+	// Deadlines after the next periodic reconciliation don't postpone it.
+	result, err = ReconcileResource(context.Background(), req, &coralogixv1alpha1.Dashboard{}, &deadlineReconciler{deadline: time.Hour})
+	require.NoError(t, err)
+	require.InDelta(t, time.Minute, result.RequeueAfter, float64(time.Second))
+}
+
+// waitingReconciler is a noopReconciler whose deletions wait for other resources.
+type waitingReconciler struct {
+	noopReconciler
+}
+
+func (w *waitingReconciler) HandleDeletion(ctx context.Context, log logr.Logger, obj client.Object) error {
+	w.deletionCalls++
+	return NewWaitingError(5*time.Second, "waiting for children to be deleted")
+}
+
+func TestReconcileResourceRequeuesWaitingDeletion(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+
+	dashboardID := "some-remote-id"
+	now := metav1.Now()
+	dashboard := &coralogixv1alpha1.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "dashboard", Namespace: "default", DeletionTimestamp: &now},
+		Status:     coralogixv1alpha1.DashboardStatus{ID: &dashboardID, PrintableStatus: "RemoteSynced"},
+	}
+	controllerutil.AddFinalizer(dashboard, (&noopReconciler{}).FinalizerName())
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(dashboard).
+		WithStatusSubresource(dashboard).
+		Build()
+
+	originalClient := config.GetClient()
+	originalScheme := config.GetScheme()
+	t.Cleanup(func() {
+		config.InitClient(originalClient)
+		config.InitScheme(originalScheme)
+	})
+	config.InitClient(fakeClient)
+	config.InitScheme(scheme)
+
+	reconciler := &waitingReconciler{}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: dashboard.Name, Namespace: dashboard.Namespace}}
+	result, err := ReconcileResource(context.Background(), req, &coralogixv1alpha1.Dashboard{}, reconciler)
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, result.RequeueAfter)
+	require.Equal(t, 1, reconciler.deletionCalls)
+
+	// The resource is neither marked as failed nor released.
+	fetched := &coralogixv1alpha1.Dashboard{}
+	require.NoError(t, fakeClient.Get(context.Background(), req.NamespacedName, fetched))
+	require.Equal(t, "RemoteSynced", fetched.Status.PrintableStatus)
+	require.True(t, controllerutil.ContainsFinalizer(fetched, reconciler.FinalizerName()))
+}
+
+// testsFailedReconciler is a noopReconciler whose rule-group tests fail.
+type testsFailedReconciler struct {
+	noopReconciler
+}
+
+func (f *testsFailedReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
+	f.creationCalls++
+	return ruleengine.FailedTestsError([]coralogixv1alpha1.RuleGroupTestResult{
+		{Name: "drops health checks", Message: "the log was not blocked"},
+	})
+}
+
+func TestReconcileResourceDoesNotRetryFailedRuleGroupTests(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+
+	ruleGroup := &coralogixv1alpha1.RuleGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "rule-group", Namespace: "default", Generation: 2},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(ruleGroup).
+		WithStatusSubresource(ruleGroup).
+		Build()
+
+	originalClient := config.GetClient()
+	originalScheme := config.GetScheme()
+	t.Cleanup(func() {
+		config.InitClient(originalClient)
+		config.InitScheme(originalScheme)
+	})
+	config.InitClient(fakeClient)
+	config.InitScheme(scheme)
+
+	reconciler := &testsFailedReconciler{}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: ruleGroup.Name, Namespace: ruleGroup.Namespace}}
+	result, err := ReconcileResource(context.Background(), req, &coralogixv1alpha1.RuleGroup{}, reconciler)
+	require.NoError(t, err, "failed tests are not retried until the spec changes")
+	require.Zero(t, result)
+	require.Equal(t, 1, reconciler.creationCalls)
+
+	fetched := &coralogixv1alpha1.RuleGroup{}
+	require.NoError(t, fakeClient.Get(context.Background(), req.NamespacedName, fetched))
+	condition := meta.FindStatusCondition(fetched.Status.Conditions, utils.ConditionTypeRemoteSynced)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, utils.ReasonRuleGroupTestsFailed, condition.Reason)
+	require.Contains(t, condition.Message, `"drops health checks"`)
+}
+
 func TestReconcileResourceSelectorMismatchPreservesDashboardImported(t *testing.T) {
 	scheme := runtime.NewScheme()
 	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
@@ -129,3 +280,48 @@ func TestReconcileResourceSelectorMismatchPreservesDashboardImported(t *testing.
 	require.Empty(t, fetched.Status.PrintableStatus)
 	require.True(t, fetched.Status.Imported, "status.imported must survive a selector-mismatch status clear")
 }
+
+func TestReconcileResourceAcknowledgesReconcileRequest(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+
+	dashboardID := "some-remote-id"
+	dashboard := &coralogixv1alpha1.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "dashboard",
+			Namespace: "default",
+			Annotations: map[string]string{
+				utils.ReconcileRequestedAtAnnotationKey: "2026-10-19T10:00:00Z",
+			},
+		},
+		Status: coralogixv1alpha1.DashboardStatus{ID: &dashboardID},
+	}
+	controllerutil.AddFinalizer(dashboard, (&noopReconciler{}).FinalizerName())
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(dashboard).
+		WithStatusSubresource(dashboard).
+		Build()
+
+	originalClient := config.GetClient()
+	originalScheme := config.GetScheme()
+	t.Cleanup(func() {
+		config.InitClient(originalClient)
+		config.InitScheme(originalScheme)
+	})
+	config.InitClient(fakeClient)
+	config.InitScheme(scheme)
+
+	require.True(t, ReconcileRequested(dashboard))
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: dashboard.Name, Namespace: dashboard.Namespace}}
+	_, err := ReconcileResource(context.Background(), req, &coralogixv1alpha1.Dashboard{}, &noopReconciler{})
+	require.NoError(t, err)
+
+	fetched := &coralogixv1alpha1.Dashboard{}
+	require.NoError(t, fakeClient.Get(context.Background(), req.NamespacedName, fetched))
+	require.Equal(t, "2026-10-19T10:00:00Z", fetched.Status.LastHandledReconcileAt)
+	require.Equal(t, "RemoteSynced", fetched.Status.PrintableStatus)
+	require.False(t, ReconcileRequested(fetched))
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coralogixreconciler
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+)
+
+// EnqueueForCoralogixDefaults enqueues the resources listed by newList whose defaults change when a
+// CoralogixDefaults is created, deleted, or changes its spec or labels: the resources of its namespace, or all of
+// them if it is cluster-wide.
+func EnqueueForCoralogixDefaults(newList func() client.ObjectList) handler.EventHandler {
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueueForCoralogixDefaults(ctx, newList, q, e.Object)
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			if e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() ||
+				!maps.Equal(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels()) {
+				enqueueForCoralogixDefaults(ctx, newList, q, e.ObjectOld, e.ObjectNew)
+			}
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueueForCoralogixDefaults(ctx, newList, q, e.Object)
+		},
+	}
+}
+
+func enqueueForCoralogixDefaults(ctx context.Context, newList func() client.ObjectList, q workqueue.TypedRateLimitingInterface[reconcile.Request], objs ...client.Object) {
+	var opts []client.ListOption
+	for _, obj := range objs {
+		if defaults, ok := obj.(*coralogixv1alpha1.CoralogixDefaults); ok && defaults.Spec.ClusterWide {
+			opts = nil
+			break
+		}
+		opts = []client.ListOption{client.InNamespace(obj.GetNamespace())}
+	}
+
+	list := newList()
+	if err := config.GetClient().List(ctx, list, opts...); err != nil {
+		log.FromContext(ctx).Error(err, "Error listing resources using CoralogixDefaults", "type", fmt.Sprintf("%T", list))
+		return
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return
+	}
+	for _, item := range items {
+		// The resources not selected by the operator are not reconciled, whatever their defaults.
+		if obj, ok := item.(client.Object); ok && config.GetConfig().Selector.Matches(obj.GetLabels(), obj.GetNamespace()) {
+			q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}})
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coralogixreconciler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+)
+
+func TestEnqueueForCoralogixDefaultsEnqueuesSelectedResources(t *testing.T) {
+	newSLO := func(namespace, name, team string) *coralogixv1alpha1.SLO {
+		return &coralogixv1alpha1.SLO{ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels:    map[string]string{"team": team},
+		}}
+	}
+	setupSingletonTest(t,
+		newSLO("payments", "availability", "payments"),
+		newSLO("payments", "latency", "search"),
+		newSLO("checkout", "errors", "payments"),
+	)
+	selector, err := labels.Parse("team=payments")
+	require.NoError(t, err)
+	config.GetConfig().Selector = config.Selector{LabelSelector: selector}
+
+	for _, tt := range []struct {
+		name     string
+		defaults *coralogixv1alpha1.CoralogixDefaults
+		expected []string
+	}{
+		{
+			name: "namespaced",
+			defaults: &coralogixv1alpha1.CoralogixDefaults{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "payments", Name: "defaults"},
+			},
+			expected: []string{"payments/availability"},
+		},
+		{
+			name: "cluster-wide",
+			defaults: &coralogixv1alpha1.CoralogixDefaults{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "platform", Name: "defaults"},
+				Spec:       coralogixv1alpha1.CoralogixDefaultsSpec{ClusterWide: true},
+			},
+			expected: []string{"checkout/errors", "payments/availability"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer q.ShutDown()
+
+			handler := EnqueueForCoralogixDefaults(func() client.ObjectList { return &coralogixv1alpha1.SLOList{} })
+			handler.Create(context.Background(), event.CreateEvent{Object: tt.defaults}, q)
+
+			var enqueued []string
+			for q.Len() > 0 {
+				req, _ := q.Get()
+				enqueued = append(enqueued, req.String())
+				q.Done(req)
+			}
+			require.ElementsMatch(t, tt.expected, enqueued)
+		})
+	}
+}
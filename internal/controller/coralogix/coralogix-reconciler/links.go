@@ -0,0 +1,109 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coralogixreconciler
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// uiPaths are the paths of the Coralogix UI pages of the remote objects by kind. A %s in a path is replaced with the
+// ID of the remote object, the other paths lead to the page listing the objects of the kind.
+var uiPaths = map[string]string{
+	utils.AlertKind:                  "/#/alerts/%s",
+	utils.AlertSetKind:               "/#/alerts",
+	utils.AlertSchedulerKind:         "/#/alerts/suppression-rules",
+	utils.DashboardKind:              "/#/dashboards/%s",
+	utils.DashboardsFolderKind:       "/#/dashboards",
+	utils.ViewKind:                   "/#/query-new/logs?viewId=%s",
+	utils.ViewFolderKind:             "/#/query-new/logs",
+	utils.SLOKind:                    "/#/slos/%s",
+	utils.RuleGroupKind:              "/#/rules",
+	utils.RecordingRuleGroupSetKind:  "/#/recording-rules",
+	utils.Events2MetricKind:          "/#/events2metrics",
+	utils.EnrichmentKind:             "/#/enrichments",
+	utils.CustomEnrichmentKind:       "/#/enrichments",
+	utils.OutboundWebhookKind:        "/#/settings/outbound-webhooks",
+	utils.ConnectorKind:              "/#/notification-center/connectors",
+	utils.PresetKind:                 "/#/notification-center/presets",
+	utils.GlobalRouterKind:           "/#/notification-center/routers",
+	utils.ApiKeyKind:                 "/#/settings/api-keys",
+	utils.UserKind:                   "/#/settings/teams/users",
+	utils.GroupKind:                  "/#/settings/teams/groups",
+	utils.CustomRoleKind:             "/#/settings/teams/roles",
+	utils.ScopeKind:                  "/#/settings/teams/scopes",
+	utils.IPAccess:                   "/#/settings/ip-access",
+	utils.IntegrationKind:            "/#/integrations",
+	utils.ArchiveLogsTargetKind:      "/#/settings/archive/logs",
+	utils.ArchiveMetricsTargetKind:   "/#/settings/archive/metrics",
+	utils.TCOLogsPoliciesKind:        "/#/tco",
+	utils.TCOTracesPoliciesKind:      "/#/tco",
+	utils.TCORumPoliciesKind:         "/#/tco",
+	utils.QuotaAllocationRuleSetKind: "/#/tco",
+	utils.AIEvaluationKind:           "/#/ai-center/evaluations",
+	utils.AICustomEvaluationKind:     "/#/ai-center/evaluations",
+	utils.AIEvaluationSetKind:        "/#/ai-center/evaluations",
+}
+
+// RemoteURL returns the link to the remote object of a resource in the Coralogix UI, or an empty string if the team
+// name is not configured, the kind has no page, or the remote object was not created yet.
+func RemoteURL(obj client.Object) string {
+	base := config.GetConfig().CoralogixUIUrl
+	path, ok := uiPaths[objToKind(obj)]
+	if base == "" || !ok {
+		return ""
+	}
+	if !strings.Contains(path, "%s") {
+		return base + path
+	}
+
+	id := remoteID(obj)
+	if id == "" {
+		return ""
+	}
+	return base + fmt.Sprintf(path, url.PathEscape(id))
+}
+
+// setRemoteURL sets the status.url of a resource, and returns true if it changed.
+func setRemoteURL(obj coralogix.Object) bool {
+	remoteURL := RemoteURL(obj)
+	if remoteURL == obj.GetURL() {
+		return false
+	}
+	obj.SetURL(remoteURL)
+	return true
+}
+
+// remoteID returns the status.id of a resource, whatever its type is.
+func remoteID(obj client.Object) string {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return ""
+	}
+	id, found, err := unstructured.NestedFieldNoCopy(u, "status", "id")
+	if err != nil || !found || id == nil {
+		return ""
+	}
+	return fmt.Sprint(id)
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coralogixreconciler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+)
+
+func TestRemoteURL(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+
+	originalScheme := config.GetScheme()
+	originalUIUrl := config.GetConfig().CoralogixUIUrl
+	t.Cleanup(func() {
+		config.InitScheme(originalScheme)
+		config.GetConfig().CoralogixUIUrl = originalUIUrl
+	})
+	config.InitScheme(scheme)
+
+	dashboard := &coralogixv1alpha1.Dashboard{}
+	connector := &coralogixv1alpha1.Connector{}
+
+	config.GetConfig().CoralogixUIUrl = ""
+	dashboard.Status.ID = ptr.To("some-remote-id")
+	require.Empty(t, RemoteURL(dashboard))
+
+	config.GetConfig().CoralogixUIUrl = "https://team.app.eu2.coralogix.com"
+	require.Equal(t, "https://team.app.eu2.coralogix.com/#/dashboards/some-remote-id", RemoteURL(dashboard))
+	require.Equal(t, "https://team.app.eu2.coralogix.com/#/notification-center/connectors", RemoteURL(connector))
+
+	dashboard.Status.ID = nil
+	require.Empty(t, RemoteURL(dashboard))
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coralogixreconciler
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// informationalStatusFields are the status fields that are only written for users, and never read by the
+// reconciliations.
+var informationalStatusFields = []string{"nextReconcileAt", "url", "createdAt", "updatedAt"}
+
+// IgnoreInformationalStatusUpdates filters out the update events of resources whose only changes are in their
+// informational status fields, e.g. status.nextReconcileAt. Recording the next periodic reconciliation or the update
+// time of the remote object would otherwise trigger another reconciliation right away.
+func IgnoreInformationalStatusUpdates() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldObj, err := withoutInformationalFields(e.ObjectOld)
+			if err != nil {
+				return true
+			}
+			newObj, err := withoutInformationalFields(e.ObjectNew)
+			if err != nil {
+				return true
+			}
+			return !equality.Semantic.DeepEqual(oldObj, newObj)
+		},
+	}
+}
+
+func withoutInformationalFields(obj runtime.Object) (map[string]any, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	unstructured.RemoveNestedField(u, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(u, "metadata", "managedFields")
+	for _, field := range informationalStatusFields {
+		unstructured.RemoveNestedField(u, "status", field)
+	}
+	return u, nil
+}
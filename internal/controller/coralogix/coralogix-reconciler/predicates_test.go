@@ -0,0 +1,56 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coralogixreconciler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+)
+
+func TestIgnoreInformationalStatusUpdates(t *testing.T) {
+	predicate := IgnoreInformationalStatusUpdates()
+	oldDashboard := &coralogixv1alpha1.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "dashboard", Namespace: "default", ResourceVersion: "1"},
+	}
+
+	scheduled := oldDashboard.DeepCopy()
+	scheduled.ResourceVersion = "2"
+	scheduled.Status.NextReconcileAt = &metav1.Time{Time: time.Now().Add(time.Minute)}
+	scheduled.Status.URL = "https://team.app.coralogix.com/#/dashboards/some-remote-id"
+	require.False(t, predicate.Update(event.UpdateEvent{ObjectOld: oldDashboard, ObjectNew: scheduled}))
+
+	relabeled := scheduled.DeepCopy()
+	relabeled.Labels = map[string]string{"team": "payments"}
+	require.True(t, predicate.Update(event.UpdateEvent{ObjectOld: oldDashboard, ObjectNew: relabeled}))
+
+	oldAlert := &coralogixv1beta1.Alert{
+		ObjectMeta: metav1.ObjectMeta{Name: "alert", Namespace: "default", ResourceVersion: "1"},
+	}
+	updated := oldAlert.DeepCopy()
+	updated.ResourceVersion = "2"
+	updated.Status.UpdatedAt = &metav1.Time{Time: time.Now()}
+	require.False(t, predicate.Update(event.UpdateEvent{ObjectOld: oldAlert, ObjectNew: updated}))
+
+	synced := updated.DeepCopy()
+	synced.Status.PrintableStatus = "RemoteSynced"
+	require.True(t, predicate.Update(event.UpdateEvent{ObjectOld: oldAlert, ObjectNew: synced}))
+}
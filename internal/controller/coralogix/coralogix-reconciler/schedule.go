@@ -0,0 +1,85 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coralogixreconciler
+
+import (
+	"math/rand/v2"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// ReconcileInterval returns the interval between the periodic reconciliations of a resource: the value of its
+// reconcile-interval annotation if it is a valid duration of at least 30 seconds, or the interval of its kind.
+func ReconcileInterval(obj metav1.Object, interval time.Duration) time.Duration {
+	val, exists := obj.GetAnnotations()[utils.ReconcileIntervalAnnotationKey]
+	if !exists {
+		return interval
+	}
+
+	annotationInterval, err := time.ParseDuration(val)
+	if err != nil || annotationInterval < config.MinReconcileInterval {
+		return interval
+	}
+
+	return annotationInterval
+}
+
+// NextReconcile returns the time of the next periodic reconciliation of a resource and the delay until then, or nil
+// if it is not reconciled periodically. The interval of the resource is spread by the configured jitter.
+//
+// The scheduled time in the status of the resource is kept while it is ahead, so that reconciliations triggered by
+// events don't postpone the periodic one, and the status changes only once per interval. It is also kept across
+// restarts of the operator, which would otherwise reconcile all the resources of a kind at the same time.
+func NextReconcile(obj metav1.Object, scheduled *metav1.Time, interval time.Duration) (*metav1.Time, time.Duration) {
+	interval = ReconcileInterval(obj, interval)
+	if interval <= 0 {
+		return nil, 0
+	}
+
+	now := time.Now()
+	jitter := config.GetConfig().ReconcileJitter
+	maxInterval := time.Duration(float64(interval) * (1 + jitter))
+	if scheduled != nil && scheduled.After(now) && scheduled.Sub(now) <= maxInterval {
+		return scheduled, scheduled.Sub(now)
+	}
+
+	jittered := time.Duration(float64(interval) * (1 + jitter*(2*rand.Float64()-1)))
+	next := metav1.NewTime(now.Add(jittered).Truncate(time.Second))
+	return &next, next.Sub(now)
+}
+
+// ScheduleNextReconcile records the next periodic reconciliation of a resource in its status, and returns the delay
+// until then and whether the status changed.
+func ScheduleNextReconcile(obj coralogix.Object, interval time.Duration) (time.Duration, bool) {
+	next, requeueAfter := NextReconcile(obj, obj.GetNextReconcileAt(), interval)
+	changed := !next.Equal(obj.GetNextReconcileAt())
+	obj.SetNextReconcileAt(next)
+	return requeueAfter, changed
+}
+
+// UnscheduleNextReconcile clears the next periodic reconciliation of a failing resource, which is retried with
+// backoff instead. It returns true if the status changed.
+func UnscheduleNextReconcile(obj coralogix.Object) bool {
+	if obj.GetNextReconcileAt() == nil {
+		return false
+	}
+	obj.SetNextReconcileAt(nil)
+	return true
+}
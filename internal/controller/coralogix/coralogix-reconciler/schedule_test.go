@@ -0,0 +1,103 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coralogixreconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+func TestReconcileInterval(t *testing.T) {
+	for _, tc := range []struct {
+		annotation string
+		expected   time.Duration
+	}{
+		{annotation: "", expected: time.Minute},
+		{annotation: "5m", expected: 5 * time.Minute},
+		{annotation: "10s", expected: time.Minute},
+		{annotation: "often", expected: time.Minute},
+	} {
+		obj := &coralogixv1alpha1.Dashboard{}
+		if tc.annotation != "" {
+			obj.Annotations = map[string]string{utils.ReconcileIntervalAnnotationKey: tc.annotation}
+		}
+		require.Equal(t, tc.expected, ReconcileInterval(obj, time.Minute), tc.annotation)
+	}
+}
+
+func TestReconcileResourceSchedulesNextReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+
+	dashboardID := "some-remote-id"
+	dashboard := &coralogixv1alpha1.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "dashboard",
+			Namespace: "default",
+			Annotations: map[string]string{
+				utils.ReconcileIntervalAnnotationKey: "5m",
+			},
+		},
+		Status: coralogixv1alpha1.DashboardStatus{ID: &dashboardID},
+	}
+	controllerutil.AddFinalizer(dashboard, (&noopReconciler{}).FinalizerName())
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(dashboard).
+		WithStatusSubresource(dashboard).
+		Build()
+
+	originalClient := config.GetClient()
+	originalScheme := config.GetScheme()
+	t.Cleanup(func() {
+		config.InitClient(originalClient)
+		config.InitScheme(originalScheme)
+	})
+	config.InitClient(fakeClient)
+	config.InitScheme(scheme)
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: dashboard.Name, Namespace: dashboard.Namespace}}
+	result, err := ReconcileResource(context.Background(), req, &coralogixv1alpha1.Dashboard{}, &noopReconciler{})
+	require.NoError(t, err)
+	require.InDelta(t, 5*time.Minute, result.RequeueAfter, float64(time.Second))
+
+	fetched := &coralogixv1alpha1.Dashboard{}
+	require.NoError(t, fakeClient.Get(context.Background(), req.NamespacedName, fetched))
+	require.NotNil(t, fetched.Status.NextReconcileAt)
+	require.InDelta(t, 5*time.Minute, time.Until(fetched.Status.NextReconcileAt.Time), float64(time.Second))
+
+	// Reconciliations triggered by events keep the scheduled time, so the status does not change.
+	result, err = ReconcileResource(context.Background(), req, &coralogixv1alpha1.Dashboard{}, &noopReconciler{})
+	require.NoError(t, err)
+	require.InDelta(t, time.Until(fetched.Status.NextReconcileAt.Time), result.RequeueAfter, float64(time.Second))
+
+	refetched := &coralogixv1alpha1.Dashboard{}
+	require.NoError(t, fakeClient.Get(context.Background(), req.NamespacedName, refetched))
+	require.Equal(t, fetched.ResourceVersion, refetched.ResourceVersion)
+}
@@ -0,0 +1,255 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coralogixreconciler
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/monitoring"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// SingletonReconciler is implemented by controllers of kinds that replace account-wide settings,
+// where only one selected resource in the cluster may be applied at a time.
+//
+// The oldest selected resource (by creation timestamp, then namespace and name) is elected to manage
+// the account settings. Every other resource gets a Conflict condition naming the elected one and is
+// never applied to, nor deleted from, the remote account.
+type SingletonReconciler interface {
+	CoralogixReconciler
+	// NewSingletonList returns an empty list of the reconciled kind, used to find the competing resources.
+	// It returns nil if the kind is currently not a singleton, e.g. when several resources are merged instead.
+	NewSingletonList() client.ObjectList
+}
+
+// singletonList returns an empty list of the kind reconciled by r, or nil if r does not reconcile a singleton.
+func singletonList(r CoralogixReconciler) client.ObjectList {
+	if singleton, ok := r.(SingletonReconciler); ok {
+		return singleton.NewSingletonList()
+	}
+	return nil
+}
+
+// ElectSingleton returns the resource elected to manage the account-wide settings among the selected,
+// non-deleted resources listed by newList, including obj itself.
+func ElectSingleton(ctx context.Context, obj client.Object, newList client.ObjectList) (client.Object, error) {
+	candidates, err := singletonCandidates(ctx, newList)
+	if err != nil {
+		return nil, err
+	}
+
+	winner := obj
+	for _, candidate := range candidates {
+		if singletonLess(candidate, winner) {
+			winner = candidate
+		}
+	}
+	return winner, nil
+}
+
+// FindOtherSingleton returns the elected resource among the selected, non-deleted resources listed by newList,
+// excluding obj. It returns nil if obj is the only such resource.
+func FindOtherSingleton(ctx context.Context, obj client.Object, newList client.ObjectList) (client.Object, error) {
+	candidates, err := singletonCandidates(ctx, newList)
+	if err != nil {
+		return nil, err
+	}
+
+	var other client.Object
+	for _, candidate := range candidates {
+		if candidate.GetUID() == obj.GetUID() {
+			continue
+		}
+		if other == nil || singletonLess(candidate, other) {
+			other = candidate
+		}
+	}
+	return other, nil
+}
+
+func singletonCandidates(ctx context.Context, newList client.ObjectList) ([]client.Object, error) {
+	if err := config.GetClient().List(ctx, newList); err != nil {
+		return nil, fmt.Errorf("error on listing %T: %w", newList, err)
+	}
+
+	items, err := meta.ExtractList(newList)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []client.Object
+	for _, item := range items {
+		candidate, ok := item.(client.Object)
+		if !ok {
+			continue
+		}
+		if !candidate.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		if !config.GetConfig().Selector.Matches(candidate.GetLabels(), candidate.GetNamespace()) {
+			continue
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
+
+// singletonLess orders resources by creation timestamp, breaking ties by namespace and name,
+// so that every controller replica elects the same resource.
+func singletonLess(a, b client.Object) bool {
+	aCreated, bCreated := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !aCreated.Equal(&bCreated) {
+		return aCreated.Before(&bCreated)
+	}
+	if a.GetNamespace() != b.GetNamespace() {
+		return a.GetNamespace() < b.GetNamespace()
+	}
+	return a.GetName() < b.GetName()
+}
+
+// ManageConflictWithRequeue marks obj as not synced because winner manages the account-wide settings.
+func ManageConflictWithRequeue(ctx context.Context, obj coralogix.Object, winner client.Object, interval time.Duration) (reconcile.Result, error) {
+	message := fmt.Sprintf(
+		"only one selected %s can manage the account-wide settings; %s/%s is the elected resource",
+		objToKind(obj),
+		winner.GetNamespace(),
+		winner.GetName(),
+	)
+
+	conditions := obj.GetConditions()
+	conflictChanged := utils.SetConflictConditionTrue(&conditions, obj.GetGeneration(), utils.ReasonSingletonConflict, message)
+	syncedChanged := utils.SetSyncedConditionFalse(&conditions, obj.GetGeneration(), utils.ReasonSingletonConflict, message)
+	requestAcknowledged := AcknowledgeReconcileRequest(obj)
+	requeueAfter, scheduled := ScheduleNextReconcile(obj, interval)
+	if conflictChanged || syncedChanged || requestAcknowledged || scheduled || obj.GetPrintableStatus() != "RemoteUnsynced" {
+		obj.SetConditions(conditions)
+		obj.SetPrintableStatus("RemoteUnsynced")
+		if err := config.GetClient().Status().Update(ctx, obj); err != nil {
+			return ManageErrorWithRequeue(ctx, obj, utils.ReasonInternalK8sError, err)
+		}
+	}
+
+	monitoring.SetResourceInfoMetricUnsynced(
+		obj.GetObjectKind().GroupVersionKind().Kind,
+		obj.GetName(),
+		obj.GetNamespace(),
+	)
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func objToKind(obj client.Object) string {
+	gvks, _, _ := config.GetScheme().ObjectKinds(obj)
+	if len(gvks) == 0 {
+		return obj.GetObjectKind().GroupVersionKind().Kind
+	}
+	return gvks[0].Kind
+}
+
+// handleDeletion runs the reconciler's deletion unless it is a SingletonReconciler and another selected
+// resource of the same kind exists. That resource is either the elected one, or is about to be elected and
+// overwrite the account-wide settings, so resetting them here would only cause an outage.
+func handleDeletion(ctx context.Context, log logr.Logger, obj client.Object, r CoralogixReconciler) error {
+	if list := singletonList(r); list != nil {
+		other, err := FindOtherSingleton(ctx, obj, list)
+		if err != nil {
+			return err
+		}
+		if other != nil {
+			log.Info(
+				"Skipping remote deletion because another selected resource manages the account-wide settings",
+				"otherNamespace", other.GetNamespace(),
+				"otherName", other.GetName(),
+			)
+			return nil
+		}
+	}
+
+	return r.HandleDeletion(ctx, log, obj)
+}
+
+// SingletonPeersHandler enqueues the other resources of the same kind when a resource is deleted or
+// stops being selected, so that the next elected resource takes over the account-wide settings.
+func SingletonPeersHandler(newList func() client.ObjectList) handler.EventHandler {
+	return handler.Funcs{
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			selector := config.GetConfig().Selector
+			startedDeletion := e.ObjectOld.GetDeletionTimestamp().IsZero() && !e.ObjectNew.GetDeletionTimestamp().IsZero()
+			leftSelector := selector.Matches(e.ObjectOld.GetLabels(), e.ObjectOld.GetNamespace()) &&
+				!selector.Matches(e.ObjectNew.GetLabels(), e.ObjectNew.GetNamespace())
+			if startedDeletion || leftSelector {
+				enqueuePeers(ctx, newList, e.ObjectNew, q)
+			}
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueuePeers(ctx, newList, e.Object, q)
+		},
+	}
+}
+
+// ComposedPeersHandler enqueues the other resources of the same kind whenever a resource is created, deleted,
+// or changes its spec, labels or deletion state, for kinds whose resources are merged into a single remote
+// object and therefore each report the outcome of the merge.
+func ComposedPeersHandler(newList func() client.ObjectList) handler.EventHandler {
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueuePeers(ctx, newList, e.Object, q)
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			if e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() ||
+				!maps.Equal(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels()) ||
+				e.ObjectOld.GetDeletionTimestamp().IsZero() != e.ObjectNew.GetDeletionTimestamp().IsZero() {
+				enqueuePeers(ctx, newList, e.ObjectNew, q)
+			}
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueuePeers(ctx, newList, e.Object, q)
+		},
+	}
+}
+
+func enqueuePeers(ctx context.Context, newList func() client.ObjectList, obj client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	list := newList()
+	if err := config.GetClient().List(ctx, list); err != nil {
+		log.FromContext(ctx).Error(err, "Error listing peer resources", "type", fmt.Sprintf("%T", list))
+		return
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return
+	}
+	for _, item := range items {
+		peer, ok := item.(client.Object)
+		if !ok || peer.GetUID() == obj.GetUID() {
+			continue
+		}
+		q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: peer.GetNamespace(), Name: peer.GetName()}})
+	}
+}
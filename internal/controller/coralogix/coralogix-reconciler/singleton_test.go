@@ -0,0 +1,151 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coralogixreconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// singletonNoopReconciler is a noopReconciler for an account-wide kind.
+type singletonNoopReconciler struct {
+	noopReconciler
+	updateCalls int
+}
+
+func (n *singletonNoopReconciler) HandleUpdate(ctx context.Context, log logr.Logger, obj client.Object) error {
+	n.updateCalls++
+	return nil
+}
+
+func (n *singletonNoopReconciler) NewSingletonList() client.ObjectList {
+	return &coralogixv1alpha1.TCOLogsPoliciesList{}
+}
+
+func tcoLogsPoliciesCreatedAt(name string, created time.Time) *coralogixv1alpha1.TCOLogsPolicies {
+	return &coralogixv1alpha1.TCOLogsPolicies{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			UID:               types.UID(name),
+			CreationTimestamp: metav1.NewTime(created),
+		},
+	}
+}
+
+func setupSingletonTest(t *testing.T, objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(objs...).
+		Build()
+
+	originalClient := config.GetClient()
+	originalScheme := config.GetScheme()
+	originalSelector := config.GetConfig().Selector
+	t.Cleanup(func() {
+		config.InitClient(originalClient)
+		config.InitScheme(originalScheme)
+		config.GetConfig().Selector = originalSelector
+	})
+
+	config.InitClient(fakeClient)
+	config.InitScheme(scheme)
+	config.GetConfig().Selector = config.Selector{}
+	return fakeClient
+}
+
+func TestReconcileResourceSingletonElectsOldestResource(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	oldest := tcoLogsPoliciesCreatedAt("oldest", now)
+	newest := tcoLogsPoliciesCreatedAt("newest", now.Add(time.Hour))
+	newest.Status.Conditions = []metav1.Condition{{
+		Type:               utils.ConditionTypeConflict,
+		Status:             metav1.ConditionTrue,
+		Reason:             utils.ReasonSingletonConflict,
+		LastTransitionTime: metav1.NewTime(now),
+	}}
+	fakeClient := setupSingletonTest(t, oldest, newest)
+
+	loser := &singletonNoopReconciler{}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: newest.Name, Namespace: newest.Namespace}}
+	_, err := ReconcileResource(context.Background(), req, &coralogixv1alpha1.TCOLogsPolicies{}, loser)
+	require.NoError(t, err)
+	require.Zero(t, loser.updateCalls, "a non-elected resource must not be applied")
+
+	fetched := &coralogixv1alpha1.TCOLogsPolicies{}
+	require.NoError(t, fakeClient.Get(context.Background(), req.NamespacedName, fetched))
+	conflict := meta.FindStatusCondition(fetched.Status.Conditions, utils.ConditionTypeConflict)
+	require.NotNil(t, conflict)
+	require.Contains(t, conflict.Message, "default/oldest")
+	require.True(t, meta.IsStatusConditionFalse(fetched.Status.Conditions, utils.ConditionTypeRemoteSynced))
+
+	winner := &singletonNoopReconciler{}
+	req = ctrl.Request{NamespacedName: types.NamespacedName{Name: oldest.Name, Namespace: oldest.Namespace}}
+	_, err = ReconcileResource(context.Background(), req, &coralogixv1alpha1.TCOLogsPolicies{}, winner)
+	require.NoError(t, err)
+	require.Equal(t, 1, winner.updateCalls)
+
+	require.NoError(t, fakeClient.Get(context.Background(), req.NamespacedName, fetched))
+	require.Nil(t, meta.FindStatusCondition(fetched.Status.Conditions, utils.ConditionTypeConflict))
+	require.True(t, meta.IsStatusConditionTrue(fetched.Status.Conditions, utils.ConditionTypeRemoteSynced))
+}
+
+func TestReconcileResourceSingletonDeletionKeepsAccountSettings(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	oldest := tcoLogsPoliciesCreatedAt("oldest", now)
+	deleted := tcoLogsPoliciesCreatedAt("deleted", now.Add(time.Hour))
+	controllerutil.AddFinalizer(deleted, (&singletonNoopReconciler{}).FinalizerName())
+	fakeClient := setupSingletonTest(t, oldest, deleted)
+	require.NoError(t, fakeClient.Delete(context.Background(), deleted))
+
+	reconciler := &singletonNoopReconciler{}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: deleted.Name, Namespace: deleted.Namespace}}
+	_, err := ReconcileResource(context.Background(), req, &coralogixv1alpha1.TCOLogsPolicies{}, reconciler)
+	require.NoError(t, err)
+	require.Zero(t, reconciler.deletionCalls, "deleting a non-elected resource must not reset the account settings")
+}
+
+func TestReconcileResourceSingletonDeletionOfLastResource(t *testing.T) {
+	last := tcoLogsPoliciesCreatedAt("last", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	controllerutil.AddFinalizer(last, (&singletonNoopReconciler{}).FinalizerName())
+	fakeClient := setupSingletonTest(t, last)
+	require.NoError(t, fakeClient.Delete(context.Background(), last))
+
+	reconciler := &singletonNoopReconciler{}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: last.Name, Namespace: last.Namespace}}
+	_, err := ReconcileResource(context.Background(), req, &coralogixv1alpha1.TCOLogsPolicies{}, reconciler)
+	require.NoError(t, err)
+	require.Equal(t, 1, reconciler.deletionCalls)
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coralogixreconciler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WaitingError is returned by the handlers of a reconciler while the resource waits for other resources, e.g. for the
+// resources generated from it to be deleted. The resource is requeued after the delay instead of being marked as
+// failed and retried with backoff.
+type WaitingError struct {
+	Delay  time.Duration
+	Reason string
+}
+
+func (e *WaitingError) Error() string {
+	return e.Reason
+}
+
+// NewWaitingError returns a WaitingError requeuing the resource after the delay.
+func NewWaitingError(delay time.Duration, format string, args ...any) error {
+	return &WaitingError{Delay: delay, Reason: fmt.Sprintf(format, args...)}
+}
+
+// IsWaitingError returns the delay of a WaitingError, and whether the error is one.
+func IsWaitingError(err error) (time.Duration, bool) {
+	var waitingErr *WaitingError
+	if !errors.As(err, &waitingErr) {
+		return 0, false
+	}
+	return waitingErr.Delay, true
+}
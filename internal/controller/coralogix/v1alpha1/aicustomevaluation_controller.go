@@ -17,13 +17,20 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/coralogix/coralogix-management-sdk/go/openapi/cxsdk"
 	aiapplications "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/ai_applications_service"
@@ -56,6 +63,7 @@ type aiCustomEvaluationApplication struct {
 // +kubebuilder:rbac:groups=coralogix.com,resources=aicustomevaluations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=coralogix.com,resources=aicustomevaluations/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=coralogix.com,resources=aicustomevaluations/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 
 func (r *AICustomEvaluationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	return coralogixreconciler.ReconcileResource(ctx, req, &coralogixv1alpha1.AICustomEvaluation{}, r)
@@ -76,7 +84,12 @@ func (r *AICustomEvaluationReconciler) HandleCreation(ctx context.Context, log l
 		return err
 	}
 
-	createRequest, err := aiCustomEvaluation.ExtractCreateAICustomEvaluationRequest(aiCustomEvaluationApplicationIDs(applications))
+	resolved, err := aiCustomEvaluation.Resolve(ctx, aiCustomEvaluationApplicationNames(applications))
+	if err != nil {
+		return err
+	}
+
+	createRequest, err := resolved.ExtractCreateAICustomEvaluationRequest(aiCustomEvaluationApplicationIDs(applications))
 	if err != nil {
 		return fmt.Errorf("error on extracting create AICustomEvaluation request: %w", err)
 	}
@@ -100,6 +113,7 @@ func (r *AICustomEvaluationReconciler) HandleCreation(ctx context.Context, log l
 	aiCustomEvaluation.Status = coralogixv1alpha1.AICustomEvaluationStatus{
 		Id: ptr.To(id),
 	}
+	aiCustomEvaluation.Status.RecordRevision(resolved.Spec.ContentHash(), metav1.Now())
 
 	return nil
 }
@@ -111,7 +125,12 @@ func (r *AICustomEvaluationReconciler) HandleUpdate(ctx context.Context, log log
 		return err
 	}
 
-	updateRequest, err := aiCustomEvaluation.ExtractUpdateAICustomEvaluationRequest()
+	resolved, err := aiCustomEvaluation.Resolve(ctx, aiCustomEvaluationApplicationNames(applications))
+	if err != nil {
+		return err
+	}
+
+	updateRequest, err := resolved.ExtractUpdateAICustomEvaluationRequest()
 	if err != nil {
 		return fmt.Errorf("error on extracting update AICustomEvaluation request: %w", err)
 	}
@@ -127,7 +146,7 @@ func (r *AICustomEvaluationReconciler) HandleUpdate(ctx context.Context, log log
 	}
 	log.Info("Remote AICustomEvaluation updated", "response", utils.FormatJSON(updateResponse))
 
-	examplesUpdateRequest, err := aiCustomEvaluation.ExtractUpdateAICustomEvaluationExamplesRequest()
+	examplesUpdateRequest, err := resolved.ExtractUpdateAICustomEvaluationExamplesRequest()
 	if err != nil {
 		return fmt.Errorf("error on extracting update AICustomEvaluation examples request: %w", err)
 	}
@@ -150,7 +169,17 @@ func (r *AICustomEvaluationReconciler) HandleUpdate(ctx context.Context, log log
 	}
 
 	desiredApplicationIDs := aiCustomEvaluationApplicationIDs(applications)
-	return r.reconcileApplicationLinks(ctx, id, remoteApplicationIDs, desiredApplicationIDs)
+	if err := r.reconcileApplicationLinks(ctx, id, remoteApplicationIDs, desiredApplicationIDs); err != nil {
+		return err
+	}
+
+	if aiCustomEvaluation.Status.RecordRevision(resolved.Spec.ContentHash(), metav1.Now()) {
+		log.Info("Remote AICustomEvaluation content changed", "contentHash", aiCustomEvaluation.Status.ContentHash)
+		if err := config.GetClient().Status().Update(ctx, aiCustomEvaluation); err != nil {
+			return fmt.Errorf("error on updating AICustomEvaluation status: %w", err)
+		}
+	}
+	return nil
 }
 
 func (r *AICustomEvaluationReconciler) HandleDeletion(ctx context.Context, log logr.Logger, obj client.Object) error {
@@ -311,6 +340,14 @@ func aiCustomEvaluationApplicationIDs(applications []aiCustomEvaluationApplicati
 	return ids
 }
 
+func aiCustomEvaluationApplicationNames(applications []aiCustomEvaluationApplication) []string {
+	names := make([]string, 0, len(applications))
+	for _, application := range applications {
+		names = append(names, application.Application)
+	}
+	return names
+}
+
 func sortAICustomEvaluationApplications(applications []aiCustomEvaluationApplication) {
 	sort.Slice(applications, func(i, j int) bool {
 		if applications[i].Id == applications[j].Id {
@@ -334,7 +371,44 @@ func aiCustomEvaluationStringSet(values []string) map[string]struct{} {
 // SetupWithManager sets up the controller with the Manager.
 func (r *AICustomEvaluationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&coralogixv1alpha1.AICustomEvaluation{}).
-		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		For(&coralogixv1alpha1.AICustomEvaluation{}, builder.WithPredicates(config.GetConfig().Selector.Predicate(), coralogixreconciler.IgnoreInformationalStatusUpdates())).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(enqueueAICustomEvaluationsForConfigMap)).
 		Complete(r)
 }
+
+// enqueueAICustomEvaluationsForConfigMap enqueues the selected AICustomEvaluations loading their instructions or
+// criteria flags from a ConfigMap, so that new prompt versions are synchronized without waiting for the requeue
+// interval.
+func enqueueAICustomEvaluationsForConfigMap(ctx context.Context, configMap client.Object) []reconcile.Request {
+	var aiCustomEvaluationList coralogixv1alpha1.AICustomEvaluationList
+	if err := config.GetClient().List(ctx, &aiCustomEvaluationList, client.InNamespace(configMap.GetNamespace())); err != nil {
+		ctrllog.FromContext(ctx).Error(err, "Error listing AICustomEvaluations loading the ConfigMap")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, aiCustomEvaluation := range aiCustomEvaluationList.Items {
+		if !config.GetConfig().Selector.Matches(aiCustomEvaluation.Labels, aiCustomEvaluation.Namespace) {
+			continue
+		}
+		if slices.Contains(aiCustomEvaluationConfigMapNames(&aiCustomEvaluation.Spec), configMap.GetName()) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&aiCustomEvaluation)})
+		}
+	}
+	return requests
+}
+
+func aiCustomEvaluationConfigMapNames(spec *coralogixv1alpha1.AICustomEvaluationSpec) []string {
+	var names []string
+	if spec.InstructionsFrom != nil {
+		names = append(names, spec.InstructionsFrom.ConfigMapKeyRef.Name)
+	}
+	if spec.Criteria != nil {
+		for _, criterion := range []*coralogixv1alpha1.AICustomEvaluationCriterion{spec.Criteria.Acceptable, spec.Criteria.Prohibited} {
+			if criterion != nil && criterion.FlagsFrom != nil {
+				names = append(names, criterion.FlagsFrom.ConfigMapKeyRef.Name)
+			}
+		}
+	}
+	return names
+}
@@ -127,5 +127,6 @@ func (r *AIEvaluationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.AIEvaluation{}).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
@@ -0,0 +1,553 @@
+// Copyright 2026 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/coralogix/coralogix-management-sdk/go/openapi/cxsdk"
+	aievaluations "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/ai_evaluations_service"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	coralogixreconciler "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
+	"github.com/coralogix/coralogix-operator/v2/internal/monitoring"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+const (
+	aiEvaluationSetFinalizer = "ai-evaluation-set.coralogix.com/finalizer"
+	aiEvaluationSetPageSize  = 200
+)
+
+// AIEvaluationSetReconciler reconciles an AIEvaluationSet object.
+type AIEvaluationSetReconciler struct {
+	AIEvaluationsClient *aievaluations.AIEvaluationsServiceAPIService
+	Interval            time.Duration
+	api                 aiEvaluationSetAPI
+}
+
+type aiEvaluationSetAPI interface {
+	List(ctx context.Context, application, subsystem string) ([]aievaluations.AiEvaluation, error)
+	Create(context.Context, aievaluations.AiEvaluationsServiceCreateAiEvaluationRequest) (string, error)
+	Update(context.Context, string, aievaluations.AiEvaluationsServiceUpdateAiEvaluationRequest) error
+	// Delete deletes a remote evaluation, and succeeds if it does not exist.
+	Delete(context.Context, string) error
+}
+
+type sdkAIEvaluationSetAPI struct {
+	client *aievaluations.AIEvaluationsServiceAPIService
+}
+
+func (a sdkAIEvaluationSetAPI) List(ctx context.Context, application, subsystem string) ([]aievaluations.AiEvaluation, error) {
+	var result []aievaluations.AiEvaluation
+	for offset := 0; ; offset += aiEvaluationSetPageSize {
+		response, httpResp, err := a.client.
+			AiEvaluationsServiceListAiEvaluations(ctx).
+			Application(application).
+			Subsystem(subsystem).
+			PageSize(aiEvaluationSetPageSize).
+			PageOffset(int32(offset)).
+			Execute()
+		if err != nil {
+			return nil, cxsdk.NewAPIError(httpResp, err)
+		}
+		page := response.GetAiEvaluations()
+		result = append(result, page...)
+		if len(page) < aiEvaluationSetPageSize {
+			return result, nil
+		}
+	}
+}
+
+func (a sdkAIEvaluationSetAPI) Create(
+	ctx context.Context,
+	request aievaluations.AiEvaluationsServiceCreateAiEvaluationRequest,
+) (string, error) {
+	response, httpResp, err := a.client.
+		AiEvaluationsServiceCreateAiEvaluation(ctx).
+		AiEvaluationsServiceCreateAiEvaluationRequest(request).
+		Execute()
+	if err != nil {
+		return "", cxsdk.NewAPIError(httpResp, err)
+	}
+	created := response.GetAiEvaluation()
+	return (&created).GetId(), nil
+}
+
+func (a sdkAIEvaluationSetAPI) Update(
+	ctx context.Context,
+	id string,
+	request aievaluations.AiEvaluationsServiceUpdateAiEvaluationRequest,
+) error {
+	_, httpResp, err := a.client.
+		AiEvaluationsServiceUpdateAiEvaluation(ctx, id).
+		AiEvaluationsServiceUpdateAiEvaluationRequest(request).
+		Execute()
+	if err != nil {
+		return cxsdk.NewAPIError(httpResp, err)
+	}
+	return nil
+}
+
+func (a sdkAIEvaluationSetAPI) Delete(ctx context.Context, id string) error {
+	_, httpResp, err := a.client.AiEvaluationsServiceDeleteAiEvaluation(ctx, id).Execute()
+	if err != nil {
+		if apiErr := cxsdk.NewAPIError(httpResp, err); !cxsdk.IsNotFound(apiErr) {
+			return apiErr
+		}
+	}
+	return nil
+}
+
+func (r *AIEvaluationSetReconciler) evaluationsAPI() aiEvaluationSetAPI {
+	if r.api != nil {
+		return r.api
+	}
+	return sdkAIEvaluationSetAPI{client: r.AIEvaluationsClient}
+}
+
+// +kubebuilder:rbac:groups=coralogix.com,resources=aievaluationsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=coralogix.com,resources=aievaluationsets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=coralogix.com,resources=aievaluationsets/finalizers,verbs=update
+
+func (r *AIEvaluationSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	evaluationSet := &coralogixv1alpha1.AIEvaluationSet{}
+	if err := config.GetClient().Get(ctx, req.NamespacedName, evaluationSet); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("get AIEvaluationSet: %w", err)
+	}
+
+	reconcileLog := log.FromContext(ctx).WithValues(
+		"gvk", coralogixv1alpha1.GroupVersion.WithKind(utils.AIEvaluationSetKind).String(),
+		"name", req.Name,
+		"namespace", req.Namespace,
+	)
+	originalStatus := evaluationSet.DeepCopy().Status
+
+	if !evaluationSet.DeletionTimestamp.IsZero() ||
+		!config.GetConfig().Selector.Matches(evaluationSet.Labels, evaluationSet.Namespace) {
+		return r.reconcileDeletion(ctx, reconcileLog, evaluationSet, originalStatus)
+	}
+
+	if !controllerutil.ContainsFinalizer(evaluationSet, aiEvaluationSetFinalizer) {
+		controllerutil.AddFinalizer(evaluationSet, aiEvaluationSetFinalizer)
+		if err := config.GetClient().Update(ctx, evaluationSet); err != nil {
+			if k8serrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("add AIEvaluationSet finalizer: %w", err)
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	if err := validateAIEvaluationSetItems(evaluationSet.Spec.Evaluations); err != nil {
+		return r.finish(ctx, evaluationSet, originalStatus, utils.ReasonPartialFailure, []error{err})
+	}
+
+	statusByKey := aiEvaluationSetStatusByKey(evaluationSet.Status.Evaluations)
+	desiredByKey := make(map[string]coralogixv1alpha1.AIEvaluationSetItem, len(evaluationSet.Spec.Evaluations))
+	for _, item := range evaluationSet.Spec.Evaluations {
+		desiredByKey[item.Key] = item
+		if _, found := statusByKey[item.Key]; !found {
+			statusByKey[item.Key] = coralogixv1alpha1.AIEvaluationSetItemStatus{
+				Key:   item.Key,
+				State: coralogixv1alpha1.AIEvaluationSetItemStatePending,
+			}
+		}
+	}
+
+	// Evaluations removed from the spec, and those whose target changed, are deleted, since the target of a remote
+	// evaluation cannot be updated.
+	var removedKeys, recreatedKeys []string
+	for key, status := range statusByKey {
+		desired, found := desiredByKey[key]
+		switch {
+		case !hasAIEvaluationSetStatusID(status) && !found:
+			delete(statusByKey, key)
+		case !hasAIEvaluationSetStatusID(status):
+		case !found:
+			removedKeys = append(removedKeys, key)
+		case status.Target != desired.Target:
+			recreatedKeys = append(recreatedKeys, key)
+		}
+	}
+	if errs := r.deleteEvaluations(ctx, reconcileLog, statusByKey, append(removedKeys, recreatedKeys...)); len(errs) > 0 {
+		evaluationSet.Status.Evaluations = sortedAIEvaluationSetStatuses(statusByKey)
+		return r.finish(ctx, evaluationSet, originalStatus, utils.ReasonRemoteDeletionFailed, errs)
+	}
+	for _, key := range recreatedKeys {
+		statusByKey[key] = coralogixv1alpha1.AIEvaluationSetItemStatus{Key: key, State: coralogixv1alpha1.AIEvaluationSetItemStatePending}
+	}
+
+	// The remote evaluations are listed once, to find the evaluations that were deleted out of band and those that
+	// need an update.
+	remoteByID := make(map[string]aievaluations.AiEvaluation)
+	if len(aiEvaluationSetStatusKeysWithIDs(statusByKey)) > 0 {
+		remotes, err := r.evaluationsAPI().List(ctx, evaluationSet.Spec.Application, evaluationSet.Spec.Subsystem)
+		if err != nil {
+			return r.finish(ctx, evaluationSet, originalStatus, utils.ReasonRemoteUpdateFailed,
+				[]error{fmt.Errorf("list remote AI evaluations: %w", err)})
+		}
+		for _, remote := range remotes {
+			remoteByID[(&remote).GetId()] = remote
+		}
+	}
+
+	var reconcileErrs []error
+	var createdKeys []string
+	for _, key := range sortedAIEvaluationSetKeys(desiredByKey) {
+		status := statusByKey[key]
+		if hasAIEvaluationSetStatusID(status) {
+			if _, found := remoteByID[*status.ID]; found {
+				continue
+			}
+			reconcileLog.Info("Remote AI evaluation not found, recreating", "key", key, "id", *status.ID)
+		}
+		evaluation := &coralogixv1alpha1.AIEvaluation{Spec: evaluationSet.Spec.EvaluationSpec(desiredByKey[key])}
+		request, err := evaluation.ExtractCreateAIEvaluationRequest()
+		if err != nil {
+			reconcileErrs = append(reconcileErrs, setAIEvaluationSetStatusFailure(statusByKey, key, fmt.Errorf("convert AI evaluation %q for create: %w", key, err)))
+			continue
+		}
+		id, err := r.evaluationsAPI().Create(ctx, *request)
+		if err == nil && id == "" {
+			err = errors.New("remote AI evaluation response did not include an id")
+		}
+		if err != nil {
+			reconcileErrs = append(reconcileErrs, setAIEvaluationSetStatusFailure(statusByKey, key, fmt.Errorf("create AI evaluation %q: %w", key, err)))
+			continue
+		}
+		statusByKey[key] = coralogixv1alpha1.AIEvaluationSetItemStatus{
+			Key:    key,
+			ID:     &id,
+			Target: desiredByKey[key].Target,
+			State:  coralogixv1alpha1.AIEvaluationSetItemStateSynced,
+		}
+		createdKeys = append(createdKeys, key)
+	}
+	if len(createdKeys) > 0 {
+		reconcileLog.Info("Created remote AI evaluations", "keys", createdKeys)
+		if err := persistCreatedAIEvaluationSetStatus(ctx, evaluationSet, statusByKey, createdKeys); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Evaluations matching their remote are not updated, unless a reconciliation was requested.
+	reconcileRequested := coralogixreconciler.ReconcileRequested(evaluationSet)
+	for _, key := range sortedAIEvaluationSetKeys(desiredByKey) {
+		status := statusByKey[key]
+		if !hasAIEvaluationSetStatusID(status) {
+			continue
+		}
+		remote, found := remoteByID[*status.ID]
+		if !found {
+			continue
+		}
+		evaluation := &coralogixv1alpha1.AIEvaluation{Spec: evaluationSet.Spec.EvaluationSpec(desiredByKey[key])}
+		request, err := evaluation.ExtractUpdateAIEvaluationRequest()
+		if err != nil {
+			reconcileErrs = append(reconcileErrs, setAIEvaluationSetStatusFailure(statusByKey, key, fmt.Errorf("convert AI evaluation %q for update: %w", key, err)))
+			continue
+		}
+		if !reconcileRequested && aiEvaluationUpToDate(remote, request) {
+			status.State, status.Message = coralogixv1alpha1.AIEvaluationSetItemStateSynced, ""
+			statusByKey[key] = status
+			continue
+		}
+		reconcileLog.Info("Updating remote AI evaluation", "key", key, "id", *status.ID)
+		if err := r.evaluationsAPI().Update(ctx, *status.ID, *request); err != nil {
+			reconcileErrs = append(reconcileErrs, setAIEvaluationSetStatusFailure(statusByKey, key, fmt.Errorf("update AI evaluation %q: %w", key, err)))
+			continue
+		}
+		status.State, status.Message = coralogixv1alpha1.AIEvaluationSetItemStateSynced, ""
+		statusByKey[key] = status
+	}
+
+	evaluationSet.Status.Evaluations = sortedAIEvaluationSetStatuses(statusByKey)
+	return r.finish(ctx, evaluationSet, originalStatus, utils.ReasonPartialFailure, reconcileErrs)
+}
+
+// reconcileDeletion deletes the remote evaluations of an AIEvaluationSet that is being deleted or no longer matches
+// the selector, and then removes its finalizer.
+func (r *AIEvaluationSetReconciler) reconcileDeletion(
+	ctx context.Context,
+	reconcileLog logr.Logger,
+	evaluationSet *coralogixv1alpha1.AIEvaluationSet,
+	originalStatus coralogixv1alpha1.AIEvaluationSetStatus,
+) (ctrl.Result, error) {
+	statusByKey := aiEvaluationSetStatusByKey(evaluationSet.Status.Evaluations)
+	if errs := r.deleteEvaluations(ctx, reconcileLog, statusByKey, aiEvaluationSetStatusKeysWithIDs(statusByKey)); len(errs) > 0 {
+		evaluationSet.Status.Evaluations = sortedAIEvaluationSetStatuses(statusByKey)
+		return r.finish(ctx, evaluationSet, originalStatus, utils.ReasonRemoteDeletionFailed, errs)
+	}
+
+	evaluationSet.Status = coralogixv1alpha1.AIEvaluationSetStatus{}
+	if err := updateAIEvaluationSetStatusIfChanged(ctx, evaluationSet, originalStatus); err != nil && !k8serrors.IsNotFound(err) {
+		if k8serrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("clear AIEvaluationSet status: %w", err)
+	}
+	if controllerutil.ContainsFinalizer(evaluationSet, aiEvaluationSetFinalizer) {
+		controllerutil.RemoveFinalizer(evaluationSet, aiEvaluationSetFinalizer)
+		if err := config.GetClient().Update(ctx, evaluationSet); err != nil && !k8serrors.IsNotFound(err) {
+			if k8serrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("remove AIEvaluationSet finalizer: %w", err)
+		}
+	}
+	monitoring.DeleteResourceInfoMetric(utils.AIEvaluationSetKind, evaluationSet.Name, evaluationSet.Namespace)
+	return ctrl.Result{}, nil
+}
+
+func (r *AIEvaluationSetReconciler) deleteEvaluations(
+	ctx context.Context,
+	reconcileLog logr.Logger,
+	statusByKey map[string]coralogixv1alpha1.AIEvaluationSetItemStatus,
+	keys []string,
+) []error {
+	sort.Strings(keys)
+	var resultErrs []error
+	for _, key := range keys {
+		status := statusByKey[key]
+		id := *status.ID
+		reconcileLog.Info("Deleting remote AI evaluation", "key", key, "id", id)
+		if err := r.evaluationsAPI().Delete(ctx, id); err != nil {
+			status.State = coralogixv1alpha1.AIEvaluationSetItemStateDeleting
+			status.Message = fmt.Sprintf("delete AI evaluation %q with ID %q: %v", key, id, err)
+			statusByKey[key] = status
+			resultErrs = append(resultErrs, errors.New(status.Message))
+			continue
+		}
+		delete(statusByKey, key)
+	}
+	return resultErrs
+}
+
+func (r *AIEvaluationSetReconciler) finish(
+	ctx context.Context,
+	evaluationSet *coralogixv1alpha1.AIEvaluationSet,
+	originalStatus coralogixv1alpha1.AIEvaluationSetStatus,
+	reason string,
+	reconcileErrs []error,
+) (ctrl.Result, error) {
+	coralogixreconciler.AcknowledgeReconcileRequest(evaluationSet)
+	if len(reconcileErrs) > 0 {
+		coralogixreconciler.UnscheduleNextReconcile(evaluationSet)
+		joinedErr := errors.Join(reconcileErrs...)
+		utils.SetSyncedConditionFalse(&evaluationSet.Status.Conditions, evaluationSet.Generation, reason, joinedErr.Error())
+		evaluationSet.Status.PrintableStatus = "RemoteUnsynced"
+		if err := updateAIEvaluationSetStatusIfChanged(ctx, evaluationSet, originalStatus); err != nil {
+			if k8serrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("update failed AIEvaluationSet status: %w", err)
+		}
+		monitoring.SetResourceInfoMetricUnsynced(utils.AIEvaluationSetKind, evaluationSet.Name, evaluationSet.Namespace)
+		return ctrl.Result{}, joinedErr
+	}
+
+	utils.SetSyncedConditionTrue(&evaluationSet.Status.Conditions, evaluationSet.Generation, utils.ReasonRemoteSyncedSuccessfully)
+	evaluationSet.Status.PrintableStatus = "RemoteSynced"
+	requeueAfter, _ := coralogixreconciler.ScheduleNextReconcile(evaluationSet, r.Interval)
+	evaluationSet.Status.URL = coralogixreconciler.RemoteURL(evaluationSet)
+	if err := updateAIEvaluationSetStatusIfChanged(ctx, evaluationSet, originalStatus); err != nil {
+		if k8serrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("update synchronized AIEvaluationSet status: %w", err)
+	}
+	monitoring.SetResourceInfoMetricSynced(utils.AIEvaluationSetKind, evaluationSet.Name, evaluationSet.Namespace)
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// persistCreatedAIEvaluationSetStatus writes the IDs of the created evaluations before the reconcile continues, so
+// that a later failure does not leave remote evaluations the status does not know about. On conflict, the IDs are
+// written to the latest object under their keys.
+func persistCreatedAIEvaluationSetStatus(
+	ctx context.Context,
+	evaluationSet *coralogixv1alpha1.AIEvaluationSet,
+	statusByKey map[string]coralogixv1alpha1.AIEvaluationSetItemStatus,
+	createdKeys []string,
+) error {
+	evaluationSet.Status.Evaluations = sortedAIEvaluationSetStatuses(statusByKey)
+	statusUpdateErr := config.GetClient().Status().Update(ctx, evaluationSet)
+	if statusUpdateErr == nil {
+		return nil
+	}
+
+	err := retry.OnError(retry.DefaultBackoff, k8serrors.IsConflict, func() error {
+		latest := &coralogixv1alpha1.AIEvaluationSet{}
+		if err := config.GetClient().Get(ctx, client.ObjectKeyFromObject(evaluationSet), latest); err != nil {
+			return err
+		}
+		latestStatuses := aiEvaluationSetStatusByKey(latest.Status.Evaluations)
+		for _, key := range createdKeys {
+			latestStatuses[key] = statusByKey[key]
+		}
+		latest.Status.Evaluations = sortedAIEvaluationSetStatuses(latestStatuses)
+		if err := config.GetClient().Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		*evaluationSet = *latest
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("persist created AIEvaluationSet IDs after status update error: %w", errors.Join(statusUpdateErr, err))
+	}
+	return nil
+}
+
+// aiEvaluationUpToDate reports whether a remote evaluation already matches an update request.
+func aiEvaluationUpToDate(
+	remote aievaluations.AiEvaluation,
+	request *aievaluations.AiEvaluationsServiceUpdateAiEvaluationRequest,
+) bool {
+	if remote.GetThreshold() != request.GetThreshold() || remote.GetIsEnabled() != request.GetIsEnabled() {
+		return false
+	}
+	remoteConfig, err := json.Marshal(remote.GetConfig())
+	if err != nil {
+		return false
+	}
+	desiredConfig, err := json.Marshal(request.GetConfig())
+	if err != nil {
+		return false
+	}
+	return string(remoteConfig) == string(desiredConfig)
+}
+
+func validateAIEvaluationSetItems(items []coralogixv1alpha1.AIEvaluationSetItem) error {
+	if len(items) < 1 || len(items) > 100 {
+		return fmt.Errorf("AIEvaluationSet must contain between 1 and 100 evaluations, got %d", len(items))
+	}
+	seen := make(map[string]struct{}, len(items))
+	var validationErrs []error
+	for _, item := range items {
+		if messages := validation.IsDNS1123Label(item.Key); len(messages) > 0 {
+			validationErrs = append(validationErrs, fmt.Errorf("invalid evaluation key %q: %s", item.Key, strings.Join(messages, ", ")))
+		}
+		if _, duplicate := seen[item.Key]; duplicate {
+			validationErrs = append(validationErrs, fmt.Errorf("duplicate evaluation key %q", item.Key))
+		}
+		seen[item.Key] = struct{}{}
+	}
+	return errors.Join(validationErrs...)
+}
+
+func aiEvaluationSetStatusByKey(
+	statuses []coralogixv1alpha1.AIEvaluationSetItemStatus,
+) map[string]coralogixv1alpha1.AIEvaluationSetItemStatus {
+	result := make(map[string]coralogixv1alpha1.AIEvaluationSetItemStatus, len(statuses))
+	for _, status := range statuses {
+		if status.ID != nil && *status.ID == "" {
+			status.ID = nil
+		}
+		result[status.Key] = status
+	}
+	return result
+}
+
+func sortedAIEvaluationSetKeys(items map[string]coralogixv1alpha1.AIEvaluationSetItem) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedAIEvaluationSetStatuses(
+	statuses map[string]coralogixv1alpha1.AIEvaluationSetItemStatus,
+) []coralogixv1alpha1.AIEvaluationSetItemStatus {
+	keys := make([]string, 0, len(statuses))
+	for key := range statuses {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := make([]coralogixv1alpha1.AIEvaluationSetItemStatus, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, statuses[key])
+	}
+	return result
+}
+
+func aiEvaluationSetStatusKeysWithIDs(statuses map[string]coralogixv1alpha1.AIEvaluationSetItemStatus) []string {
+	var keys []string
+	for key, status := range statuses {
+		if hasAIEvaluationSetStatusID(status) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func hasAIEvaluationSetStatusID(status coralogixv1alpha1.AIEvaluationSetItemStatus) bool {
+	return status.ID != nil && *status.ID != ""
+}
+
+func setAIEvaluationSetStatusFailure(
+	statuses map[string]coralogixv1alpha1.AIEvaluationSetItemStatus,
+	key string,
+	err error,
+) error {
+	status := statuses[key]
+	status.Key = key
+	status.State = coralogixv1alpha1.AIEvaluationSetItemStateFailed
+	status.Message = err.Error()
+	statuses[key] = status
+	return err
+}
+
+func updateAIEvaluationSetStatusIfChanged(
+	ctx context.Context,
+	evaluationSet *coralogixv1alpha1.AIEvaluationSet,
+	originalStatus coralogixv1alpha1.AIEvaluationSetStatus,
+) error {
+	if reflect.DeepEqual(originalStatus, evaluationSet.Status) {
+		return nil
+	}
+	return config.GetClient().Status().Update(ctx, evaluationSet)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AIEvaluationSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&coralogixv1alpha1.AIEvaluationSet{}).
+		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
+		Complete(r)
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aievaluations "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/ai_evaluations_service"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// fakeAIEvaluationSetAPI keeps the remote evaluations in memory and records the calls made to it.
+type fakeAIEvaluationSetAPI struct {
+	remotes map[string]aievaluations.AiEvaluation
+	nextID  int
+	calls   []string
+}
+
+func (f *fakeAIEvaluationSetAPI) List(_ context.Context, _, _ string) ([]aievaluations.AiEvaluation, error) {
+	f.calls = append(f.calls, "list")
+	result := make([]aievaluations.AiEvaluation, 0, len(f.remotes))
+	for _, remote := range f.remotes {
+		result = append(result, remote)
+	}
+	return result, nil
+}
+
+func (f *fakeAIEvaluationSetAPI) Create(
+	_ context.Context,
+	request aievaluations.AiEvaluationsServiceCreateAiEvaluationRequest,
+) (string, error) {
+	f.nextID++
+	id := fmt.Sprintf("evaluation-%d", f.nextID)
+	f.calls = append(f.calls, "create "+id)
+	f.store(id, request.GetThreshold(), request.GetIsEnabled(), request.GetConfig())
+	return id, nil
+}
+
+func (f *fakeAIEvaluationSetAPI) Update(
+	_ context.Context,
+	id string,
+	request aievaluations.AiEvaluationsServiceUpdateAiEvaluationRequest,
+) error {
+	f.calls = append(f.calls, "update "+id)
+	f.store(id, request.GetThreshold(), request.GetIsEnabled(), request.GetConfig())
+	return nil
+}
+
+func (f *fakeAIEvaluationSetAPI) Delete(_ context.Context, id string) error {
+	f.calls = append(f.calls, "delete "+id)
+	delete(f.remotes, id)
+	return nil
+}
+
+func (f *fakeAIEvaluationSetAPI) store(id string, threshold float64, isEnabled bool, evaluationConfig aievaluations.EvaluationConfig) {
+	remote := aievaluations.AiEvaluation{}
+	remote.SetId(id)
+	remote.SetThreshold(threshold)
+	remote.SetIsEnabled(isEnabled)
+	remote.SetConfig(evaluationConfig)
+	f.remotes[id] = remote
+}
+
+func TestAIEvaluationSetReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+	evaluationSet := &coralogixv1alpha1.AIEvaluationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "chatbot", Namespace: "default"},
+		Spec: coralogixv1alpha1.AIEvaluationSetSpec{
+			Application: "chatbot",
+			Subsystem:   "production",
+			Evaluations: []coralogixv1alpha1.AIEvaluationSetItem{
+				{
+					Key:       "pii",
+					Target:    coralogixv1alpha1.AIEvaluationTargetResponse,
+					Threshold: resource.MustParse("0.8"),
+					Config: coralogixv1alpha1.AIEvaluationConfig{PII: &coralogixv1alpha1.AIEvaluationPIIConfig{
+						Categories: []coralogixv1alpha1.AIEvaluationPIICategory{coralogixv1alpha1.AIEvaluationPIICategoryEmailAddress},
+					}},
+				},
+				{
+					Key:       "toxicity",
+					Target:    coralogixv1alpha1.AIEvaluationTargetResponse,
+					Threshold: resource.MustParse("0.9"),
+					Config:    coralogixv1alpha1.AIEvaluationConfig{Toxicity: &map[string]string{}},
+				},
+			},
+		},
+	}
+
+	originalClient := config.GetClient()
+	t.Cleanup(func() { config.InitClient(originalClient) })
+	config.InitClient(fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&coralogixv1alpha1.AIEvaluationSet{}).
+		WithObjects(evaluationSet).
+		Build())
+
+	ctx := context.Background()
+	api := &fakeAIEvaluationSetAPI{remotes: map[string]aievaluations.AiEvaluation{}}
+	reconciler := &AIEvaluationSetReconciler{api: api}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(evaluationSet)}
+	reconcile := func(expectedCalls ...string) *coralogixv1alpha1.AIEvaluationSet {
+		t.Helper()
+		api.calls = nil
+		_, err := reconciler.Reconcile(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, expectedCalls, api.calls)
+		latest := &coralogixv1alpha1.AIEvaluationSet{}
+		require.NoError(t, config.GetClient().Get(ctx, req.NamespacedName, latest))
+		return latest
+	}
+	update := func(mutate func(*coralogixv1alpha1.AIEvaluationSet)) {
+		t.Helper()
+		latest := &coralogixv1alpha1.AIEvaluationSet{}
+		require.NoError(t, config.GetClient().Get(ctx, req.NamespacedName, latest))
+		mutate(latest)
+		require.NoError(t, config.GetClient().Update(ctx, latest))
+	}
+
+	// The first reconcile only adds the finalizer.
+	reconcile()
+	latest := reconcile("create evaluation-1", "create evaluation-2")
+	require.Equal(t, "RemoteSynced", latest.Status.PrintableStatus)
+	require.Equal(t, []coralogixv1alpha1.AIEvaluationSetItemStatus{
+		{Key: "pii", ID: ptr.To("evaluation-1"), Target: "response", State: coralogixv1alpha1.AIEvaluationSetItemStateSynced},
+		{Key: "toxicity", ID: ptr.To("evaluation-2"), Target: "response", State: coralogixv1alpha1.AIEvaluationSetItemStateSynced},
+	}, latest.Status.Evaluations)
+
+	// Evaluations matching their remote are not updated.
+	reconcile("list")
+
+	// A reconcile request updates them anyway, and is acknowledged in the status.
+	update(func(set *coralogixv1alpha1.AIEvaluationSet) {
+		set.Annotations = map[string]string{utils.ReconcileRequestedAtAnnotationKey: "2026-10-19T10:00:00Z"}
+	})
+	latest = reconcile("list", "update evaluation-1", "update evaluation-2")
+	require.Equal(t, "2026-10-19T10:00:00Z", latest.Status.LastHandledReconcileAt)
+	reconcile("list")
+
+	update(func(set *coralogixv1alpha1.AIEvaluationSet) {
+		set.Spec.Evaluations[1].Threshold = resource.MustParse("0.7")
+	})
+	reconcile("list", "update evaluation-2")
+
+	// A changed target recreates the evaluation, and a removed evaluation is deleted.
+	update(func(set *coralogixv1alpha1.AIEvaluationSet) {
+		set.Spec.Evaluations[0].Target = coralogixv1alpha1.AIEvaluationTargetPrompt
+		set.Spec.Evaluations = set.Spec.Evaluations[:1]
+	})
+	latest = reconcile("delete evaluation-1", "delete evaluation-2", "create evaluation-3")
+	require.Equal(t, []coralogixv1alpha1.AIEvaluationSetItemStatus{
+		{Key: "pii", ID: ptr.To("evaluation-3"), Target: "prompt", State: coralogixv1alpha1.AIEvaluationSetItemStateSynced},
+	}, latest.Status.Evaluations)
+
+	// An evaluation deleted out of band is recreated.
+	delete(api.remotes, "evaluation-3")
+	reconcile("list", "create evaluation-4")
+
+	require.NoError(t, config.GetClient().Delete(ctx, evaluationSet))
+	api.calls = nil
+	_, err := reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"delete evaluation-4"}, api.calls)
+	require.Empty(t, api.remotes)
+}
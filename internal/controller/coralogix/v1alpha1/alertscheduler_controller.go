@@ -133,5 +133,6 @@ func (r *AlertSchedulerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.AlertScheduler{}).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
@@ -28,7 +28,9 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -39,7 +41,9 @@ import (
 	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
 	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
 	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	coralogixreconciler "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
 	"github.com/coralogix/coralogix-operator/v2/internal/monitoring"
+	"github.com/coralogix/coralogix-operator/v2/internal/provenance"
 	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 )
 
@@ -105,6 +109,7 @@ func (r *AlertSetReconciler) alertsAPI() alertSetAPI {
 // +kubebuilder:rbac:groups=coralogix.com,resources=alertsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=coralogix.com,resources=alertsets/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=coralogix.com,resources=alertsets/finalizers,verbs=update
+// +kubebuilder:rbac:groups=coralogix.com,resources=coralogixdefaults,verbs=get;list;watch
 
 func (r *AlertSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	alertSet := &coralogixv1alpha1.AlertSet{}
@@ -159,6 +164,16 @@ func (r *AlertSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		}
 	}
 
+	defaults, err := coralogixv1alpha1.GetAlertDefaults(ctx, alertSet.Namespace)
+	if err != nil {
+		return r.finish(ctx, alertSet, originalStatus, utils.ReasonInternalK8sError, []error{err})
+	}
+	for key, desired := range desiredByKey {
+		status := statusByKey[key]
+		_, status.AppliedDefaults = desired.Spec.WithDefaults(defaults)
+		statusByKey[key] = status
+	}
+
 	removedKeys := make([]string, 0)
 	for key, status := range statusByKey {
 		if _, found := desiredByKey[key]; found {
@@ -179,7 +194,7 @@ func (r *AlertSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	}
 
 	var reconcileErrs []error
-	createdKeys, createErrs, createRequestErr := r.createAlerts(ctx, reconcileLog, alertSet, desiredByKey, statusByKey)
+	createdKeys, createErrs, createRequestErr := r.createAlerts(ctx, reconcileLog, alertSet, defaults, desiredByKey, statusByKey)
 	reconcileErrs = append(reconcileErrs, createErrs...)
 	if len(createdKeys) > 0 {
 		statusRecoveryAttempted, err := r.persistCreatedAlertSetStatus(
@@ -210,6 +225,7 @@ func (r *AlertSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		ctx,
 		reconcileLog,
 		alertSet,
+		defaults,
 		desiredByKey,
 		statusByKey,
 		createdKeys,
@@ -221,6 +237,10 @@ func (r *AlertSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return r.finish(ctx, alertSet, originalStatus, utils.ReasonRemoteUpdateFailed, reconcileErrs)
 	}
 
+	if err := finishAlertSetRollback(ctx, alertSet, statusByKey); err != nil {
+		reconcileErrs = append(reconcileErrs, err)
+	}
+
 	alertSet.Status.Alerts = sortedAlertSetStatuses(statusByKey)
 	if len(reconcileErrs) == 0 {
 		if err := validateSynchronizedAlertSet(desiredByKey, statusByKey); err != nil {
@@ -313,6 +333,7 @@ func (r *AlertSetReconciler) createAlerts(
 	ctx context.Context,
 	reconcileLog logr.Logger,
 	alertSet *coralogixv1alpha1.AlertSet,
+	defaults *coralogixv1beta1.AlertDefaults,
 	desiredByKey map[string]coralogixv1alpha1.AlertSetItem,
 	statusByKey map[string]coralogixv1alpha1.AlertSetItemStatus,
 ) (map[string]struct{}, []error, error) {
@@ -331,6 +352,7 @@ func (r *AlertSetReconciler) createAlerts(
 			Log:       reconcileLog,
 			ClientSet: r.ClientSet,
 			Namespace: alertSet.Namespace,
+			Defaults:  defaults,
 		})
 		if err != nil {
 			itemErr := fmt.Errorf("convert alert %q for create: %w", key, err)
@@ -338,6 +360,7 @@ func (r *AlertSetReconciler) createAlerts(
 			itemErrs = append(itemErrs, itemErr)
 			continue
 		}
+		props.EntityLabels = ptr.To(provenance.WithLabels(ptr.Deref(props.EntityLabels, nil), alertSet))
 		requestItems = append(requestItems, alerts.AlertDefToCreate{AlertDefProperties: *props})
 		requestKeys = append(requestKeys, key)
 	}
@@ -354,6 +377,15 @@ func (r *AlertSetReconciler) createAlerts(
 	}
 
 	responseErrs := applyBulkCreateResponse(requestKeys, response, statusByKey, createdKeys)
+	for key := range createdKeys {
+		// A shadow alert left by a recreated live alert is kept in the status, so that it is promoted or rolled
+		// back by the next reconcile.
+		if status := statusByKey[key]; status.Rollout == nil || status.Rollout.ShadowID == nil {
+			desired := desiredByKey[key]
+			_, status.Rollout = desired.Spec.NextRolloutStep(nil, false, time.Now())
+			statusByKey[key] = status
+		}
+	}
 	return createdKeys, append(itemErrs, responseErrs...), nil
 }
 
@@ -406,6 +438,7 @@ func (r *AlertSetReconciler) replaceAlerts(
 	ctx context.Context,
 	reconcileLog logr.Logger,
 	alertSet *coralogixv1alpha1.AlertSet,
+	defaults *coralogixv1beta1.AlertDefaults,
 	desiredByKey map[string]coralogixv1alpha1.AlertSetItem,
 	statusByKey map[string]coralogixv1alpha1.AlertSetItemStatus,
 	createdKeys map[string]struct{},
@@ -413,6 +446,9 @@ func (r *AlertSetReconciler) replaceAlerts(
 	keys := sortedDesiredAlertSetKeys(desiredByKey)
 	requestItems := make([]alerts.AlertDefToReplace, 0, len(keys))
 	requestIDs := make(map[string]string, len(keys))
+	rollout := newAlertSetRollout()
+	_, rollback := alertSet.Annotations[utils.RollbackAnnotationKey]
+	now := time.Now()
 	var itemErrs []error
 	for _, key := range keys {
 		if _, created := createdKeys[key]; created {
@@ -428,6 +464,7 @@ func (r *AlertSetReconciler) replaceAlerts(
 			Log:       reconcileLog,
 			ClientSet: r.ClientSet,
 			Namespace: alertSet.Namespace,
+			Defaults:  defaults,
 		})
 		if err != nil {
 			itemErr := fmt.Errorf("convert alert %q for replace: %w", key, err)
@@ -435,23 +472,166 @@ func (r *AlertSetReconciler) replaceAlerts(
 			itemErrs = append(itemErrs, itemErr)
 			continue
 		}
-		id := *status.ID
-		requestItems = append(requestItems, alerts.AlertDefToReplace{Id: &id, AlertDefProperties: props})
-		requestIDs[id] = key
+		props.EntityLabels = ptr.To(provenance.WithLabels(ptr.Deref(props.EntityLabels, nil), alertSet))
+		step, rolloutStatus := desired.Spec.NextRolloutStep(status.Rollout, rollback, now)
+		rollout.steps[key] = step
+		rollout.statuses[key] = rolloutStatus
+		switch step {
+		case coralogixv1beta1.AlertRolloutStepSync, coralogixv1beta1.AlertRolloutStepPromote:
+			id := *status.ID
+			requestItems = append(requestItems, alerts.AlertDefToReplace{Id: &id, AlertDefProperties: props})
+			requestIDs[id] = key
+		case coralogixv1beta1.AlertRolloutStepCreateShadow, coralogixv1beta1.AlertRolloutStepReplaceShadow:
+			rollout.shadows[key] = coralogixv1beta1.ShadowAlertDefProperties(props)
+		}
 	}
-	if len(requestItems) == 0 {
-		return itemErrs, nil
+
+	if len(requestItems) > 0 {
+		request := alerts.BulkReplaceAlertDefinitionsRequest{AlertDefsToReplace: requestItems}
+		reconcileLog.Info("Replacing remote alerts", "count", len(requestItems))
+		response, httpResponse, err := r.alertsAPI().BulkReplace(ctx, request)
+		if err != nil {
+			return itemErrs, fmt.Errorf("bulk replace remote alerts: %w", cxsdk.NewAPIError(httpResponse, err))
+		}
+		itemErrs = append(itemErrs, applyBulkReplaceResponse(requestIDs, response, statusByKey)...)
 	}
 
-	request := alerts.BulkReplaceAlertDefinitionsRequest{AlertDefsToReplace: requestItems}
-	reconcileLog.Info("Replacing remote alerts", "count", len(requestItems))
-	response, httpResponse, err := r.alertsAPI().BulkReplace(ctx, request)
-	if err != nil {
-		return itemErrs, fmt.Errorf("bulk replace remote alerts: %w", cxsdk.NewAPIError(httpResponse, err))
+	return append(itemErrs, r.rollOutAlerts(ctx, reconcileLog, rollout, statusByKey)...), nil
+}
+
+// finishAlertSetRollback removes the rollback annotation of an AlertSet once no alert has a shadow alert left. It is
+// kept while a shadow alert failed to be deleted, so that the rollback is retried instead of the next reconciliation
+// promoting the rolled back change.
+func finishAlertSetRollback(
+	ctx context.Context,
+	alertSet *coralogixv1alpha1.AlertSet,
+	statusByKey map[string]coralogixv1alpha1.AlertSetItemStatus,
+) error {
+	if _, rollback := alertSet.Annotations[utils.RollbackAnnotationKey]; !rollback {
+		return nil
+	}
+	for _, status := range statusByKey {
+		if status.Rollout != nil && status.Rollout.ShadowID != nil {
+			return nil
+		}
+	}
+
+	patch := client.MergeFrom(alertSet.DeepCopy())
+	delete(alertSet.Annotations, utils.RollbackAnnotationKey)
+	if err := config.GetClient().Patch(ctx, alertSet, patch); err != nil {
+		return fmt.Errorf("remove AlertSet rollback annotation: %w", err)
+	}
+	return nil
+}
+
+// alertSetRollout is the next rollout step of each replaced alert of an AlertSet, the rollout status once the step
+// succeeded, and the properties of the shadow alerts to create or replace.
+type alertSetRollout struct {
+	steps    map[string]coralogixv1beta1.AlertRolloutStep
+	statuses map[string]*coralogixv1beta1.AlertRolloutStatus
+	shadows  map[string]*alerts.AlertDefProperties
+}
+
+func newAlertSetRollout() alertSetRollout {
+	return alertSetRollout{
+		steps:    map[string]coralogixv1beta1.AlertRolloutStep{},
+		statuses: map[string]*coralogixv1beta1.AlertRolloutStatus{},
+		shadows:  map[string]*alerts.AlertDefProperties{},
+	}
+}
+
+// rollOutAlerts creates, replaces and deletes the shadow alerts of the rollout, and records the rollout status of
+// every alert whose step succeeded. The live alerts are replaced before, and a promoted shadow alert is only deleted
+// once its live alert was replaced.
+func (r *AlertSetReconciler) rollOutAlerts(
+	ctx context.Context,
+	reconcileLog logr.Logger,
+	rollout alertSetRollout,
+	statusByKey map[string]coralogixv1alpha1.AlertSetItemStatus,
+) []error {
+	var createKeys []string
+	var createItems []alerts.AlertDefToCreate
+	var replaceItems []alerts.AlertDefToReplace
+	replaceIDs := make(map[string]string)
+	var resultErrs []error
+	keys := make([]string, 0, len(rollout.steps))
+	for key := range rollout.steps {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		status := statusByKey[key]
+		switch step := rollout.steps[key]; step {
+		case coralogixv1beta1.AlertRolloutStepSync:
+			if status.State == coralogixv1alpha1.AlertSetItemStateSynced {
+				status.Rollout = rollout.statuses[key]
+			}
+		case coralogixv1beta1.AlertRolloutStepPromote, coralogixv1beta1.AlertRolloutStepRollback:
+			if step == coralogixv1beta1.AlertRolloutStepPromote && status.State != coralogixv1alpha1.AlertSetItemStateSynced {
+				continue
+			}
+			shadowID := *status.Rollout.ShadowID
+			reconcileLog.Info("Deleting remote shadow alert", "key", key, "id", shadowID, "step", step)
+			httpResponse, err := r.alertsAPI().Delete(ctx, shadowID)
+			if err != nil && (httpResponse == nil || httpResponse.StatusCode != http.StatusNotFound) {
+				itemErr := fmt.Errorf("delete shadow alert %q with ID %q: %w", key, shadowID, cxsdk.NewAPIError(httpResponse, err))
+				setAlertSetStatusFailure(statusByKey, key, itemErr.Error())
+				resultErrs = append(resultErrs, itemErr)
+				continue
+			}
+			status.Rollout = rollout.statuses[key]
+		case coralogixv1beta1.AlertRolloutStepCreateShadow:
+			createKeys = append(createKeys, key)
+			createItems = append(createItems, alerts.AlertDefToCreate{AlertDefProperties: *rollout.shadows[key]})
+		case coralogixv1beta1.AlertRolloutStepReplaceShadow:
+			id := *rollout.statuses[key].ShadowID
+			replaceItems = append(replaceItems, alerts.AlertDefToReplace{Id: &id, AlertDefProperties: rollout.shadows[key]})
+			replaceIDs[id] = key
+		}
+		statusByKey[key] = status
 	}
 
-	responseErrs := applyBulkReplaceResponse(requestIDs, response, statusByKey)
-	return append(itemErrs, responseErrs...), nil
+	// The shadow alerts are mapped back to their keys through scratch statuses, since the bulk responses are applied
+	// to the statuses of the live alerts.
+	if len(createItems) > 0 {
+		reconcileLog.Info("Creating remote shadow alerts", "count", len(createItems))
+		response, httpResponse, err := r.alertsAPI().BulkCreate(ctx, alerts.BulkCreateAlertDefinitionsRequest{AlertDefsToCreate: createItems})
+		if err != nil {
+			return append(resultErrs, fmt.Errorf("bulk create remote shadow alerts: %w", cxsdk.NewAPIError(httpResponse, err)))
+		}
+		shadowStatuses := make(map[string]coralogixv1alpha1.AlertSetItemStatus, len(createKeys))
+		createdKeys := make(map[string]struct{}, len(createKeys))
+		resultErrs = append(resultErrs, applyBulkCreateResponse(createKeys, response, shadowStatuses, createdKeys)...)
+		for _, key := range createKeys {
+			if _, created := createdKeys[key]; !created {
+				setAlertSetStatusFailure(statusByKey, key, "shadow: "+shadowStatuses[key].Message)
+				continue
+			}
+			status := statusByKey[key]
+			status.Rollout = rollout.statuses[key]
+			status.Rollout.ShadowID = shadowStatuses[key].ID
+			statusByKey[key] = status
+		}
+	}
+	if len(replaceItems) > 0 {
+		reconcileLog.Info("Replacing remote shadow alerts", "count", len(replaceItems))
+		response, httpResponse, err := r.alertsAPI().BulkReplace(ctx, alerts.BulkReplaceAlertDefinitionsRequest{AlertDefsToReplace: replaceItems})
+		if err != nil {
+			return append(resultErrs, fmt.Errorf("bulk replace remote shadow alerts: %w", cxsdk.NewAPIError(httpResponse, err)))
+		}
+		shadowStatuses := make(map[string]coralogixv1alpha1.AlertSetItemStatus, len(replaceIDs))
+		resultErrs = append(resultErrs, applyBulkReplaceResponse(replaceIDs, response, shadowStatuses)...)
+		for _, key := range replaceIDs {
+			if shadowStatuses[key].State != coralogixv1alpha1.AlertSetItemStateSynced {
+				setAlertSetStatusFailure(statusByKey, key, "shadow: "+shadowStatuses[key].Message)
+				continue
+			}
+			status := statusByKey[key]
+			status.Rollout = rollout.statuses[key]
+			statusByKey[key] = status
+		}
+	}
+	return resultErrs
 }
 
 func (r *AlertSetReconciler) deleteAlerts(
@@ -466,6 +646,7 @@ func (r *AlertSetReconciler) deleteAlerts(
 	sort.Strings(keys)
 	ids := make([]string, 0, len(keys))
 	idToKey := make(map[string]string, len(keys))
+	var shadowErrs []error
 	for _, key := range keys {
 		status := statusByKey[key]
 		if !hasAlertSetStatusID(status) {
@@ -474,13 +655,25 @@ func (r *AlertSetReconciler) deleteAlerts(
 		}
 		status.State = coralogixv1alpha1.AlertSetItemStateDeleting
 		status.Message = ""
+		if status.Rollout != nil && status.Rollout.ShadowID != nil {
+			shadowID := *status.Rollout.ShadowID
+			httpResponse, err := r.alertsAPI().Delete(ctx, shadowID)
+			if err != nil && (httpResponse == nil || httpResponse.StatusCode != http.StatusNotFound) {
+				itemErr := fmt.Errorf("delete shadow alert %q with ID %q: %w", key, shadowID, cxsdk.NewAPIError(httpResponse, err))
+				status.Message = itemErr.Error()
+				statusByKey[key] = status
+				shadowErrs = append(shadowErrs, itemErr)
+				continue
+			}
+			status.Rollout.ShadowID = nil
+		}
 		statusByKey[key] = status
 		id := *status.ID
 		ids = append(ids, id)
 		idToKey[id] = key
 	}
 	if len(ids) == 0 {
-		return nil
+		return shadowErrs
 	}
 
 	reconcileLog.Info("Deleting remote alerts", "count", len(ids))
@@ -488,19 +681,19 @@ func (r *AlertSetReconciler) deleteAlerts(
 	response, httpResponse, err := r.alertsAPI().BulkDelete(ctx, request)
 	if err != nil {
 		if httpResponse != nil && httpResponse.StatusCode == http.StatusBadRequest {
-			return r.deleteAlertsIndividually(ctx, statusByKey, ids, idToKey)
+			return append(shadowErrs, r.deleteAlertsIndividually(ctx, statusByKey, ids, idToKey)...)
 		}
 		apiErr := cxsdk.NewAPIError(httpResponse, err)
 		message := fmt.Sprintf("bulk delete remote alerts: %v", apiErr)
-		for _, key := range keys {
+		for _, key := range idToKey {
 			status := statusByKey[key]
 			status.Message = message
 			statusByKey[key] = status
 		}
-		return []error{errors.New(message)}
+		return append(shadowErrs, errors.New(message))
 	}
 	if response == nil {
-		return []error{errors.New("bulk delete remote alerts: response is empty")}
+		return append(shadowErrs, errors.New("bulk delete remote alerts: response is empty"))
 	}
 
 	completedIDs := make(map[string]struct{}, len(response.DeletedIds)+len(response.NotFoundIds))
@@ -510,7 +703,7 @@ func (r *AlertSetReconciler) deleteAlerts(
 	for _, id := range response.NotFoundIds {
 		completedIDs[id] = struct{}{}
 	}
-	var resultErrs []error
+	resultErrs := shadowErrs
 	for _, id := range ids {
 		key := idToKey[id]
 		if _, completed := completedIDs[id]; completed {
@@ -566,7 +759,9 @@ func (r *AlertSetReconciler) finish(
 	reason string,
 	reconcileErrs []error,
 ) (ctrl.Result, error) {
+	coralogixreconciler.AcknowledgeReconcileRequest(alertSet)
 	if len(reconcileErrs) > 0 {
+		coralogixreconciler.UnscheduleNextReconcile(alertSet)
 		joinedErr := errors.Join(reconcileErrs...)
 		utils.SetSyncedConditionFalse(&alertSet.Status.Conditions, alertSet.Generation, reason, joinedErr.Error())
 		alertSet.Status.PrintableStatus = "RemoteUnsynced"
@@ -586,6 +781,8 @@ func (r *AlertSetReconciler) finish(
 		utils.ReasonRemoteSyncedSuccessfully,
 	)
 	alertSet.Status.PrintableStatus = "RemoteSynced"
+	requeueAfter, _ := coralogixreconciler.ScheduleNextReconcile(alertSet, r.Interval)
+	alertSet.Status.URL = coralogixreconciler.RemoteURL(alertSet)
 	if err := updateAlertSetStatusIfChanged(ctx, alertSet, originalStatus); err != nil {
 		if k8serrors.IsConflict(err) {
 			return ctrl.Result{Requeue: true}, nil
@@ -593,7 +790,17 @@ func (r *AlertSetReconciler) finish(
 		return ctrl.Result{}, fmt.Errorf("update synchronized AlertSet status: %w", err)
 	}
 	monitoring.SetResourceInfoMetricSynced(utils.AlertSetKind, alertSet.Name, alertSet.Namespace)
-	return ctrl.Result{RequeueAfter: r.Interval}, nil
+	return alertSetResult(alertSet, requeueAfter, time.Now()), nil
+}
+
+// alertSetResult requeues the AlertSet after the given delay, or earlier when the shadow alert of one of its items is
+// due for promotion.
+func alertSetResult(alertSet *coralogixv1alpha1.AlertSet, requeueAfter time.Duration, now time.Time) ctrl.Result {
+	result := ctrl.Result{RequeueAfter: requeueAfter}
+	for _, item := range alertSet.Status.Alerts {
+		result = coralogixreconciler.RequeueWithin(result, item.Rollout.PromotionDelay(now))
+	}
+	return result
 }
 
 func applyBulkCreateResponse(
@@ -644,9 +851,11 @@ func applyBulkCreateResponse(
 		}
 		id := *created.Id
 		statusByKey[key] = coralogixv1alpha1.AlertSetItemStatus{
-			Key:   key,
-			ID:    &id,
-			State: coralogixv1alpha1.AlertSetItemStateSynced,
+			Key:             key,
+			ID:              &id,
+			State:           coralogixv1alpha1.AlertSetItemStateSynced,
+			AppliedDefaults: statusByKey[key].AppliedDefaults,
+			Rollout:         statusByKey[key].Rollout,
 		}
 		createdKeys[key] = struct{}{}
 	}
@@ -732,9 +941,11 @@ func applyBulkReplaceResponse(
 		seen[id] = struct{}{}
 		message := fmt.Sprintf("remote alert %q with ID %q was not found", key, id)
 		statusByKey[key] = coralogixv1alpha1.AlertSetItemStatus{
-			Key:     key,
-			State:   coralogixv1alpha1.AlertSetItemStatePending,
-			Message: message,
+			Key:             key,
+			State:           coralogixv1alpha1.AlertSetItemStatePending,
+			Message:         message,
+			AppliedDefaults: statusByKey[key].AppliedDefaults,
+			Rollout:         statusByKey[key].Rollout,
 		}
 		resultErrs = append(resultErrs, errors.New(message))
 	}
@@ -876,7 +1087,9 @@ func updateAlertSetStatusIfChanged(
 // SetupWithManager sets up the controller with the Manager.
 func (r *AlertSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&coralogixv1alpha1.AlertSet{}).
-		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		For(&coralogixv1alpha1.AlertSet{}, builder.WithPredicates(config.GetConfig().Selector.Predicate(), coralogixreconciler.IgnoreInformationalStatusUpdates())).
+		Watches(&coralogixv1alpha1.CoralogixDefaults{}, coralogixreconciler.EnqueueForCoralogixDefaults(func() client.ObjectList {
+			return &coralogixv1alpha1.AlertSetList{}
+		})).
 		Complete(r)
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,7 @@ import (
 	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
 	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
 	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 )
 
 type fakeAlertSetAPI struct {
@@ -436,6 +438,7 @@ func TestCreateAlertsContinuesAfterLocalConversionFailure(t *testing.T) {
 		context.Background(),
 		logr.Discard(),
 		alertSet,
+		nil,
 		desired,
 		statuses,
 	)
@@ -482,6 +485,7 @@ func TestCreateAlertsUsesOneRequestForOneHundredItems(t *testing.T) {
 		context.Background(),
 		logr.Discard(),
 		&coralogixv1alpha1.AlertSet{},
+		nil,
 		desiredAlertSetItemsByKey(items),
 		statuses,
 	)
@@ -539,6 +543,7 @@ func TestReplaceAlertsContinuesAfterLocalConversionFailure(t *testing.T) {
 		context.Background(),
 		logr.Discard(),
 		&coralogixv1alpha1.AlertSet{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}},
+		nil,
 		desiredAlertSetItemsByKey([]coralogixv1alpha1.AlertSetItem{valid, invalid}),
 		statuses,
 		map[string]struct{}{},
@@ -587,6 +592,7 @@ func TestReplaceAlertsUsesOneRequestForOneHundredItems(t *testing.T) {
 		context.Background(),
 		logr.Discard(),
 		&coralogixv1alpha1.AlertSet{},
+		nil,
 		desiredAlertSetItemsByKey(items),
 		statuses,
 		map[string]struct{}{},
@@ -601,6 +607,171 @@ func TestReplaceAlertsUsesOneRequestForOneHundredItems(t *testing.T) {
 	}
 }
 
+func TestReplaceAlertsRollsOutThroughShadowAlerts(t *testing.T) {
+	item := minimalAlertSetItem("errors")
+	item.Spec.Rollout = &coralogixv1beta1.AlertRollout{SoakPeriod: metav1.Duration{Duration: time.Hour}}
+	liveID, shadowID := "live-id", "shadow-id"
+	statuses := map[string]coralogixv1alpha1.AlertSetItemStatus{
+		"errors": {
+			Key:     "errors",
+			ID:      &liveID,
+			State:   coralogixv1alpha1.AlertSetItemStateSynced,
+			Rollout: &coralogixv1beta1.AlertRolloutStatus{LiveSpecHash: "previous"},
+		},
+	}
+
+	var replacedIDs, deletedIDs []string
+	reconciler := &AlertSetReconciler{api: fakeAlertSetAPI{
+		bulkCreate: func(
+			_ context.Context,
+			request alerts.BulkCreateAlertDefinitionsRequest,
+		) (*alerts.BulkCreateAlertDefsResponse, *http.Response, error) {
+			require.Len(t, request.AlertDefsToCreate, 1)
+			props := request.AlertDefsToCreate[0].AlertDefProperties
+			require.True(t, props.GetPhantomMode())
+			require.Equal(t, "errors (shadow)", props.GetName())
+			return &alerts.BulkCreateAlertDefsResponse{AlertDefs: []alerts.AlertDef{{Id: &shadowID}}}, nil, nil
+		},
+		bulkReplace: func(
+			_ context.Context,
+			request alerts.BulkReplaceAlertDefinitionsRequest,
+		) (*alerts.BulkReplaceAlertDefsResponse, *http.Response, error) {
+			replaced := make([]alerts.AlertDef, len(request.AlertDefsToReplace))
+			for i := range request.AlertDefsToReplace {
+				replacedIDs = append(replacedIDs, *request.AlertDefsToReplace[i].Id)
+				replaced[i].Id = request.AlertDefsToReplace[i].Id
+			}
+			return &alerts.BulkReplaceAlertDefsResponse{AlertDefs: replaced}, nil, nil
+		},
+		delete: func(_ context.Context, id string) (*http.Response, error) {
+			deletedIDs = append(deletedIDs, id)
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		},
+	}}
+	replaceAlerts := func() {
+		itemErrs, requestErr := reconciler.replaceAlerts(
+			context.Background(),
+			logr.Discard(),
+			&coralogixv1alpha1.AlertSet{},
+			nil,
+			desiredAlertSetItemsByKey([]coralogixv1alpha1.AlertSetItem{item}),
+			statuses,
+			map[string]struct{}{},
+		)
+		require.NoError(t, requestErr)
+		require.Empty(t, itemErrs)
+	}
+
+	// The changed spec runs as a shadow alert, and the live alert is left untouched.
+	replaceAlerts()
+	require.Empty(t, replacedIDs)
+	rollout := statuses["errors"].Rollout
+	require.Equal(t, shadowID, *rollout.ShadowID)
+	require.Equal(t, item.Spec.RolloutHash(), rollout.ShadowSpecHash)
+	require.InDelta(t, time.Hour, time.Until(rollout.SoakDeadline.Time), float64(time.Minute))
+
+	// The shadow alert soaks until its deadline.
+	replaceAlerts()
+	require.Empty(t, replacedIDs)
+	require.Equal(t, rollout, statuses["errors"].Rollout)
+
+	// The live alert is replaced and the shadow alert deleted once the deadline passed.
+	rollout.SoakDeadline = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	replaceAlerts()
+	require.Equal(t, []string{liveID}, replacedIDs)
+	require.Equal(t, []string{shadowID}, deletedIDs)
+	require.Equal(t, &coralogixv1beta1.AlertRolloutStatus{LiveSpecHash: item.Spec.RolloutHash()}, statuses["errors"].Rollout)
+	require.Equal(t, coralogixv1alpha1.AlertSetItemStateSynced, statuses["errors"].State)
+}
+
+func TestAlertSetRollbackAnnotationKeptUntilShadowAlertsAreDeleted(t *testing.T) {
+	originalClient := config.GetClient()
+	t.Cleanup(func() { config.InitClient(originalClient) })
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+	alertSet := &coralogixv1alpha1.AlertSet{ObjectMeta: metav1.ObjectMeta{
+		Name:        "test-alert-set",
+		Namespace:   "default",
+		Annotations: map[string]string{utils.RollbackAnnotationKey: "true"},
+	}}
+	config.InitClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(alertSet.DeepCopy()).Build())
+	require.NoError(t, config.GetClient().Get(context.Background(), client.ObjectKeyFromObject(alertSet), alertSet))
+
+	item := minimalAlertSetItem("errors")
+	item.Spec.Rollout = &coralogixv1beta1.AlertRollout{SoakPeriod: metav1.Duration{Duration: time.Hour}}
+	liveID, shadowID := "live-id", "shadow-id"
+	statuses := map[string]coralogixv1alpha1.AlertSetItemStatus{
+		"errors": {
+			Key:   "errors",
+			ID:    &liveID,
+			State: coralogixv1alpha1.AlertSetItemStateSynced,
+			Rollout: &coralogixv1beta1.AlertRolloutStatus{
+				LiveSpecHash:   "previous",
+				ShadowID:       &shadowID,
+				ShadowSpecHash: item.Spec.RolloutHash(),
+				SoakDeadline:   &metav1.Time{Time: time.Now().Add(time.Hour)},
+			},
+		},
+	}
+
+	deleteErr := errors.New("unavailable")
+	reconciler := &AlertSetReconciler{api: fakeAlertSetAPI{
+		delete: func(_ context.Context, id string) (*http.Response, error) {
+			require.Equal(t, shadowID, id)
+			if deleteErr != nil {
+				return &http.Response{StatusCode: http.StatusServiceUnavailable}, deleteErr
+			}
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		},
+	}}
+	rollBack := func() []error {
+		itemErrs, requestErr := reconciler.replaceAlerts(
+			context.Background(),
+			logr.Discard(),
+			alertSet,
+			nil,
+			desiredAlertSetItemsByKey([]coralogixv1alpha1.AlertSetItem{item}),
+			statuses,
+			map[string]struct{}{},
+		)
+		require.NoError(t, requestErr)
+		require.NoError(t, finishAlertSetRollback(context.Background(), alertSet, statuses))
+		return itemErrs
+	}
+
+	// The shadow alert could not be deleted, so the rollback is retried by the next reconciliation.
+	require.Len(t, rollBack(), 1)
+	require.Equal(t, shadowID, *statuses["errors"].Rollout.ShadowID)
+	stored := &coralogixv1alpha1.AlertSet{}
+	require.NoError(t, config.GetClient().Get(context.Background(), client.ObjectKeyFromObject(alertSet), stored))
+	require.Contains(t, stored.Annotations, utils.RollbackAnnotationKey)
+
+	deleteErr = nil
+	require.Empty(t, rollBack())
+	require.Nil(t, statuses["errors"].Rollout.ShadowID)
+	require.NoError(t, config.GetClient().Get(context.Background(), client.ObjectKeyFromObject(alertSet), stored))
+	require.NotContains(t, stored.Annotations, utils.RollbackAnnotationKey)
+}
+
+func TestAlertSetResultRequeuesAtSoakDeadline(t *testing.T) {
+	now := time.Now()
+	shadowID := "shadow-id"
+	soaking := func(deadline time.Duration) *coralogixv1beta1.AlertRolloutStatus {
+		return &coralogixv1beta1.AlertRolloutStatus{ShadowID: &shadowID, SoakDeadline: &metav1.Time{Time: now.Add(deadline)}}
+	}
+	alertSet := &coralogixv1alpha1.AlertSet{Status: coralogixv1alpha1.AlertSetStatus{Alerts: []coralogixv1alpha1.AlertSetItemStatus{
+		{Key: "synced", Rollout: &coralogixv1beta1.AlertRolloutStatus{LiveSpecHash: "live"}},
+		{Key: "errors", Rollout: soaking(2 * time.Hour)},
+		{Key: "latency", Rollout: soaking(time.Hour)},
+	}}}
+
+	// The earliest soak deadline comes before the periodic reconciliation, and is used even without one.
+	require.Equal(t, time.Hour, alertSetResult(alertSet, 3*time.Hour, now).RequeueAfter)
+	require.Equal(t, time.Hour, alertSetResult(alertSet, 0, now).RequeueAfter)
+	require.Equal(t, 30*time.Minute, alertSetResult(alertSet, 30*time.Minute, now).RequeueAfter)
+}
+
 func TestDeleteAlertsFallsBackAndPersistsPartialProgress(t *testing.T) {
 	statuses := map[string]coralogixv1alpha1.AlertSetItemStatus{}
 	for key, id := range map[string]string{"alpha": "one", "bravo": "two", "charlie": "three"} {
@@ -177,5 +177,6 @@ func (r *ApiKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.ApiKey{}).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
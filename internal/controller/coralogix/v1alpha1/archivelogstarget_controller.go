@@ -60,6 +60,10 @@ func (r *ArchiveLogsTargetReconciler) RequeueInterval() time.Duration {
 	return r.Interval
 }
 
+func (r *ArchiveLogsTargetReconciler) NewSingletonList() client.ObjectList {
+	return &coralogixv1alpha1.ArchiveLogsTargetList{}
+}
+
 func (r *ArchiveLogsTargetReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
 	archivelogstarget := obj.(*coralogixv1alpha1.ArchiveLogsTarget)
 	createRequest, err := archivelogstarget.Spec.ExtractSetTargetRequest(true)
@@ -133,6 +137,8 @@ func (r *ArchiveLogsTargetReconciler) HandleDeletion(ctx context.Context, log lo
 func (r *ArchiveLogsTargetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.ArchiveLogsTarget{}).
+		Watches(&coralogixv1alpha1.ArchiveLogsTarget{}, coralogixreconciler.SingletonPeersHandler(r.NewSingletonList)).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
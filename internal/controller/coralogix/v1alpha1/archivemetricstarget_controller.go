@@ -58,6 +58,10 @@ func (r *ArchiveMetricsTargetReconciler) RequeueInterval() time.Duration {
 	return r.Interval
 }
 
+func (r *ArchiveMetricsTargetReconciler) NewSingletonList() client.ObjectList {
+	return &coralogixv1alpha1.ArchiveMetricsTargetList{}
+}
+
 // We first configure the tenant and then update because we cannot specify the retention days in the configure request.
 func (r *ArchiveMetricsTargetReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
 	archiveMetricsTarget := obj.(*coralogixv1alpha1.ArchiveMetricsTarget)
@@ -131,6 +135,8 @@ func (r *ArchiveMetricsTargetReconciler) HandleDeletion(ctx context.Context, log
 func (r *ArchiveMetricsTargetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.ArchiveMetricsTarget{}).
+		Watches(&coralogixv1alpha1.ArchiveMetricsTarget{}, coralogixreconciler.SingletonPeersHandler(r.NewSingletonList)).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
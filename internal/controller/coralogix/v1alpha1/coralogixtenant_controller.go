@@ -0,0 +1,383 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix"
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	coralogixreconciler "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+const tenantPendingStatus = "Pending"
+
+// CoralogixTenantReconciler reconciles a CoralogixTenant object into its Scope, CustomRole, Group and ApiKey.
+type CoralogixTenantReconciler struct {
+	Interval time.Duration
+}
+
+// +kubebuilder:rbac:groups=coralogix.com,resources=coralogixtenants,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=coralogix.com,resources=coralogixtenants/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=coralogix.com,resources=coralogixtenants/finalizers,verbs=update
+
+func (r *CoralogixTenantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues(
+		"gvk", coralogixv1alpha1.GroupVersion.WithKind(utils.CoralogixTenantKind).String(),
+		"name", req.Name,
+		"namespace", req.Namespace,
+	)
+
+	tenant := &coralogixv1alpha1.CoralogixTenant{}
+	if err := config.GetClient().Get(ctx, req.NamespacedName, tenant); err != nil {
+		if k8serrors.IsNotFound(err) {
+			// Child resources are garbage collected via their owner references.
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !tenant.DeletionTimestamp.IsZero() || !config.GetConfig().Selector.Matches(tenant.Labels, tenant.Namespace) {
+		return ctrl.Result{}, nil
+	}
+
+	resources, syncErr := r.syncResources(ctx, tenant)
+	if syncErr != nil {
+		log.Error(syncErr, "Received an error while trying to sync tenant resources")
+	}
+
+	requeueAfter, err := r.updateStatus(ctx, tenant, resources, syncErr)
+	if err != nil {
+		log.Error(err, "Received an error while trying to update tenant status")
+		return ctrl.Result{}, err
+	}
+
+	if syncErr != nil {
+		return ctrl.Result{}, syncErr
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// syncResources applies the resources of the tenant in dependency order, and returns their statuses.
+// The Group is only created once the Scope and CustomRole it refers to have IDs.
+func (r *CoralogixTenantReconciler) syncResources(
+	ctx context.Context,
+	tenant *coralogixv1alpha1.CoralogixTenant,
+) ([]coralogixv1alpha1.CoralogixTenantResourceStatus, error) {
+	var resources []coralogixv1alpha1.CoralogixTenantResourceStatus
+	var errs []error
+
+	scope := renderTenantScope(tenant)
+	if scope != nil {
+		if err := applyTenantResource(ctx, tenant, scope, func(existing, desired *coralogixv1alpha1.Scope) {
+			existing.Spec = desired.Spec
+		}); err != nil {
+			errs = append(errs, err)
+		}
+		resources = append(resources, tenantResourceStatus(utils.ScopeKind, scope, scope.Status.ID))
+	} else if err := deleteTenantResource(ctx, tenant, &coralogixv1alpha1.Scope{}, tenantResourceName(tenant, "scope")); err != nil {
+		errs = append(errs, err)
+	}
+
+	role := renderTenantCustomRole(tenant)
+	if err := applyTenantResource(ctx, tenant, role, func(existing, desired *coralogixv1alpha1.CustomRole) {
+		existing.Spec = desired.Spec
+	}); err != nil {
+		errs = append(errs, err)
+	}
+	resources = append(resources, tenantResourceStatus(utils.CustomRoleKind, role, role.Status.ID))
+
+	group := renderTenantGroup(tenant, scope != nil)
+	groupExists := true
+	if err := config.GetClient().Get(ctx, client.ObjectKeyFromObject(group), &coralogixv1alpha1.Group{}); err != nil {
+		groupExists = !k8serrors.IsNotFound(err)
+	}
+	if !groupExists && (role.Status.ID == nil || (scope != nil && scope.Status.ID == nil)) {
+		resources = append(resources, coralogixv1alpha1.CoralogixTenantResourceStatus{
+			Kind:            utils.GroupKind,
+			Name:            group.Name,
+			PrintableStatus: tenantPendingStatus,
+			Message:         "waiting for the Scope and CustomRole to be synced",
+		})
+	} else {
+		if err := applyTenantResource(ctx, tenant, group, func(existing, desired *coralogixv1alpha1.Group) {
+			existing.Spec = desired.Spec
+		}); err != nil {
+			errs = append(errs, err)
+		}
+		resources = append(resources, tenantResourceStatus(utils.GroupKind, group, group.Status.ID))
+	}
+
+	apiKey := renderTenantApiKey(tenant)
+	if apiKey != nil {
+		if err := applyTenantResource(ctx, tenant, apiKey, func(existing, desired *coralogixv1alpha1.ApiKey) {
+			existing.Spec = desired.Spec
+		}); err != nil {
+			errs = append(errs, err)
+		}
+		resources = append(resources, tenantResourceStatus(utils.ApiKeyKind, apiKey, apiKey.Status.Id))
+	} else if err := deleteTenantResource(ctx, tenant, &coralogixv1alpha1.ApiKey{}, tenantResourceName(tenant, "api-key")); err != nil {
+		errs = append(errs, err)
+	}
+
+	return resources, errors.Join(errs...)
+}
+
+// applyTenantResource creates or updates a resource of the tenant, refusing to take over resources it does not own.
+// On return, obj holds the resource as stored in the cluster, including its status.
+func applyTenantResource[T coralogix.Object](
+	ctx context.Context,
+	tenant *coralogixv1alpha1.CoralogixTenant,
+	obj T,
+	mutateSpec func(existing, desired T),
+) error {
+	desired := obj.DeepCopyObject().(T)
+	_, err := controllerutil.CreateOrUpdate(ctx, config.GetClient(), obj, func() error {
+		if obj.GetResourceVersion() != "" && !metav1.IsControlledBy(obj, tenant) {
+			return fmt.Errorf("%s already exists and is not managed by CoralogixTenant %s", obj.GetName(), tenant.Name)
+		}
+		mutateSpec(obj, desired)
+		obj.SetLabels(desired.GetLabels())
+		utils.PropagateReconcileRequest(tenant, obj)
+		return controllerutil.SetControllerReference(tenant, obj, config.GetClient().Scheme())
+	})
+	if err != nil {
+		return fmt.Errorf("error applying %s: %w", obj.GetName(), err)
+	}
+	return nil
+}
+
+func deleteTenantResource(
+	ctx context.Context,
+	tenant *coralogixv1alpha1.CoralogixTenant,
+	obj client.Object,
+	name string,
+) error {
+	if err := config.GetClient().Get(ctx, client.ObjectKey{Namespace: tenant.Namespace, Name: name}, obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !metav1.IsControlledBy(obj, tenant) {
+		return nil
+	}
+	if err := config.GetClient().Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("error deleting %s: %w", name, err)
+	}
+	return nil
+}
+
+func tenantResourceStatus(kind string, obj coralogix.Object, id *string) coralogixv1alpha1.CoralogixTenantResourceStatus {
+	status := coralogixv1alpha1.CoralogixTenantResourceStatus{
+		Kind:            kind,
+		Name:            obj.GetName(),
+		ID:              id,
+		PrintableStatus: obj.GetPrintableStatus(),
+	}
+	if status.PrintableStatus == "" {
+		status.PrintableStatus = tenantPendingStatus
+	}
+	if condition := meta.FindStatusCondition(obj.GetConditions(), utils.ConditionTypeRemoteSynced); condition != nil &&
+		condition.Status != metav1.ConditionTrue {
+		status.Message = condition.Message
+	}
+	return status
+}
+
+// updateStatus aggregates the statuses of the tenant resources into the RemoteSynced condition of the tenant, and
+// returns the delay until its next periodic reconciliation.
+func (r *CoralogixTenantReconciler) updateStatus(
+	ctx context.Context,
+	tenant *coralogixv1alpha1.CoralogixTenant,
+	resources []coralogixv1alpha1.CoralogixTenantResourceStatus,
+	syncErr error,
+) (time.Duration, error) {
+	var unsynced []string
+	if syncErr != nil {
+		unsynced = append(unsynced, syncErr.Error())
+	}
+	for _, resource := range resources {
+		if resource.PrintableStatus != "RemoteSynced" {
+			message := fmt.Sprintf("%s %s is %s", resource.Kind, resource.Name, resource.PrintableStatus)
+			if resource.Message != "" {
+				message += ": " + resource.Message
+			}
+			unsynced = append(unsynced, message)
+		}
+	}
+
+	status := coralogixv1alpha1.CoralogixTenantStatus{
+		Conditions:             append([]metav1.Condition(nil), tenant.Status.Conditions...),
+		Resources:              resources,
+		LastHandledReconcileAt: utils.HandledReconcileRequest(tenant, tenant.Status.LastHandledReconcileAt),
+	}
+	if len(unsynced) > 0 {
+		utils.SetSyncedConditionFalse(&status.Conditions, tenant.Generation, utils.ReasonChildResourcesUnsynced,
+			strings.Join(unsynced, "; "))
+		status.PrintableStatus = "RemoteUnsynced"
+	} else {
+		utils.SetSyncedConditionTrue(&status.Conditions, tenant.Generation, utils.ReasonRemoteSyncedSuccessfully)
+		status.PrintableStatus = "RemoteSynced"
+	}
+
+	// Failed syncs are retried with backoff instead of the periodic reconciliation.
+	var requeueAfter time.Duration
+	if syncErr == nil {
+		status.NextReconcileAt, requeueAfter = coralogixreconciler.NextReconcile(tenant, tenant.Status.NextReconcileAt, r.Interval)
+	}
+
+	if reflect.DeepEqual(tenant.Status, status) {
+		return requeueAfter, nil
+	}
+	tenant.Status = status
+	return requeueAfter, config.GetClient().Status().Update(ctx, tenant)
+}
+
+func tenantResourceName(tenant *coralogixv1alpha1.CoralogixTenant, suffix string) string {
+	return tenant.Name + "-" + suffix
+}
+
+func tenantObjectMeta(tenant *coralogixv1alpha1.CoralogixTenant, suffix string) metav1.ObjectMeta {
+	// Resources inherit the labels of the tenant, so that they match the same selector.
+	return metav1.ObjectMeta{
+		Name:      tenantResourceName(tenant, suffix),
+		Namespace: tenant.Namespace,
+		Labels:    maps.Clone(tenant.Labels),
+	}
+}
+
+func tenantDescription(tenant *coralogixv1alpha1.CoralogixTenant) string {
+	if tenant.Spec.Description != nil {
+		return *tenant.Spec.Description
+	}
+	return fmt.Sprintf("Managed by CoralogixTenant %s/%s", tenant.Namespace, tenant.Name)
+}
+
+func renderTenantScope(tenant *coralogixv1alpha1.CoralogixTenant) *coralogixv1alpha1.Scope {
+	filters := tenant.Spec.Filters
+	if filters == nil {
+		return nil
+	}
+
+	var clauses []string
+	if len(filters.Applications) > 0 {
+		clauses = append(clauses, scopeFieldExpression("applicationName", filters.Applications))
+	}
+	if len(filters.Subsystems) > 0 {
+		clauses = append(clauses, scopeFieldExpression("subsystemName", filters.Subsystems))
+	}
+	expression := "<v1>" + strings.Join(clauses, " && ")
+
+	return &coralogixv1alpha1.Scope{
+		ObjectMeta: tenantObjectMeta(tenant, "scope"),
+		Spec: coralogixv1alpha1.ScopeSpec{
+			Name:        tenant.Spec.TeamName,
+			Description: ptr.To(tenantDescription(tenant)),
+			Filters: []coralogixv1alpha1.ScopeFilter{
+				{EntityType: "logs", Expression: expression},
+				{EntityType: "spans", Expression: expression},
+			},
+			DefaultExpression: "<v1>false",
+		},
+	}
+}
+
+// scopeFieldExpression returns an expression matching any of the values of the field.
+func scopeFieldExpression(field string, values []string) string {
+	terms := make([]string, 0, len(values))
+	for _, value := range values {
+		terms = append(terms, fmt.Sprintf("%s == '%s'", field, strings.ReplaceAll(value, "'", `\'`)))
+	}
+	return "(" + strings.Join(terms, " || ") + ")"
+}
+
+func renderTenantCustomRole(tenant *coralogixv1alpha1.CoralogixTenant) *coralogixv1alpha1.CustomRole {
+	return &coralogixv1alpha1.CustomRole{
+		ObjectMeta: tenantObjectMeta(tenant, "role"),
+		Spec: coralogixv1alpha1.CustomRoleSpec{
+			Name:           tenant.Spec.TeamName,
+			Description:    tenantDescription(tenant),
+			ParentRoleName: tenant.Spec.Role.ParentRoleName,
+			Permissions:    tenant.Spec.Role.Permissions,
+		},
+	}
+}
+
+func renderTenantGroup(tenant *coralogixv1alpha1.CoralogixTenant, withScope bool) *coralogixv1alpha1.Group {
+	group := &coralogixv1alpha1.Group{
+		ObjectMeta: tenantObjectMeta(tenant, "group"),
+		Spec: coralogixv1alpha1.GroupSpec{
+			Name:        tenant.Spec.TeamName,
+			Description: ptr.To(tenantDescription(tenant)),
+			Members:     tenant.Spec.Members,
+			CustomRole: &coralogixv1alpha1.GroupCustomRole{
+				ResourceRef: coralogixv1alpha1.ResourceRef{Name: tenantResourceName(tenant, "role")},
+			},
+		},
+	}
+	if withScope {
+		group.Spec.Scope = &coralogixv1alpha1.GroupScope{
+			ResourceRef: coralogixv1alpha1.ResourceRef{Name: tenantResourceName(tenant, "scope")},
+		}
+	}
+	return group
+}
+
+func renderTenantApiKey(tenant *coralogixv1alpha1.CoralogixTenant) *coralogixv1alpha1.ApiKey {
+	apiKey := tenant.Spec.ApiKey
+	if apiKey == nil {
+		return nil
+	}
+
+	return &coralogixv1alpha1.ApiKey{
+		ObjectMeta: tenantObjectMeta(tenant, "api-key"),
+		Spec: coralogixv1alpha1.ApiKeySpec{
+			Name:        tenant.Spec.TeamName,
+			Active:      true,
+			Owner:       coralogixv1alpha1.ApiKeyOwner{TeamId: ptr.To(apiKey.TeamId)},
+			Presets:     apiKey.Presets,
+			Permissions: apiKey.Permissions,
+		},
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CoralogixTenantReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&coralogixv1alpha1.CoralogixTenant{}).
+		Owns(&coralogixv1alpha1.Scope{}).
+		Owns(&coralogixv1alpha1.CustomRole{}).
+		Owns(&coralogixv1alpha1.Group{}).
+		Owns(&coralogixv1alpha1.ApiKey{}).
+		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
+		Complete(r)
+}
@@ -0,0 +1,215 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix"
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+func TestCoralogixTenantReconcile(t *testing.T) {
+	tenant := &coralogixv1alpha1.CoralogixTenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "checkout",
+			Namespace: "teams",
+			UID:       types.UID("tenant-uid"),
+			Labels:    map[string]string{"team": "checkout"},
+		},
+		Spec: coralogixv1alpha1.CoralogixTenantSpec{
+			TeamName: "Checkout",
+			Filters: &coralogixv1alpha1.CoralogixTenantFilters{
+				Applications: []string{"checkout"},
+				Subsystems:   []string{"payments", "o'rders"},
+			},
+			Role: coralogixv1alpha1.CoralogixTenantRole{
+				ParentRoleName: "Standard User",
+				Permissions:    []string{"team-actions:UpdateConfig"},
+			},
+			Members: []coralogixv1alpha1.Member{{UserName: "example@coralogix.com"}},
+			ApiKey: &coralogixv1alpha1.CoralogixTenantApiKey{
+				TeamId:  4013254,
+				Presets: []string{"SendData"},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	reconcileTenant := setupCoralogixTenantTest(t, tenant)
+
+	// Creating the Scope, CustomRole and ApiKey, and waiting with the Group.
+	reconcileTenant()
+
+	scope := &coralogixv1alpha1.Scope{}
+	require.NoError(t, config.GetClient().Get(ctx, types.NamespacedName{Namespace: "teams", Name: "checkout-scope"}, scope))
+	require.Equal(t, "Checkout", scope.Spec.Name)
+	require.Equal(t, "checkout", scope.Labels["team"])
+	require.True(t, metav1.IsControlledBy(scope, tenant))
+	require.Equal(t, []coralogixv1alpha1.ScopeFilter{
+		{EntityType: "logs", Expression: `<v1>(applicationName == 'checkout') && (subsystemName == 'payments' || subsystemName == 'o\'rders')`},
+		{EntityType: "spans", Expression: `<v1>(applicationName == 'checkout') && (subsystemName == 'payments' || subsystemName == 'o\'rders')`},
+	}, scope.Spec.Filters)
+
+	role := &coralogixv1alpha1.CustomRole{}
+	require.NoError(t, config.GetClient().Get(ctx, types.NamespacedName{Namespace: "teams", Name: "checkout-role"}, role))
+	require.Equal(t, "Standard User", role.Spec.ParentRoleName)
+
+	apiKey := &coralogixv1alpha1.ApiKey{}
+	require.NoError(t, config.GetClient().Get(ctx, types.NamespacedName{Namespace: "teams", Name: "checkout-api-key"}, apiKey))
+	require.Equal(t, ptr.To(uint32(4013254)), apiKey.Spec.Owner.TeamId)
+
+	group := &coralogixv1alpha1.Group{}
+	err := config.GetClient().Get(ctx, types.NamespacedName{Namespace: "teams", Name: "checkout-group"}, group)
+	require.True(t, k8serrors.IsNotFound(err))
+
+	fetched := getCoralogixTenant(t, tenant)
+	require.Equal(t, "RemoteUnsynced", fetched.Status.PrintableStatus)
+	require.Len(t, fetched.Status.Resources, 4)
+	require.Equal(t, coralogixv1alpha1.CoralogixTenantResourceStatus{
+		Kind:            utils.GroupKind,
+		Name:            "checkout-group",
+		PrintableStatus: "Pending",
+		Message:         "waiting for the Scope and CustomRole to be synced",
+	}, fetched.Status.Resources[2])
+
+	// Creating the Group once the Scope and CustomRole are synced.
+	scope.Status.ID = ptr.To("scope-id")
+	markTenantResourceSynced(t, scope)
+	role.Status.ID = ptr.To("1234")
+	markTenantResourceSynced(t, role)
+	reconcileTenant()
+
+	require.NoError(t, config.GetClient().Get(ctx, types.NamespacedName{Namespace: "teams", Name: "checkout-group"}, group))
+	require.Equal(t, "checkout-scope", group.Spec.Scope.ResourceRef.Name)
+	require.Equal(t, "checkout-role", group.Spec.CustomRole.ResourceRef.Name)
+	require.Equal(t, tenant.Spec.Members, group.Spec.Members)
+
+	// Becoming synced once all the resources are synced.
+	group.Status.ID = ptr.To("5678")
+	markTenantResourceSynced(t, group)
+	apiKey.Status.Id = ptr.To("api-key-id")
+	markTenantResourceSynced(t, apiKey)
+	reconcileTenant()
+
+	fetched = getCoralogixTenant(t, tenant)
+	require.Equal(t, "RemoteSynced", fetched.Status.PrintableStatus)
+	require.True(t, meta.IsStatusConditionTrue(fetched.Status.Conditions, utils.ConditionTypeRemoteSynced))
+	require.Equal(t, ptr.To("5678"), fetched.Status.Resources[2].ID)
+
+	// Deleting the Scope and ApiKey once they are removed from the spec.
+	fetched.Spec.Filters = nil
+	fetched.Spec.ApiKey = nil
+	require.NoError(t, config.GetClient().Update(ctx, fetched))
+	reconcileTenant()
+
+	err = config.GetClient().Get(ctx, types.NamespacedName{Namespace: "teams", Name: "checkout-scope"}, &coralogixv1alpha1.Scope{})
+	require.True(t, k8serrors.IsNotFound(err))
+	err = config.GetClient().Get(ctx, types.NamespacedName{Namespace: "teams", Name: "checkout-api-key"}, &coralogixv1alpha1.ApiKey{})
+	require.True(t, k8serrors.IsNotFound(err))
+	require.NoError(t, config.GetClient().Get(ctx, types.NamespacedName{Namespace: "teams", Name: "checkout-group"}, group))
+	require.Nil(t, group.Spec.Scope)
+	require.Len(t, getCoralogixTenant(t, tenant).Status.Resources, 2)
+}
+
+func TestCoralogixTenantReconcileRefusesUnmanagedResources(t *testing.T) {
+	tenant := &coralogixv1alpha1.CoralogixTenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "teams", UID: types.UID("tenant-uid")},
+		Spec: coralogixv1alpha1.CoralogixTenantSpec{
+			TeamName: "Checkout",
+			Role: coralogixv1alpha1.CoralogixTenantRole{
+				ParentRoleName: "Standard User",
+				Permissions:    []string{"team-actions:UpdateConfig"},
+			},
+		},
+	}
+	unmanaged := &coralogixv1alpha1.CustomRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkout-role", Namespace: "teams"},
+		Spec:       coralogixv1alpha1.CustomRoleSpec{Name: "unmanaged"},
+	}
+
+	setupCoralogixTenantTest(t, tenant, unmanaged)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tenant)}
+	_, err := (&CoralogixTenantReconciler{}).Reconcile(context.Background(), req)
+	require.ErrorContains(t, err, "checkout-role already exists and is not managed by CoralogixTenant checkout")
+
+	role := &coralogixv1alpha1.CustomRole{}
+	require.NoError(t, config.GetClient().Get(context.Background(), client.ObjectKeyFromObject(unmanaged), role))
+	require.Equal(t, "unmanaged", role.Spec.Name)
+
+	synced := meta.FindStatusCondition(getCoralogixTenant(t, tenant).Status.Conditions, utils.ConditionTypeRemoteSynced)
+	require.NotNil(t, synced)
+	require.Equal(t, metav1.ConditionFalse, synced.Status)
+	require.Equal(t, utils.ReasonChildResourcesUnsynced, synced.Reason)
+}
+
+func setupCoralogixTenantTest(t *testing.T, objects ...client.Object) func() {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+
+	originalClient := config.GetClient()
+	originalSelector := config.GetConfig().Selector
+	t.Cleanup(func() {
+		config.InitClient(originalClient)
+		config.GetConfig().Selector = originalSelector
+	})
+
+	config.InitClient(fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(
+			&coralogixv1alpha1.CoralogixTenant{},
+			&coralogixv1alpha1.Scope{},
+			&coralogixv1alpha1.CustomRole{},
+			&coralogixv1alpha1.Group{},
+			&coralogixv1alpha1.ApiKey{},
+		).
+		Build())
+	config.GetConfig().Selector = config.Selector{}
+
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(objects[0])}
+	return func() {
+		_, err := (&CoralogixTenantReconciler{}).Reconcile(context.Background(), req)
+		require.NoError(t, err)
+	}
+}
+
+func markTenantResourceSynced(t *testing.T, obj coralogix.Object) {
+	conditions := obj.GetConditions()
+	utils.SetSyncedConditionTrue(&conditions, obj.GetGeneration(), utils.ReasonRemoteSyncedSuccessfully)
+	obj.SetConditions(conditions)
+	obj.SetPrintableStatus("RemoteSynced")
+	require.NoError(t, config.GetClient().Status().Update(context.Background(), obj))
+}
+
+func getCoralogixTenant(t *testing.T, tenant *coralogixv1alpha1.CoralogixTenant) *coralogixv1alpha1.CoralogixTenant {
+	fetched := &coralogixv1alpha1.CoralogixTenant{}
+	require.NoError(t, config.GetClient().Get(context.Background(), client.ObjectKeyFromObject(tenant), fetched))
+	return fetched
+}
@@ -17,13 +17,26 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"maps"
+	"reflect"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/coralogix/coralogix-management-sdk/go/openapi/cxsdk"
 	customenrichments "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/custom_enrichments_service"
@@ -38,11 +51,19 @@ import (
 type CustomEnrichmentReconciler struct {
 	CustomEnrichmentsClient *customenrichments.CustomEnrichmentsServiceAPIService
 	Interval                time.Duration
+	// EnableGenerator watches the pods, nodes and namespaces of the cluster to generate the CSV data of the
+	// CustomEnrichments with a generator. CustomEnrichments with a generator fail to sync when it is disabled.
+	EnableGenerator bool
 }
 
+// defaultCustomEnrichmentDebounce is the debounce of generators without one, which the API server defaults otherwise.
+const defaultCustomEnrichmentDebounce = 30 * time.Second
+
 // +kubebuilder:rbac:groups=coralogix.com,resources=customenrichments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=coralogix.com,resources=customenrichments/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=coralogix.com,resources=customenrichments/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods;nodes;namespaces,verbs=get;list;watch
 
 func (r *CustomEnrichmentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	return coralogixreconciler.ReconcileResource(ctx, req, &coralogixv1alpha1.CustomEnrichment{}, r)
@@ -58,12 +79,16 @@ func (r *CustomEnrichmentReconciler) RequeueInterval() time.Duration {
 
 func (r *CustomEnrichmentReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
 	customEnrichment := obj.(*coralogixv1alpha1.CustomEnrichment)
+	if err := r.checkGenerator(customEnrichment); err != nil {
+		return err
+	}
 	createRequest, err := customEnrichment.ExtractCreateCustomEnrichmentRequest(ctx)
 	if err != nil {
 		return fmt.Errorf("error on extracting create request from customEnrichment spec: %w", err)
 	}
 
-	log.Info("Creating remote customEnrichment", "customEnrichment", utils.FormatJSON(createRequest))
+	log.Info("Creating remote customEnrichment",
+		customEnrichmentLogValues(createRequest.Name, createRequest.Description, *createRequest.File.Textual)...)
 	createResponse, httpResp, err := r.CustomEnrichmentsClient.
 		CustomEnrichmentServiceCreateCustomEnrichment(ctx).
 		CreateCustomEnrichmentRequest(*createRequest).
@@ -74,7 +99,12 @@ func (r *CustomEnrichmentReconciler) HandleCreation(ctx context.Context, log log
 	log.Info("Remote customEnrichment created", "response", utils.FormatJSON(createResponse))
 
 	customEnrichment.Status = coralogixv1alpha1.CustomEnrichmentStatus{
-		Id: ptr.To(strconv.Itoa(int(*createResponse.CustomEnrichment.Id))),
+		Id:       ptr.To(strconv.Itoa(int(*createResponse.CustomEnrichment.Id))),
+		RowCount: coralogixv1alpha1.CustomEnrichmentRowCount(*createRequest.File.Textual),
+	}
+	if customEnrichment.Spec.Generator != nil {
+		customEnrichment.Status.ContentHash = coralogixv1alpha1.CustomEnrichmentContentHash(
+			createRequest.Name, createRequest.Description, *createRequest.File.Textual)
 	}
 
 	return nil
@@ -82,12 +112,28 @@ func (r *CustomEnrichmentReconciler) HandleCreation(ctx context.Context, log log
 
 func (r *CustomEnrichmentReconciler) HandleUpdate(ctx context.Context, log logr.Logger, obj client.Object) error {
 	customEnrichment := obj.(*coralogixv1alpha1.CustomEnrichment)
+	if err := r.checkGenerator(customEnrichment); err != nil {
+		return err
+	}
 	updateRequest, err := customEnrichment.ExtractUpdateCustomEnrichmentRequest(ctx)
 	if err != nil {
 		return fmt.Errorf("error on extracting update request from customEnrichment spec: %w", err)
 	}
 
-	log.Info("Updating remote customEnrichment", "customEnrichment", utils.FormatJSON(updateRequest))
+	// Generators are reconciled on every change of the objects they are generated from, most of which do not change
+	// the generated CSV, so the remote custom enrichment is only updated when it does, or when requested.
+	var contentHash string
+	if customEnrichment.Spec.Generator != nil {
+		contentHash = coralogixv1alpha1.CustomEnrichmentContentHash(
+			updateRequest.Name, updateRequest.Description, *updateRequest.File.Textual)
+		if contentHash == customEnrichment.Status.ContentHash && !coralogixreconciler.ReconcileRequested(customEnrichment) {
+			log.Info("Generated customEnrichment unchanged; skipping remote update")
+			return nil
+		}
+	}
+
+	log.Info("Updating remote customEnrichment",
+		customEnrichmentLogValues(updateRequest.Name, updateRequest.Description, *updateRequest.File.Textual)...)
 	updateResponse, httpResp, err := r.CustomEnrichmentsClient.
 		CustomEnrichmentServiceUpdateCustomEnrichment(ctx).
 		UpdateCustomEnrichmentRequest(*updateRequest).
@@ -98,6 +144,30 @@ func (r *CustomEnrichmentReconciler) HandleUpdate(ctx context.Context, log logr.
 	}
 	log.Info("Remote customEnrichment updated", "customEnrichment", utils.FormatJSON(updateResponse))
 
+	rowCount := coralogixv1alpha1.CustomEnrichmentRowCount(*updateRequest.File.Textual)
+	if customEnrichment.Status.ContentHash != contentHash || !reflect.DeepEqual(customEnrichment.Status.RowCount, rowCount) {
+		customEnrichment.Status.ContentHash = contentHash
+		customEnrichment.Status.RowCount = rowCount
+		if err := config.GetClient().Status().Update(ctx, customEnrichment); err != nil {
+			return fmt.Errorf("error on updating customEnrichment status: %w", err)
+		}
+	}
+	return nil
+}
+
+// customEnrichmentLogValues describes a request without its CSV data, which may be read from Secrets.
+func customEnrichmentLogValues(name, description, content string) []any {
+	return []any{
+		"name", name,
+		"rowCount", ptr.Deref(coralogixv1alpha1.CustomEnrichmentRowCount(content), 0),
+		"contentHash", coralogixv1alpha1.CustomEnrichmentContentHash(name, description, content),
+	}
+}
+
+func (r *CustomEnrichmentReconciler) checkGenerator(customEnrichment *coralogixv1alpha1.CustomEnrichment) error {
+	if customEnrichment.Spec.Generator != nil && !r.EnableGenerator {
+		return fmt.Errorf("customEnrichment generators require the operator to run with -custom-enrichment-generator")
+	}
 	return nil
 }
 
@@ -121,10 +191,75 @@ func (r *CustomEnrichmentReconciler) HandleDeletion(ctx context.Context, log log
 	return nil
 }
 
+// enqueueCustomEnrichmentGenerators returns a handler enqueueing the selected CustomEnrichments generated from the
+// resources once their debounce elapsed. Requests already waiting are not delayed further, so the changes made
+// meanwhile are folded into a single regeneration.
+func enqueueCustomEnrichmentGenerators(resources ...coralogixv1alpha1.CustomEnrichmentGeneratorResource) handler.EventHandler {
+	enqueue := func(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+		var customEnrichmentList coralogixv1alpha1.CustomEnrichmentList
+		if err := config.GetClient().List(ctx, &customEnrichmentList); err != nil {
+			log.FromContext(ctx).Error(err, "Error listing CustomEnrichments")
+			return
+		}
+		for _, customEnrichment := range customEnrichmentList.Items {
+			generator := customEnrichment.Spec.Generator
+			if generator == nil || !slices.Contains(resources, generator.Resource) ||
+				!config.GetConfig().Selector.Matches(customEnrichment.Labels, customEnrichment.Namespace) {
+				continue
+			}
+			debounce := ptr.Deref(generator.Debounce, metav1.Duration{Duration: defaultCustomEnrichmentDebounce})
+			q.AddAfter(reconcile.Request{NamespacedName: types.NamespacedName{
+				Namespace: customEnrichment.Namespace,
+				Name:      customEnrichment.Name,
+			}}, debounce.Duration)
+		}
+	}
+
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, _ event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, q)
+		},
+		UpdateFunc: func(ctx context.Context, _ event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, q)
+		},
+		DeleteFunc: func(ctx context.Context, _ event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, q)
+		},
+	}
+}
+
+// generatedObjectChanged filters out the updates of pods and nodes which do not change the columns a CSV can be
+// generated from, like the status updates of their conditions.
+func generatedObjectChanged(e event.UpdateEvent) bool {
+	if !maps.Equal(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels()) ||
+		!maps.Equal(e.ObjectOld.GetAnnotations(), e.ObjectNew.GetAnnotations()) {
+		return true
+	}
+	switch newObj := e.ObjectNew.(type) {
+	case *corev1.Pod:
+		oldObj, ok := e.ObjectOld.(*corev1.Pod)
+		return !ok || oldObj.Status.PodIP != newObj.Status.PodIP || oldObj.Status.HostIP != newObj.Status.HostIP ||
+			oldObj.Status.Phase != newObj.Status.Phase || oldObj.Spec.NodeName != newObj.Spec.NodeName
+	case *corev1.Node:
+		oldObj, ok := e.ObjectOld.(*corev1.Node)
+		return !ok || !reflect.DeepEqual(oldObj.Status.Addresses, newObj.Status.Addresses)
+	}
+	return false
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *CustomEnrichmentReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&coralogixv1alpha1.CustomEnrichment{}).
-		WithEventFilter(config.GetConfig().Selector.Predicate()).
-		Complete(r)
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&coralogixv1alpha1.CustomEnrichment{}, builder.WithPredicates(config.GetConfig().Selector.Predicate(), coralogixreconciler.IgnoreInformationalStatusUpdates()))
+	if r.EnableGenerator {
+		changed := builder.WithPredicates(predicate.Funcs{UpdateFunc: generatedObjectChanged})
+		b = b.
+			Watches(&corev1.Pod{}, enqueueCustomEnrichmentGenerators(coralogixv1alpha1.CustomEnrichmentGeneratorResourcePods), changed).
+			Watches(&corev1.Node{}, enqueueCustomEnrichmentGenerators(coralogixv1alpha1.CustomEnrichmentGeneratorResourceNodes), changed).
+			// Pods are filtered by the labels of their namespace, and can have columns of its labels and annotations.
+			Watches(&corev1.Namespace{}, enqueueCustomEnrichmentGenerators(
+				coralogixv1alpha1.CustomEnrichmentGeneratorResourceNamespaces,
+				coralogixv1alpha1.CustomEnrichmentGeneratorResourcePods), changed)
+	}
+	return b.Complete(r)
 }
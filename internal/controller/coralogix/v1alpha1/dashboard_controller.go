@@ -17,6 +17,7 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -24,6 +25,9 @@ import (
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/coralogix/coralogix-management-sdk/go/openapi/cxsdk"
 	dashboards "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/dashboard_service"
@@ -31,6 +35,7 @@ import (
 	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
 	"github.com/coralogix/coralogix-operator/v2/internal/config"
 	coralogixreconciler "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
+	"github.com/coralogix/coralogix-operator/v2/internal/provenance"
 	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 )
 
@@ -59,10 +64,15 @@ func (r *DashboardReconciler) RequeueInterval() time.Duration {
 
 func (r *DashboardReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
 	dashboard := obj.(*coralogixv1alpha1.Dashboard)
-	dashboardToCreate, err := dashboard.Spec.ExtractDashboardFromSpec(ctx, dashboard.Namespace)
+	extracted, err := dashboard.Spec.ExtractDashboardContentFromSpec(ctx, dashboard.Namespace)
 	if err != nil {
 		return fmt.Errorf("error on extracting dashboard from spec: %w", err)
 	}
+	if len(extracted.UnsupportedPanels) > 0 {
+		log.Info("Grafana dashboard converted with unsupported panels", "unsupportedPanels", extracted.UnsupportedPanels)
+	}
+	dashboardToCreate := extracted.Dashboard
+	stampDashboardProvenance(dashboard, dashboardToCreate)
 	// The import annotation only adopts the remote dashboard once. Once adopted,
 	// status.imported gates it, and that marker persists across status.id being cleared on a
 	// remote NotFound (see coralogix_reconciler.go), so a subsequent recreation goes through the
@@ -78,13 +88,18 @@ func (r *DashboardReconciler) HandleCreation(ctx context.Context, log logr.Logge
 	imported := dashboard.Status.Imported
 	if importID != "" && !imported {
 		log.Info("Import annotation present, adopting existing remote dashboard", "id", importID)
-		_, httpResp, err := r.DashboardsClient.DashboardsServiceGetDashboard(ctx, importID).Execute()
+		getResponse, httpResp, err := r.DashboardsClient.DashboardsServiceGetDashboard(ctx, importID).Execute()
 		if err != nil {
 			return fmt.Errorf("error on getting remote dashboard %q for import: %w", importID, cxsdk.NewAPIError(httpResp, err))
 		}
+		if err = checkDashboardProvenance(dashboard, getResponse); err != nil {
+			return fmt.Errorf("error on importing remote dashboard %q: %w", importID, err)
+		}
 		dashboard.Status = coralogixv1alpha1.DashboardStatus{
-			ID:       ptr.To(importID),
-			Imported: true,
+			ID:                ptr.To(importID),
+			Imported:          true,
+			UnsupportedPanels: extracted.UnsupportedPanels,
+			TemplateRevision:  extracted.TemplateRevision,
 		}
 		return nil
 	}
@@ -101,8 +116,10 @@ func (r *DashboardReconciler) HandleCreation(ctx context.Context, log logr.Logge
 	log.Info("Remote dashboard created", "dashboard", utils.FormatJSON(createResponse))
 
 	dashboard.Status = coralogixv1alpha1.DashboardStatus{
-		ID:       createResponse.DashboardId,
-		Imported: imported,
+		ID:                createResponse.DashboardId,
+		Imported:          imported,
+		UnsupportedPanels: extracted.UnsupportedPanels,
+		TemplateRevision:  extracted.TemplateRevision,
 	}
 
 	return nil
@@ -142,14 +159,25 @@ func validateNoEmbeddedIDWithImport(importID string, dashboard *dashboards.Dashb
 
 func (r *DashboardReconciler) HandleUpdate(ctx context.Context, log logr.Logger, obj client.Object) error {
 	dashboard := obj.(*coralogixv1alpha1.Dashboard)
-	dashboardToUpdate, err := dashboard.Spec.ExtractDashboardFromSpec(ctx, dashboard.Namespace)
+	extracted, err := dashboard.Spec.ExtractDashboardContentFromSpec(ctx, dashboard.Namespace)
 	if err != nil {
 		return fmt.Errorf("error on extracting dashboard from spec: %w", err)
 	}
+	dashboardToUpdate := extracted.Dashboard
 	if err = validateNoEmbeddedIDWithImport(importDashboardID(dashboard), dashboardToUpdate); err != nil {
 		return err
 	}
+	stampDashboardProvenance(dashboard, dashboardToUpdate)
 	dashboardToUpdate.Id = dashboard.Status.ID
+	if provenance.Enabled() {
+		getResponse, httpResp, err := r.DashboardsClient.DashboardsServiceGetDashboard(ctx, *dashboard.Status.ID).Execute()
+		if err != nil {
+			return cxsdk.NewAPIError(httpResp, err)
+		}
+		if err = checkDashboardProvenance(dashboard, getResponse); err != nil {
+			return err
+		}
+	}
 	updateRequest := newReplaceDashboardRequest(dashboard, *dashboardToUpdate)
 	log.Info("Updating remote dashboard", "dashboard", utils.FormatJSON(updateRequest))
 	updateResponse, httpResp, err := r.DashboardsClient.
@@ -161,6 +189,18 @@ func (r *DashboardReconciler) HandleUpdate(ctx context.Context, log logr.Logger,
 	}
 	log.Info("Remote dashboard updated", "dashboard", utils.FormatJSON(updateResponse))
 
+	if !reflect.DeepEqual(dashboard.Status.UnsupportedPanels, extracted.UnsupportedPanels) ||
+		dashboard.Status.TemplateRevision != extracted.TemplateRevision {
+		if len(extracted.UnsupportedPanels) > 0 {
+			log.Info("Grafana dashboard converted with unsupported panels", "unsupportedPanels", extracted.UnsupportedPanels)
+		}
+		dashboard.Status.UnsupportedPanels = extracted.UnsupportedPanels
+		dashboard.Status.TemplateRevision = extracted.TemplateRevision
+		if err = config.GetClient().Status().Update(ctx, dashboard); err != nil {
+			return fmt.Errorf("error on updating dashboard status: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -175,6 +215,19 @@ func newReplaceDashboardRequest(dashboard *coralogixv1alpha1.Dashboard, dashboar
 func (r *DashboardReconciler) HandleDeletion(ctx context.Context, log logr.Logger, obj client.Object) error {
 	dashboard := obj.(*coralogixv1alpha1.Dashboard)
 	id := *dashboard.Status.ID
+	if provenance.Enabled() {
+		getResponse, httpResp, err := r.DashboardsClient.DashboardsServiceGetDashboard(ctx, id).Execute()
+		if err != nil {
+			if apiErr := cxsdk.NewAPIError(httpResp, err); !cxsdk.IsNotFound(apiErr) {
+				return fmt.Errorf("error getting remote dashboard %s: %w", id, apiErr)
+			}
+			return nil
+		}
+		if err = checkDashboardProvenance(dashboard, getResponse); err != nil {
+			log.Info("Skipping deletion of remote dashboard managed by another resource", "id", id, "reason", err.Error())
+			return nil
+		}
+	}
 	log.Info("Deleting dashboard from remote system", "id", id)
 	_, httpResp, err := r.DashboardsClient.DashboardsServiceDeleteDashboard(ctx, id).Execute()
 	if err != nil {
@@ -186,10 +239,42 @@ func (r *DashboardReconciler) HandleDeletion(ctx context.Context, log logr.Logge
 	return nil
 }
 
+// Dashboards have no labels, so their provenance is written into their description.
+func stampDashboardProvenance(dashboard *coralogixv1alpha1.Dashboard, remote *dashboards.Dashboard) {
+	if provenance.Enabled() {
+		remote.Description = ptr.To(provenance.WithDescription(ptr.Deref(remote.Description, ""), dashboard))
+	}
+}
+
+func checkDashboardProvenance(dashboard *coralogixv1alpha1.Dashboard, getResponse *dashboards.GetDashboardResponse) error {
+	remote := getResponse.GetDashboard()
+	return provenance.Check(dashboard, provenance.FromDescription(remote.GetDescription()))
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *DashboardReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.Dashboard{}).
+		Watches(&coralogixv1alpha1.DashboardTemplate{}, handler.EnqueueRequestsFromMapFunc(enqueueDashboardsForTemplate)).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
+
+// enqueueDashboardsForTemplate enqueues the Dashboards rendered from a DashboardTemplate, so that its changes are
+// rolled out without waiting for the requeue interval.
+func enqueueDashboardsForTemplate(ctx context.Context, template client.Object) []reconcile.Request {
+	var dashboardList coralogixv1alpha1.DashboardList
+	if err := config.GetClient().List(ctx, &dashboardList, client.InNamespace(template.GetNamespace())); err != nil {
+		ctrllog.FromContext(ctx).Error(err, "Error listing Dashboards rendered from the DashboardTemplate")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, dashboard := range dashboardList.Items {
+		if templateRef := dashboard.Spec.TemplateRef; templateRef != nil && templateRef.Name == template.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&dashboard)})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,249 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	coralogixreconciler "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// DashboardTemplateReconciler reconciles a DashboardTemplate object into the Dashboards of its generator.
+type DashboardTemplateReconciler struct {
+	Interval time.Duration
+}
+
+// +kubebuilder:rbac:groups=coralogix.com,resources=dashboardtemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=coralogix.com,resources=dashboardtemplates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=coralogix.com,resources=dashboards,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+func (r *DashboardTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	template := &coralogixv1alpha1.DashboardTemplate{}
+	if err := config.GetClient().Get(ctx, req.NamespacedName, template); err != nil {
+		// Generated Dashboards are garbage collected via their owner references.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	revision, err := template.Spec.Revision(ctx, template.Namespace)
+	if err != nil {
+		log.Error(err, "Received an error while reading the DashboardTemplate")
+		return ctrl.Result{}, err
+	}
+
+	generated, syncErr := syncGeneratedDashboards(ctx, log, template)
+	if syncErr != nil {
+		log.Error(syncErr, "Received an error while generating Dashboards from the DashboardTemplate")
+	}
+
+	status := coralogixv1alpha1.DashboardTemplateStatus{
+		Revision:               revision,
+		GeneratedDashboards:    generated,
+		LastHandledReconcileAt: utils.HandledReconcileRequest(template, template.Status.LastHandledReconcileAt),
+	}
+	// Failed syncs are retried with backoff instead of the periodic reconciliation.
+	var requeueAfter time.Duration
+	if syncErr == nil {
+		status.NextReconcileAt, requeueAfter = coralogixreconciler.NextReconcile(template, template.Status.NextReconcileAt, r.Interval)
+	}
+	if !reflect.DeepEqual(template.Status, status) {
+		template.Status = status
+		if err := config.GetClient().Status().Update(ctx, template); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error on updating DashboardTemplate status: %w", err)
+		}
+	}
+	if syncErr != nil {
+		return ctrl.Result{}, syncErr
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// syncGeneratedDashboards creates and updates the Dashboards of the selected namespaces, deletes the ones of the
+// namespaces no longer selected, and returns the names of the generated Dashboards.
+func syncGeneratedDashboards(ctx context.Context, log logr.Logger, template *coralogixv1alpha1.DashboardTemplate) ([]string, error) {
+	desiredDashboards, err := desiredGeneratedDashboards(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	generated := make([]string, 0, len(desiredDashboards))
+	for _, desired := range desiredDashboards {
+		if err := applyGeneratedDashboard(ctx, log, template, desired); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		generated = append(generated, desired.Name)
+	}
+
+	var dashboardList coralogixv1alpha1.DashboardList
+	if err := config.GetClient().List(ctx, &dashboardList,
+		client.InNamespace(template.Namespace),
+		client.MatchingLabels{coralogixv1alpha1.DashboardTemplateLabelKey: ownerLabelValue(template.Name)}); err != nil {
+		return nil, fmt.Errorf("error listing Dashboards generated from the DashboardTemplate: %w", err)
+	}
+	for _, dashboard := range dashboardList.Items {
+		if desiredDashboards[dashboard.Name] != nil || !metav1.IsControlledBy(&dashboard, template) {
+			continue
+		}
+		log.Info("Deleting Dashboard of a namespace no longer selected", "dashboard", dashboard.Name)
+		if err := config.GetClient().Delete(ctx, &dashboard); client.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Errorf("error deleting Dashboard %s: %w", dashboard.Name, err))
+		}
+	}
+
+	sort.Strings(generated)
+	return generated, errors.Join(errs...)
+}
+
+func desiredGeneratedDashboards(ctx context.Context, template *coralogixv1alpha1.DashboardTemplate) (map[string]*coralogixv1alpha1.Dashboard, error) {
+	generator := template.Spec.Generator
+	if generator == nil {
+		return nil, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&generator.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid generator.namespaceSelector: %w", err)
+	}
+	var namespaceList corev1.NamespaceList
+	if err := config.GetClient().List(ctx, &namespaceList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("error listing namespaces: %w", err)
+	}
+
+	labels := maps.Clone(template.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[coralogixv1alpha1.DashboardTemplateLabelKey] = ownerLabelValue(template.Name)
+
+	desired := make(map[string]*coralogixv1alpha1.Dashboard, len(namespaceList.Items))
+	for _, namespace := range namespaceList.Items {
+		name := coralogixv1alpha1.GeneratedDashboardName(template.Name, namespace.Name)
+		desired[name] = &coralogixv1alpha1.Dashboard{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: template.Namespace,
+				Labels:    maps.Clone(labels),
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion:         coralogixv1alpha1.GroupVersion.String(),
+					Kind:               utils.DashboardTemplateKind,
+					Name:               template.Name,
+					UID:                template.UID,
+					Controller:         ptr.To(true),
+					BlockOwnerDeletion: ptr.To(true),
+				}},
+			},
+			Spec: coralogixv1alpha1.DashboardSpec{
+				TemplateRef: &coralogixv1alpha1.DashboardTemplateRef{
+					Name:   template.Name,
+					Values: generator.GeneratedDashboardValues(&namespace),
+				},
+				FolderRef: generator.FolderRef.DeepCopy(),
+			},
+		}
+	}
+	return desired, nil
+}
+
+func applyGeneratedDashboard(ctx context.Context, log logr.Logger, template *coralogixv1alpha1.DashboardTemplate, desired *coralogixv1alpha1.Dashboard) error {
+	dashboard := &coralogixv1alpha1.Dashboard{}
+	if err := config.GetClient().Get(ctx, client.ObjectKeyFromObject(desired), dashboard); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error getting Dashboard %s: %w", desired.Name, err)
+		}
+		log.Info("Creating Dashboard generated from the DashboardTemplate", "dashboard", desired.Name)
+		utils.PropagateReconcileRequest(template, desired)
+		if err := config.GetClient().Create(ctx, desired); err != nil {
+			return fmt.Errorf("error creating Dashboard %s: %w", desired.Name, err)
+		}
+		return nil
+	}
+
+	if !metav1.IsControlledBy(dashboard, template) {
+		return fmt.Errorf("dashboard %s already exists and is not generated from the DashboardTemplate", dashboard.Name)
+	}
+	reconcileRequested := utils.PropagateReconcileRequest(template, dashboard)
+	if !reconcileRequested && reflect.DeepEqual(dashboard.Labels, desired.Labels) &&
+		equality.Semantic.DeepEqual(dashboard.Spec, desired.Spec) {
+		return nil
+	}
+
+	log.Info("Updating Dashboard generated from the DashboardTemplate", "dashboard", dashboard.Name)
+	dashboard.Labels = desired.Labels
+	dashboard.Spec = desired.Spec
+	if err := config.GetClient().Update(ctx, dashboard); err != nil {
+		return fmt.Errorf("error updating Dashboard %s: %w", dashboard.Name, err)
+	}
+	return nil
+}
+
+// enqueueDashboardTemplatesForNamespace enqueues the DashboardTemplates with a generator when a namespace is
+// created, deleted or relabelled, as it may now be selected, or no longer be.
+func enqueueDashboardTemplatesForNamespace(ctx context.Context, _ client.Object) []reconcile.Request {
+	var templateList coralogixv1alpha1.DashboardTemplateList
+	if err := config.GetClient().List(ctx, &templateList); err != nil {
+		log.FromContext(ctx).Error(err, "Error listing DashboardTemplates")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, template := range templateList.Items {
+		if template.Spec.Generator != nil {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: template.Namespace, Name: template.Name}})
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DashboardTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The selector filters resources by the labels of their namespace, so it does not apply to namespaces.
+	selector := config.GetConfig().Selector.Predicate()
+	scheduled := coralogixreconciler.IgnoreInformationalStatusUpdates()
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&coralogixv1alpha1.DashboardTemplate{}, builder.WithPredicates(selector, scheduled)).
+		Owns(&coralogixv1alpha1.Dashboard{}, builder.WithPredicates(selector, scheduled)).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(enqueueDashboardTemplatesForNamespace),
+			builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Complete(r)
+}
@@ -55,6 +55,10 @@ func (r *EnrichmentReconciler) RequeueInterval() time.Duration {
 	return r.Interval
 }
 
+func (r *EnrichmentReconciler) NewSingletonList() client.ObjectList {
+	return &coralogixv1alpha1.EnrichmentList{}
+}
+
 func (r *EnrichmentReconciler) Overwrite(ctx context.Context, log logr.Logger, enr *coralogixv1alpha1.Enrichment) error {
 	overwriteRequest, err := enr.ExtractAtomicOverwriteRequest(ctx)
 	if err != nil {
@@ -114,6 +118,8 @@ func (r *EnrichmentReconciler) HandleDeletion(ctx context.Context, log logr.Logg
 func (r *EnrichmentReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.Enrichment{}).
+		Watches(&coralogixv1alpha1.Enrichment{}, coralogixreconciler.SingletonPeersHandler(r.NewSingletonList)).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
@@ -17,6 +17,8 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"slices"
 	"strconv"
 	"time"
 
@@ -25,6 +27,9 @@ import (
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	cxsdk "github.com/coralogix/coralogix-management-sdk/go"
 	oapicxsdk "github.com/coralogix/coralogix-management-sdk/go/openapi/cxsdk"
@@ -60,7 +65,12 @@ func (r *GroupReconciler) RequeueInterval() time.Duration {
 
 func (r *GroupReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
 	group := obj.(*coralogixv1alpha1.Group)
-	createRequest, err := group.ExtractCreateGroupRequest(ctx, r.UsersClient)
+	usersIds, err := r.extractMembers(ctx, log, group)
+	if err != nil {
+		return err
+	}
+
+	createRequest, err := group.ExtractCreateGroupRequest(usersIds)
 	if err != nil {
 		return fmt.Errorf("error on extracting create request: %w", err)
 	}
@@ -75,7 +85,8 @@ func (r *GroupReconciler) HandleCreation(ctx context.Context, log logr.Logger, o
 	log.Info("Remote group created", "group", utils.FormatJSON(createResponse))
 
 	group.Status = coralogixv1alpha1.GroupStatus{
-		ID: ptr.To(strconv.Itoa(int(*createResponse.Group.GroupId))),
+		ID:      ptr.To(strconv.Itoa(int(*createResponse.Group.GroupId))),
+		Members: group.Status.Members,
 	}
 
 	return nil
@@ -83,7 +94,12 @@ func (r *GroupReconciler) HandleCreation(ctx context.Context, log logr.Logger, o
 
 func (r *GroupReconciler) HandleUpdate(ctx context.Context, log logr.Logger, obj client.Object) error {
 	group := obj.(*coralogixv1alpha1.Group)
-	updateRequest, err := group.ExtractUpdateGroupRequest(ctx, r.UsersClient)
+	usersIds, err := r.extractMembers(ctx, log, group)
+	if err != nil {
+		return err
+	}
+
+	updateRequest, err := group.ExtractUpdateGroupRequest(usersIds)
 	if err != nil {
 		return fmt.Errorf("error on extracting update request: %w", err)
 	}
@@ -126,10 +142,59 @@ func (r *GroupReconciler) HandleDeletion(ctx context.Context, log logr.Logger, o
 	return nil
 }
 
+// extractMembers resolves the members of the group and persists their statuses, so that unresolved members are
+// reported without failing the group.
+func (r *GroupReconciler) extractMembers(ctx context.Context, log logr.Logger, group *coralogixv1alpha1.Group) ([]string, error) {
+	usersIds, members, err := group.ExtractMembers(ctx, r.UsersClient)
+	if err != nil {
+		return nil, fmt.Errorf("error on extracting group members: %w", err)
+	}
+
+	if !reflect.DeepEqual(group.Status.Members, members) {
+		group.Status.Members = members
+		if err := config.GetClient().Status().Update(ctx, group); err != nil {
+			return nil, fmt.Errorf("error on updating group members status: %w", err)
+		}
+	}
+
+	for _, member := range members {
+		if member.Message != "" {
+			log.Info("Skipping unresolved group member", "userName", member.UserName,
+				"resourceRef", member.ResourceRef, "reason", member.Message)
+		}
+	}
+
+	return usersIds, nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *GroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.Group{}).
+		Watches(&coralogixv1alpha1.User{}, handler.EnqueueRequestsFromMapFunc(enqueueGroupsForUser)).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
+
+// enqueueGroupsForUser enqueues the Groups referencing a User, so that the User is added to them once it is created,
+// without waiting for the requeue interval.
+func enqueueGroupsForUser(ctx context.Context, user client.Object) []reconcile.Request {
+	var groupList coralogixv1alpha1.GroupList
+	if err := config.GetClient().List(ctx, &groupList); err != nil {
+		ctrllog.FromContext(ctx).Error(err, "Error listing Groups referencing the User")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, group := range groupList.Items {
+		if slices.ContainsFunc(group.Spec.Members, func(member coralogixv1alpha1.Member) bool {
+			return member.ResourceRef != nil && member.ResourceRef.Name == user.GetName() &&
+				ptr.Deref(member.ResourceRef.Namespace, group.Namespace) == user.GetNamespace()
+		}) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&group)})
+		}
+	}
+
+	return requests
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+)
+
+func TestEnqueueGroupsForUser(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+
+	newGroup := func(namespace, name string, members ...coralogixv1alpha1.Member) *coralogixv1alpha1.Group {
+		return &coralogixv1alpha1.Group{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       coralogixv1alpha1.GroupSpec{Members: members},
+		}
+	}
+	userRef := func(name string, namespace *string) coralogixv1alpha1.Member {
+		return coralogixv1alpha1.Member{ResourceRef: &coralogixv1alpha1.ResourceRef{Name: name, Namespace: namespace}}
+	}
+
+	originalClient := config.GetClient()
+	t.Cleanup(func() { config.InitClient(originalClient) })
+	config.InitClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newGroup("team", "same-namespace", userRef("jane", nil)),
+		newGroup("other", "other-namespace", userRef("jane", ptr.To("team"))),
+		newGroup("other", "same-name-other-namespace", userRef("jane", nil)),
+		newGroup("team", "other-user", userRef("john", nil), coralogixv1alpha1.Member{UserName: "jane"}),
+	).Build())
+
+	user := &coralogixv1alpha1.User{ObjectMeta: metav1.ObjectMeta{Name: "jane", Namespace: "team"}}
+	require.ElementsMatch(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "team", Name: "same-namespace"}},
+		{NamespacedName: types.NamespacedName{Namespace: "other", Name: "other-namespace"}},
+	}, enqueueGroupsForUser(context.Background(), user))
+}
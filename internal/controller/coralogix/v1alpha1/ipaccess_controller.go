@@ -54,6 +54,10 @@ func (r *IPAccessReconciler) RequeueInterval() time.Duration {
 	return r.Interval
 }
 
+func (r *IPAccessReconciler) NewSingletonList() client.ObjectList {
+	return &coralogixv1alpha1.IPAccessList{}
+}
+
 func (r *IPAccessReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
 	ipAccess := obj.(*coralogixv1alpha1.IPAccess)
 	createReq, err := ipAccess.ExtractCreateIPAccessRequest()
@@ -122,6 +126,8 @@ func (r *IPAccessReconciler) HandleDeletion(ctx context.Context, log logr.Logger
 func (r *IPAccessReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.IPAccess{}).
+		Watches(&coralogixv1alpha1.IPAccess{}, coralogixreconciler.SingletonPeersHandler(r.NewSingletonList)).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
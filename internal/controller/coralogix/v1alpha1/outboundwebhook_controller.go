@@ -32,6 +32,7 @@ import (
 	"github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
 	"github.com/coralogix/coralogix-operator/v2/internal/config"
 	coralogixreconcile "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
+	"github.com/coralogix/coralogix-operator/v2/internal/provenance"
 )
 
 // OutboundWebhookReconciler reconciles a OutboundWebhook object
@@ -62,6 +63,7 @@ func (r *OutboundWebhookReconciler) HandleCreation(ctx context.Context, log logr
 	if err != nil {
 		return fmt.Errorf("error on extracting create outbound-webhook request: %w", err)
 	}
+	stampOutboundWebhookProvenance(outboundWebhook, createRequest.Data)
 	log.Info("Creating remote outbound-webhook", "outbound-webhook", utils.FormatJSON(createRequest))
 	createResponse, httpResp, err := r.OutboundWebhooksClient.
 		OutgoingWebhooksServiceCreateOutgoingWebhook(ctx).
@@ -96,6 +98,18 @@ func (r *OutboundWebhookReconciler) HandleUpdate(ctx context.Context, log logr.L
 	if err != nil {
 		return fmt.Errorf("error on extracting update outbound-webhook request: %w", err)
 	}
+	stampOutboundWebhookProvenance(outboundWebhook, updateRequest.Data)
+	if provenance.Enabled() {
+		getResponse, httpResp, err := r.OutboundWebhooksClient.
+			OutgoingWebhooksServiceGetOutgoingWebhook(ctx, *outboundWebhook.Status.ID).
+			Execute()
+		if err != nil {
+			return cxsdk.NewAPIError(httpResp, err)
+		}
+		if err = checkOutboundWebhookProvenance(outboundWebhook, getResponse.Webhook); err != nil {
+			return err
+		}
+	}
 	log.Info("Updating remote outbound-webhook", "outbound-webhook", utils.FormatJSON(updateRequest))
 	updateResponse, httpResp, err := r.OutboundWebhooksClient.
 		OutgoingWebhooksServiceUpdateOutgoingWebhook(ctx).
@@ -110,6 +124,22 @@ func (r *OutboundWebhookReconciler) HandleUpdate(ctx context.Context, log logr.L
 
 func (r *OutboundWebhookReconciler) HandleDeletion(ctx context.Context, log logr.Logger, obj client.Object) error {
 	outboundWebhook := obj.(*v1alpha1.OutboundWebhook)
+	if provenance.Enabled() {
+		getResponse, httpResp, err := r.OutboundWebhooksClient.
+			OutgoingWebhooksServiceGetOutgoingWebhook(ctx, *outboundWebhook.Status.ID).
+			Execute()
+		if err != nil {
+			if apiErr := cxsdk.NewAPIError(httpResp, err); !cxsdk.IsNotFound(apiErr) {
+				return fmt.Errorf("error getting remote outbound-webhook %s: %w", *outboundWebhook.Status.ID, apiErr)
+			}
+			return nil
+		}
+		if err = checkOutboundWebhookProvenance(outboundWebhook, getResponse.Webhook); err != nil {
+			log.Info("Skipping deletion of remote outbound-webhook managed by another resource",
+				"id", *outboundWebhook.Status.ID, "reason", err.Error())
+			return nil
+		}
+	}
 	log.Info("Deleting outbound-webhook from remote system", "id", *outboundWebhook.Status.ID)
 	_, httpResp, err := r.OutboundWebhooksClient.OutgoingWebhooksServiceDeleteOutgoingWebhook(ctx, *outboundWebhook.Status.ID).
 		Execute()
@@ -123,6 +153,20 @@ func (r *OutboundWebhookReconciler) HandleDeletion(ctx context.Context, log logr
 	return nil
 }
 
+// Outbound webhooks have neither labels nor a description, so their provenance is written as a suffix of their name.
+func stampOutboundWebhookProvenance(outboundWebhook *v1alpha1.OutboundWebhook, data *webhooks.OutgoingWebhookInputData) {
+	if provenance.Enabled() && data != nil {
+		data.Name = ptr.To(provenance.WithNameSuffix(ptr.Deref(data.Name, ""), outboundWebhook))
+	}
+}
+
+func checkOutboundWebhookProvenance(outboundWebhook *v1alpha1.OutboundWebhook, remote *webhooks.OutgoingWebhook) error {
+	if remote == nil {
+		return nil
+	}
+	return provenance.Check(outboundWebhook, provenance.FromNameSuffix(ptr.Deref(remote.Name, "")))
+}
+
 func getOutboundWebhookStatus(webhook *webhooks.OutgoingWebhook) (*v1alpha1.OutboundWebhookStatus, error) {
 	if webhook == nil {
 		return nil, fmt.Errorf("outbound-webhook is nil")
@@ -147,5 +191,6 @@ func (r *OutboundWebhookReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.OutboundWebhook{}).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconcile.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
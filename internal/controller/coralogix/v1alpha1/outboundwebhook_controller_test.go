@@ -0,0 +1,56 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	webhooks "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/outgoing_webhooks_service"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/provenance"
+)
+
+func TestOutboundWebhookProvenance(t *testing.T) {
+	cfg := config.GetConfig()
+	original := *cfg
+	t.Cleanup(func() { *cfg = original })
+	cfg.ProvenanceLabels = true
+	cfg.ClusterName = "production"
+
+	outboundWebhook := &coralogixv1alpha1.OutboundWebhook{
+		ObjectMeta: metav1.ObjectMeta{Name: "on-call", Namespace: "checkout"},
+	}
+	data := &webhooks.OutgoingWebhookInputData{Name: ptr.To("Checkout on-call")}
+	stampOutboundWebhookProvenance(outboundWebhook, data)
+	require.Equal(t, "Checkout on-call [coralogix-operator: production/checkout/on-call]", *data.Name)
+
+	require.NoError(t, checkOutboundWebhookProvenance(outboundWebhook, &webhooks.OutgoingWebhook{Name: data.Name}))
+	require.NoError(t, checkOutboundWebhookProvenance(outboundWebhook, &webhooks.OutgoingWebhook{Name: ptr.To("Checkout on-call")}),
+		"webhooks without provenance are not foreign")
+
+	foreign := &webhooks.OutgoingWebhook{Name: ptr.To("Checkout on-call [coralogix-operator: staging/checkout/on-call]")}
+	require.True(t, provenance.IsForeignError(checkOutboundWebhookProvenance(outboundWebhook, foreign)))
+
+	cfg.ProvenanceLabels = false
+	data = &webhooks.OutgoingWebhookInputData{Name: ptr.To("Checkout on-call")}
+	stampOutboundWebhookProvenance(outboundWebhook, data)
+	require.Equal(t, "Checkout on-call", *data.Name)
+}
@@ -21,8 +21,6 @@ import (
 
 	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 	"github.com/go-logr/logr"
-	"k8s.io/apimachinery/pkg/api/meta"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -54,11 +52,11 @@ func (r *QuotaAllocationRuleSetReconciler) RequeueInterval() time.Duration {
 	return r.Interval
 }
 
-func (r *QuotaAllocationRuleSetReconciler) replace(ctx context.Context, log logr.Logger, quotaAllocationRuleSet *coralogixv1alpha1.QuotaAllocationRuleSet) error {
-	if err := r.ensureSingleSelectedRuleSet(ctx, quotaAllocationRuleSet); err != nil {
-		return err
-	}
+func (r *QuotaAllocationRuleSetReconciler) NewSingletonList() client.ObjectList {
+	return &coralogixv1alpha1.QuotaAllocationRuleSetList{}
+}
 
+func (r *QuotaAllocationRuleSetReconciler) replace(ctx context.Context, log logr.Logger, quotaAllocationRuleSet *coralogixv1alpha1.QuotaAllocationRuleSet) error {
 	ruleSet, err := quotaAllocationRuleSet.Spec.ExtractQuotaAllocationRuleSetRequest()
 	if err != nil {
 		return fmt.Errorf("error on extracting quota allocation rule set request: %w", err)
@@ -113,44 +111,6 @@ func (r *QuotaAllocationRuleSetReconciler) getCurrentRuleSet(ctx context.Context
 	return getResponse.RuleSet, nil
 }
 
-func (r *QuotaAllocationRuleSetReconciler) ensureSingleSelectedRuleSet(ctx context.Context, quotaAllocationRuleSet *coralogixv1alpha1.QuotaAllocationRuleSet) error {
-	other, err := r.findOtherSelectedRuleSet(ctx, quotaAllocationRuleSet)
-	if err != nil {
-		return err
-	}
-	if other != nil {
-		return fmt.Errorf(
-			"only one selected QuotaAllocationRuleSet can manage account-level quota allocation rules; %s/%s already exists",
-			other.Namespace,
-			other.Name,
-		)
-	}
-	return nil
-}
-
-func (r *QuotaAllocationRuleSetReconciler) findOtherSelectedRuleSet(ctx context.Context, quotaAllocationRuleSet *coralogixv1alpha1.QuotaAllocationRuleSet) (*coralogixv1alpha1.QuotaAllocationRuleSet, error) {
-	ruleSets := &coralogixv1alpha1.QuotaAllocationRuleSetList{}
-	if err := config.GetClient().List(ctx, ruleSets); err != nil {
-		return nil, fmt.Errorf("error on listing quota allocation rule sets: %w", err)
-	}
-
-	for i := range ruleSets.Items {
-		ruleSet := &ruleSets.Items[i]
-		if ruleSet.UID == quotaAllocationRuleSet.UID {
-			continue
-		}
-		if !ruleSet.DeletionTimestamp.IsZero() {
-			continue
-		}
-		if !config.GetConfig().Selector.Matches(ruleSet.Labels, ruleSet.Namespace) {
-			continue
-		}
-		return ruleSet, nil
-	}
-
-	return nil, nil
-}
-
 // PreserveManagedQuotaAllocationRules appends backend-managed rules that are not replaced by the planned entity types.
 func PreserveManagedQuotaAllocationRules(
 	plannedRules []quotas.QuotaAllocationEntityTypeRule,
@@ -222,25 +182,7 @@ func (r *QuotaAllocationRuleSetReconciler) HandleUpdate(ctx context.Context, log
 	return coralogixreconciler.AddFinalizer(ctx, log, quotaAllocationRuleSet, r)
 }
 
-func (r *QuotaAllocationRuleSetReconciler) HandleDeletion(ctx context.Context, log logr.Logger, obj client.Object) error {
-	quotaAllocationRuleSet := obj.(*coralogixv1alpha1.QuotaAllocationRuleSet)
-	if other, err := r.findOtherSelectedRuleSet(ctx, quotaAllocationRuleSet); err != nil {
-		return err
-	} else if quotaAllocationRuleSetRemoteSynced(other) {
-		log.Info(
-			"Skipping remote QuotaAllocationRuleSet deletion because another selected resource is synced",
-			"otherNamespace", other.Namespace,
-			"otherName", other.Name,
-		)
-		return nil
-	} else if other != nil {
-		log.Info(
-			"Continuing remote QuotaAllocationRuleSet deletion because another selected resource has not synced",
-			"otherNamespace", other.Namespace,
-			"otherName", other.Name,
-		)
-	}
-
+func (r *QuotaAllocationRuleSetReconciler) HandleDeletion(ctx context.Context, log logr.Logger, _ client.Object) error {
 	log.Info("Deleting QuotaAllocationRuleSet")
 	ruleSet, err := r.getCurrentRuleSet(ctx)
 	if err != nil {
@@ -279,21 +221,12 @@ func (r *QuotaAllocationRuleSetReconciler) HandleDeletion(ctx context.Context, l
 	return nil
 }
 
-func quotaAllocationRuleSetRemoteSynced(ruleSet *coralogixv1alpha1.QuotaAllocationRuleSet) bool {
-	if ruleSet == nil {
-		return false
-	}
-
-	condition := meta.FindStatusCondition(ruleSet.Status.Conditions, utils.ConditionTypeRemoteSynced)
-	return condition != nil &&
-		condition.Status == metav1.ConditionTrue &&
-		condition.ObservedGeneration == ruleSet.Generation
-}
-
 // SetupWithManager sets up the controller with the Manager.
 func (r *QuotaAllocationRuleSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.QuotaAllocationRuleSet{}).
+		Watches(&coralogixv1alpha1.QuotaAllocationRuleSet{}, coralogixreconciler.SingletonPeersHandler(r.NewSingletonList)).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
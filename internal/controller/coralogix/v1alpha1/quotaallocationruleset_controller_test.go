@@ -17,12 +17,15 @@ package v1alpha1
 import (
 	"context"
 	"testing"
+	"time"
 
 	quotas "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/quota_allocation_rule_set_service"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
@@ -96,107 +99,62 @@ func TestRejectManagedQuotaAllocationRuleCollisionsAllowsUserManagedEntityType(t
 	require.NoError(t, RejectManagedQuotaAllocationRuleCollisions(planned, current))
 }
 
-func TestEnsureSingleSelectedRuleSetRejectsAnotherActiveResource(t *testing.T) {
+func TestQuotaAllocationRuleSetReconcileReportsConflictWithOlderResource(t *testing.T) {
 	scheme := runtime.NewScheme()
 	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
 
 	current := &coralogixv1alpha1.QuotaAllocationRuleSet{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "current",
-			Namespace: "team-a",
-			UID:       types.UID("current"),
+			Name:              "current",
+			Namespace:         "team-a",
+			UID:               types.UID("current"),
+			CreationTimestamp: metav1.NewTime(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)),
 		},
 	}
 	other := &coralogixv1alpha1.QuotaAllocationRuleSet{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "other",
-			Namespace: "team-b",
-			UID:       types.UID("other"),
+			Name:              "other",
+			Namespace:         "team-b",
+			UID:               types.UID("other"),
+			CreationTimestamp: metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
 		},
 	}
 
 	originalClient := config.GetClient()
+	originalScheme := config.GetScheme()
 	originalSelector := config.GetConfig().Selector
 	t.Cleanup(func() {
 		config.InitClient(originalClient)
+		config.InitScheme(originalScheme)
 		config.GetConfig().Selector = originalSelector
 	})
 
-	config.InitClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(current, other).Build())
+	config.InitClient(fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(current, other).
+		WithStatusSubresource(current, other).
+		Build())
+	config.InitScheme(scheme)
 	config.GetConfig().Selector = config.Selector{}
 
-	err := (&QuotaAllocationRuleSetReconciler{}).ensureSingleSelectedRuleSet(context.Background(), current)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: current.Name, Namespace: current.Namespace}}
+	_, err := (&QuotaAllocationRuleSetReconciler{}).Reconcile(context.Background(), req)
+	require.NoError(t, err)
 
-	require.ErrorContains(t, err, "only one selected QuotaAllocationRuleSet can manage account-level quota allocation rules")
-	require.ErrorContains(t, err, "team-b/other")
-}
+	updated := &coralogixv1alpha1.QuotaAllocationRuleSet{}
+	require.NoError(t, config.GetClient().Get(context.Background(), req.NamespacedName, updated))
 
-func TestQuotaAllocationRuleSetRemoteSynced(t *testing.T) {
-	tests := []struct {
-		name    string
-		ruleSet *coralogixv1alpha1.QuotaAllocationRuleSet
-		want    bool
-	}{
-		{
-			name: "synced current generation",
-			ruleSet: quotaAllocationRuleSetWithRemoteSyncedCondition(
-				metav1.ConditionTrue,
-				2,
-				2,
-			),
-			want: true,
-		},
-		{
-			name: "synced stale generation",
-			ruleSet: quotaAllocationRuleSetWithRemoteSyncedCondition(
-				metav1.ConditionTrue,
-				2,
-				1,
-			),
-			want: false,
-		},
-		{
-			name: "not synced",
-			ruleSet: quotaAllocationRuleSetWithRemoteSyncedCondition(
-				metav1.ConditionFalse,
-				2,
-				2,
-			),
-			want: false,
-		},
-		{
-			name:    "nil",
-			ruleSet: nil,
-			want:    false,
-		},
-	}
+	conflict := meta.FindStatusCondition(updated.Status.Conditions, utils.ConditionTypeConflict)
+	require.NotNil(t, conflict)
+	require.Equal(t, metav1.ConditionTrue, conflict.Status)
+	require.Contains(t, conflict.Message, "only one selected QuotaAllocationRuleSet can manage the account-wide settings")
+	require.Contains(t, conflict.Message, "team-b/other")
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			require.Equal(t, tt.want, quotaAllocationRuleSetRemoteSynced(tt.ruleSet))
-		})
-	}
-}
-
-func quotaAllocationRuleSetWithRemoteSyncedCondition(
-	status metav1.ConditionStatus,
-	generation int64,
-	observedGeneration int64,
-) *coralogixv1alpha1.QuotaAllocationRuleSet {
-	return &coralogixv1alpha1.QuotaAllocationRuleSet{
-		ObjectMeta: metav1.ObjectMeta{
-			Generation: generation,
-		},
-		Status: coralogixv1alpha1.QuotaAllocationRuleSetStatus{
-			Conditions: []metav1.Condition{
-				{
-					Type:               utils.ConditionTypeRemoteSynced,
-					Status:             status,
-					ObservedGeneration: observedGeneration,
-				},
-			},
-		},
-	}
+	synced := meta.FindStatusCondition(updated.Status.Conditions, utils.ConditionTypeRemoteSynced)
+	require.NotNil(t, synced)
+	require.Equal(t, metav1.ConditionFalse, synced.Status)
+	require.Equal(t, utils.ReasonSingletonConflict, synced.Reason)
+	require.Empty(t, updated.Finalizers)
 }
 
 func quotaRule(entityType string, cxManaged bool) quotas.QuotaAllocationEntityTypeRule {
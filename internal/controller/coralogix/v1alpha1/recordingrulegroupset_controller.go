@@ -58,9 +58,13 @@ func (r *RecordingRuleGroupSetReconciler) RequeueInterval() time.Duration {
 
 func (r *RecordingRuleGroupSetReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
 	recordingRuleGroupSet := obj.(*coralogixv1alpha1.RecordingRuleGroupSet)
+	groups, err := recordingRuleGroupSet.Spec.ExtractRecordingRuleGroups()
+	if err != nil {
+		return fmt.Errorf("error on extracting recordingRuleGroupSet groups: %w", err)
+	}
 	createRequest := recordingrules.CreateRuleGroupSet{
 		Name:   ptr.To(fmt.Sprintf("%s%s", recordingRuleGroupSet.Name, r.RecordingRuleGroupSetSuffix)),
-		Groups: recordingRuleGroupSet.Spec.ExtractRecordingRuleGroups(),
+		Groups: groups,
 	}
 	log.Info("Creating remote recordingRuleGroupSet", "recordingRuleGroupSet", utils.FormatJSON(createRequest))
 	createResponse, httpResp, err := r.RecordingRulesClient.
@@ -80,8 +84,12 @@ func (r *RecordingRuleGroupSetReconciler) HandleCreation(ctx context.Context, lo
 
 func (r *RecordingRuleGroupSetReconciler) HandleUpdate(ctx context.Context, log logr.Logger, obj client.Object) error {
 	recordingRuleGroupSet := obj.(*coralogixv1alpha1.RecordingRuleGroupSet)
+	groups, err := recordingRuleGroupSet.Spec.ExtractRecordingRuleGroups()
+	if err != nil {
+		return fmt.Errorf("error on extracting recordingRuleGroupSet groups: %w", err)
+	}
 	updateRequest := recordingrules.UpdateRuleGroupSet{
-		Groups: recordingRuleGroupSet.Spec.ExtractRecordingRuleGroups(),
+		Groups: groups,
 	}
 	log.Info("Updating remote recordingRuleGroupSet", "recordingRuleGroupSet", utils.FormatJSON(updateRequest))
 	updateResponse, httpResp, err := r.RecordingRulesClient.
@@ -115,5 +123,6 @@ func (r *RecordingRuleGroupSetReconciler) SetupWithManager(mgr ctrl.Manager) err
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.RecordingRuleGroupSet{}).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
@@ -17,6 +17,7 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -29,6 +30,7 @@ import (
 	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
 	"github.com/coralogix/coralogix-operator/v2/internal/config"
 	"github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
+	"github.com/coralogix/coralogix-operator/v2/internal/ruleengine"
 	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 )
 
@@ -56,6 +58,10 @@ func (r *RuleGroupReconciler) RequeueInterval() time.Duration {
 
 func (r *RuleGroupReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
 	ruleGroup := obj.(*coralogixv1alpha1.RuleGroup)
+	if err := runRuleGroupTests(ctx, log, ruleGroup); err != nil {
+		return err
+	}
+
 	createRequest := ruleGroup.Spec.ExtractCreateRuleGroupRequest()
 	log.Info("Creating remote ruleGroup", "ruleGroup", utils.FormatJSON(createRequest))
 	createResponse, httpResp, err := r.RuleGroupClient.
@@ -67,7 +73,8 @@ func (r *RuleGroupReconciler) HandleCreation(ctx context.Context, log logr.Logge
 	}
 	log.Info("Remote ruleGroup created", "response", utils.FormatJSON(createResponse))
 	ruleGroup.Status = coralogixv1alpha1.RuleGroupStatus{
-		ID: createResponse.RuleGroup.Id,
+		ID:    createResponse.RuleGroup.Id,
+		Tests: ruleGroup.Status.Tests,
 	}
 
 	return nil
@@ -75,6 +82,10 @@ func (r *RuleGroupReconciler) HandleCreation(ctx context.Context, log logr.Logge
 
 func (r *RuleGroupReconciler) HandleUpdate(ctx context.Context, log logr.Logger, obj client.Object) error {
 	ruleGroup := obj.(*coralogixv1alpha1.RuleGroup)
+	if err := runRuleGroupTests(ctx, log, ruleGroup); err != nil {
+		return err
+	}
+
 	updateRequest := ruleGroup.Spec.ExtractCreateRuleGroupRequest()
 	log.Info("Updating remote ruleGroup", "ruleGroup", utils.FormatJSON(updateRequest))
 	updateResponse, httpResp, err := r.RuleGroupClient.
@@ -105,10 +116,32 @@ func (r *RuleGroupReconciler) HandleDeletion(ctx context.Context, log logr.Logge
 	return nil
 }
 
+// runRuleGroupTests runs spec.tests and persists their results, failing if any of them failed,
+// so that a broken rule-group is never sent to Coralogix.
+func runRuleGroupTests(ctx context.Context, log logr.Logger, ruleGroup *coralogixv1alpha1.RuleGroup) error {
+	results := ruleengine.RunTests(&ruleGroup.Spec)
+	if len(results) == 0 {
+		results = nil
+	}
+	if !reflect.DeepEqual(ruleGroup.Status.Tests, results) {
+		ruleGroup.Status.Tests = results
+		if err := config.GetClient().Status().Update(ctx, ruleGroup); err != nil {
+			return fmt.Errorf("error on updating rule-group tests status: %w", err)
+		}
+	}
+
+	if err := ruleengine.FailedTestsError(results); err != nil {
+		log.Info("Rule-group tests failed; skipping remote update", "error", err.Error())
+		return err
+	}
+	return nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *RuleGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.RuleGroup{}).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
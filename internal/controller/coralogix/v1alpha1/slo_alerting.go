@@ -0,0 +1,252 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	coralogixreconciler "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// sloAlertsDeletionDelay is the delay between the checks of the deletion of the Alerts generated from a deleted SLO.
+const sloAlertsDeletionDelay = 5 * time.Second
+
+// syncSLOAlerts creates and updates the Alerts generated from spec.alerting, and deletes the ones that are
+// no longer generated.
+func syncSLOAlerts(ctx context.Context, log logr.Logger, slo *coralogixv1alpha1.SLO) error {
+	desiredAlerts := desiredSLOAlerts(slo)
+	desiredNames := make(map[string]bool, len(desiredAlerts))
+	var errs []error
+	for _, desired := range desiredAlerts {
+		desiredNames[desired.Name] = true
+		if err := applySLOAlert(ctx, log, slo, desired); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	ownedAlerts, err := listSLOAlerts(ctx, slo)
+	if err != nil {
+		return err
+	}
+	for _, alert := range ownedAlerts {
+		if desiredNames[alert.Name] {
+			continue
+		}
+		log.Info("Deleting Alert no longer generated by the SLO", "alert", alert.Name)
+		if err := config.GetClient().Delete(ctx, &alert); client.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Errorf("error deleting Alert %s: %w", alert.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deleteSLOAlerts deletes the Alerts generated from the SLO, and returns a coralogixreconciler.WaitingError until they
+// are gone, so that the remote alerts are deleted before the SLO they refer to.
+func deleteSLOAlerts(ctx context.Context, log logr.Logger, slo *coralogixv1alpha1.SLO) error {
+	ownedAlerts, err := listSLOAlerts(ctx, slo)
+	if err != nil || len(ownedAlerts) == 0 {
+		return err
+	}
+
+	var errs []error
+	for _, alert := range ownedAlerts {
+		if alert.DeletionTimestamp.IsZero() {
+			log.Info("Deleting Alert generated by the SLO", "alert", alert.Name)
+			if err := config.GetClient().Delete(ctx, &alert); client.IgnoreNotFound(err) != nil {
+				errs = append(errs, fmt.Errorf("error deleting Alert %s: %w", alert.Name, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return coralogixreconciler.NewWaitingError(sloAlertsDeletionDelay,
+		"waiting for %d Alerts generated by the SLO to be deleted", len(ownedAlerts))
+}
+
+func applySLOAlert(ctx context.Context, log logr.Logger, slo *coralogixv1alpha1.SLO, desired *coralogixv1beta1.Alert) error {
+	alert := &coralogixv1beta1.Alert{}
+	if err := config.GetClient().Get(ctx, client.ObjectKeyFromObject(desired), alert); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error getting Alert %s: %w", desired.Name, err)
+		}
+		log.Info("Creating Alert generated by the SLO", "alert", desired.Name)
+		if err := config.GetClient().Create(ctx, desired); err != nil {
+			return fmt.Errorf("error creating Alert %s: %w", desired.Name, err)
+		}
+		return nil
+	}
+
+	if !metav1.IsControlledBy(alert, slo) {
+		return fmt.Errorf("alert %s already exists and is not generated by the SLO", alert.Name)
+	}
+
+	// The API server defaults unset fields of the Alert, so only the fields set by the SLO are compared,
+	// except for the optional ones that can be removed from spec.alerting.
+	if reflect.DeepEqual(alert.Labels, desired.Labels) &&
+		equality.Semantic.DeepDerivative(desired.Spec, alert.Spec) &&
+		(desired.Spec.NotificationGroup == nil) == (alert.Spec.NotificationGroup == nil) &&
+		len(desired.Spec.EntityLabels) == len(alert.Spec.EntityLabels) {
+		return nil
+	}
+
+	log.Info("Updating Alert generated by the SLO", "alert", alert.Name)
+	alert.Labels = desired.Labels
+	alert.Spec = desired.Spec
+	if err := config.GetClient().Update(ctx, alert); err != nil {
+		return fmt.Errorf("error updating Alert %s: %w", alert.Name, err)
+	}
+	return nil
+}
+
+func listSLOAlerts(ctx context.Context, slo *coralogixv1alpha1.SLO) ([]coralogixv1beta1.Alert, error) {
+	var alertList coralogixv1beta1.AlertList
+	if err := config.GetClient().List(ctx, &alertList,
+		client.InNamespace(slo.Namespace),
+		client.MatchingLabels{utils.SLOLabelKey: ownerLabelValue(slo.Name)}); err != nil {
+		return nil, fmt.Errorf("error listing Alerts generated by the SLO: %w", err)
+	}
+
+	var owned []coralogixv1beta1.Alert
+	for _, alert := range alertList.Items {
+		if metav1.IsControlledBy(&alert, slo) {
+			owned = append(owned, alert)
+		}
+	}
+	return owned, nil
+}
+
+// desiredSLOAlerts returns the Alerts generated from spec.alerting: one per burn-rate policy, and one for
+// the error-budget thresholds.
+func desiredSLOAlerts(slo *coralogixv1alpha1.SLO) []*coralogixv1beta1.Alert {
+	alerting := slo.Spec.Alerting
+	if alerting == nil {
+		return nil
+	}
+
+	var result []*coralogixv1beta1.Alert
+	for _, policy := range alerting.BurnRates {
+		burnRateType := coralogixv1beta1.SloBurnRateType{}
+		timeDuration := coralogixv1beta1.TimeDuration{Duration: policy.WindowHours, Unit: coralogixv1beta1.TimeDurationUnitHours}
+		if policy.Dual {
+			burnRateType.Dual = &coralogixv1beta1.SloBurnRateTypeDual{TimeDuration: timeDuration}
+		} else {
+			burnRateType.Single = &coralogixv1beta1.SloBurnRateTypeSingle{TimeDuration: timeDuration}
+		}
+
+		rules := make([]coralogixv1beta1.BurnRateRule, 0, len(policy.Thresholds))
+		for _, threshold := range policy.Thresholds {
+			rules = append(rules, coralogixv1beta1.BurnRateRule{
+				Condition: coralogixv1beta1.BurnRateRuleCondition{Threshold: threshold.Threshold},
+				Override:  &coralogixv1beta1.AlertOverride{Priority: threshold.Priority},
+			})
+		}
+
+		alert := newSLOAlert(slo, "burn-rate-"+policy.Name, fmt.Sprintf("%s burn rate (%s)", slo.Spec.Name, policy.Name), policy.Thresholds)
+		alert.Spec.Description = fmt.Sprintf("Burn rate of the SLO %s over %dh.", slo.Spec.Name, policy.WindowHours)
+		alert.Spec.TypeDefinition.SloThreshold.BurnRate = &coralogixv1beta1.BurnRate{Rules: rules, BurnRateType: burnRateType}
+		result = append(result, alert)
+	}
+
+	if errorBudget := alerting.ErrorBudget; errorBudget != nil {
+		rules := make([]coralogixv1beta1.SloThresholdRule, 0, len(errorBudget.Thresholds))
+		for _, threshold := range errorBudget.Thresholds {
+			rules = append(rules, coralogixv1beta1.SloThresholdRule{
+				Condition: coralogixv1beta1.SloThresholdRuleCondition{Threshold: threshold.Threshold},
+				Override:  &coralogixv1beta1.AlertOverride{Priority: threshold.Priority},
+			})
+		}
+
+		alert := newSLOAlert(slo, "error-budget", fmt.Sprintf("%s error budget", slo.Spec.Name), errorBudget.Thresholds)
+		alert.Spec.Description = fmt.Sprintf("Remaining error budget of the SLO %s.", slo.Spec.Name)
+		alert.Spec.TypeDefinition.SloThreshold.ErrorBudget = &coralogixv1beta1.ErrorBudget{Rules: rules}
+		result = append(result, alert)
+	}
+	return result
+}
+
+// newSLOAlert returns an SLO threshold Alert owned by the SLO, with the highest priority of its thresholds.
+func newSLOAlert(slo *coralogixv1alpha1.SLO, suffix, name string, thresholds []coralogixv1alpha1.SLOAlertThreshold) *coralogixv1beta1.Alert {
+	priority := coralogixv1beta1.AlertPriorityP5
+	for _, threshold := range thresholds {
+		if threshold.Priority < priority {
+			priority = threshold.Priority
+		}
+	}
+
+	labels := maps.Clone(slo.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[utils.SLOLabelKey] = ownerLabelValue(slo.Name)
+
+	return &coralogixv1beta1.Alert{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", slo.Name, suffix),
+			Namespace: slo.Namespace,
+			Labels:    labels,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion:         coralogixv1alpha1.GroupVersion.String(),
+				Kind:               utils.SLOKind,
+				Name:               slo.Name,
+				UID:                slo.UID,
+				Controller:         ptr.To(true),
+				BlockOwnerDeletion: ptr.To(true),
+			}},
+		},
+		Spec: coralogixv1beta1.AlertSpec{
+			Name:              name,
+			Priority:          priority,
+			Enabled:           ptr.To(true),
+			NotificationGroup: slo.Spec.Alerting.NotificationGroup.DeepCopy(),
+			EntityLabels:      maps.Clone(slo.Spec.Alerting.EntityLabels),
+			TypeDefinition: coralogixv1beta1.AlertTypeDefinition{
+				SloThreshold: &coralogixv1beta1.SloThreshold{
+					SloDefinition: coralogixv1beta1.SloDefinition{
+						SloRef: coralogixv1beta1.SloRef{
+							ResourceRef: &coralogixv1beta1.ResourceRef{Name: slo.Name},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ownerLabelValue truncates the name of an owner, e.g. an SLO, to a valid label value. Generated resources are
+// matched by owner as well, so that truncated names can't mix up the resources of different owners.
+func ownerLabelValue(name string) string {
+	if len(name) <= 63 {
+		return name
+	}
+	return strings.TrimRight(name[:63], "-.")
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	coralogixreconciler "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+func TestDesiredSLOAlerts(t *testing.T) {
+	slo := sloWithAlerting()
+
+	alerts := desiredSLOAlerts(slo)
+	require.Len(t, alerts, 3)
+
+	fast := alerts[0]
+	require.Equal(t, "checkout-burn-rate-fast", fast.Name)
+	require.Equal(t, "payments", fast.Namespace)
+	require.Equal(t, map[string]string{"team": "payments", utils.SLOLabelKey: "checkout"}, fast.Labels)
+	require.True(t, metav1.IsControlledBy(fast, slo))
+	require.Equal(t, "Checkout availability burn rate (fast)", fast.Spec.Name)
+	require.Equal(t, coralogixv1beta1.AlertPriorityP1, fast.Spec.Priority)
+	require.Equal(t, slo.Spec.Alerting.NotificationGroup, fast.Spec.NotificationGroup)
+	sloThreshold := fast.Spec.TypeDefinition.SloThreshold
+	require.Equal(t, &coralogixv1beta1.ResourceRef{Name: "checkout"}, sloThreshold.SloDefinition.SloRef.ResourceRef)
+	require.Equal(t, &coralogixv1beta1.SloBurnRateTypeDual{
+		TimeDuration: coralogixv1beta1.TimeDuration{Duration: 1, Unit: coralogixv1beta1.TimeDurationUnitHours},
+	}, sloThreshold.BurnRate.BurnRateType.Dual)
+	require.Equal(t, coralogixv1beta1.AlertPriorityP1, sloThreshold.BurnRate.Rules[0].Override.Priority)
+
+	slow := alerts[1]
+	require.Equal(t, "checkout-burn-rate-slow", slow.Name)
+	require.Equal(t, coralogixv1beta1.AlertPriorityP3, slow.Spec.Priority)
+	require.NotNil(t, slow.Spec.TypeDefinition.SloThreshold.BurnRate.BurnRateType.Single)
+
+	errorBudget := alerts[2]
+	require.Equal(t, "checkout-error-budget", errorBudget.Name)
+	require.Equal(t, coralogixv1beta1.AlertPriorityP2, errorBudget.Spec.Priority)
+	require.Len(t, errorBudget.Spec.TypeDefinition.SloThreshold.ErrorBudget.Rules, 2)
+
+	slo.Spec.Alerting = nil
+	require.Empty(t, desiredSLOAlerts(slo))
+}
+
+func TestSyncSLOAlerts(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+	require.NoError(t, coralogixv1beta1.AddToScheme(scheme))
+
+	slo := sloWithAlerting()
+	stale := desiredSLOAlerts(slo)[0]
+	stale.Name = "checkout-burn-rate-removed"
+	unrelated := desiredSLOAlerts(slo)[0]
+	unrelated.Name = "checkout-burn-rate-unrelated"
+	unrelated.OwnerReferences = nil
+
+	originalClient := config.GetClient()
+	t.Cleanup(func() { config.InitClient(originalClient) })
+	config.InitClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(slo, stale, unrelated).Build())
+
+	ctx := context.Background()
+	require.NoError(t, syncSLOAlerts(ctx, logr.Discard(), slo))
+	requireAlertNames(t, "checkout-burn-rate-fast", "checkout-burn-rate-slow", "checkout-burn-rate-unrelated", "checkout-error-budget")
+
+	// Changes of the SLO are propagated to the generated alerts.
+	slo.Spec.Alerting.BurnRates[0].Thresholds[0].Threshold = resource.MustParse("10")
+	slo.Spec.Alerting.NotificationGroup = nil
+	require.NoError(t, syncSLOAlerts(ctx, logr.Discard(), slo))
+	fast := &coralogixv1beta1.Alert{}
+	require.NoError(t, config.GetClient().Get(ctx, client.ObjectKey{Namespace: "payments", Name: "checkout-burn-rate-fast"}, fast))
+	require.Nil(t, fast.Spec.NotificationGroup)
+	require.True(t, resource.MustParse("10").Equal(fast.Spec.TypeDefinition.SloThreshold.BurnRate.Rules[0].Condition.Threshold))
+
+	// An existing alert which isn't generated by the SLO is not taken over.
+	slo.Spec.Alerting.BurnRates[0].Name = "unrelated"
+	require.ErrorContains(t, syncSLOAlerts(ctx, logr.Discard(), slo), "alert checkout-burn-rate-unrelated already exists and is not generated by the SLO")
+
+	err := deleteSLOAlerts(ctx, logr.Discard(), slo)
+	require.ErrorContains(t, err, "waiting for 2 Alerts generated by the SLO to be deleted")
+	delay, waiting := coralogixreconciler.IsWaitingError(err)
+	require.True(t, waiting)
+	require.Equal(t, sloAlertsDeletionDelay, delay)
+	requireAlertNames(t, "checkout-burn-rate-unrelated")
+	require.NoError(t, deleteSLOAlerts(ctx, logr.Discard(), slo))
+}
+
+func requireAlertNames(t *testing.T, names ...string) {
+	t.Helper()
+	var alertList coralogixv1beta1.AlertList
+	require.NoError(t, config.GetClient().List(context.Background(), &alertList, client.InNamespace("payments")))
+	var actual []string
+	for _, alert := range alertList.Items {
+		actual = append(actual, alert.Name)
+	}
+	require.ElementsMatch(t, names, actual)
+}
+
+func sloWithAlerting() *coralogixv1alpha1.SLO {
+	return &coralogixv1alpha1.SLO{
+		TypeMeta: metav1.TypeMeta{APIVersion: coralogixv1alpha1.GroupVersion.String(), Kind: utils.SLOKind},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "checkout",
+			Namespace: "payments",
+			UID:       "slo-uid",
+			Labels:    map[string]string{"team": "payments"},
+		},
+		Spec: coralogixv1alpha1.SLOSpec{
+			Name: "Checkout availability",
+			Alerting: &coralogixv1alpha1.SLOAlerting{
+				BurnRates: []coralogixv1alpha1.SLOBurnRateAlerting{
+					{
+						Name:        "fast",
+						WindowHours: 1,
+						Dual:        true,
+						Thresholds:  []coralogixv1alpha1.SLOAlertThreshold{{Threshold: resource.MustParse("14.4"), Priority: coralogixv1beta1.AlertPriorityP1}},
+					},
+					{
+						Name:        "slow",
+						WindowHours: 72,
+						Thresholds:  []coralogixv1alpha1.SLOAlertThreshold{{Threshold: resource.MustParse("1"), Priority: coralogixv1beta1.AlertPriorityP3}},
+					},
+				},
+				ErrorBudget: &coralogixv1alpha1.SLOErrorBudgetAlerting{
+					Thresholds: []coralogixv1alpha1.SLOAlertThreshold{
+						{Threshold: resource.MustParse("50"), Priority: coralogixv1beta1.AlertPriorityP4},
+						{Threshold: resource.MustParse("10"), Priority: coralogixv1beta1.AlertPriorityP2},
+					},
+				},
+				NotificationGroup: &coralogixv1beta1.NotificationGroup{GroupByKeys: []string{"service"}},
+			},
+		},
+	}
+}
@@ -17,19 +17,23 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/go-logr/logr"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/coralogix/coralogix-management-sdk/go/openapi/cxsdk"
 	slos "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/slos_service"
 
 	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
 	"github.com/coralogix/coralogix-operator/v2/internal/config"
 	coralogixreconciler "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
+	"github.com/coralogix/coralogix-operator/v2/internal/provenance"
 	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 )
 
@@ -42,6 +46,7 @@ type SLOReconciler struct {
 // +kubebuilder:rbac:groups=coralogix.com,resources=slos,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=coralogix.com,resources=slos/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=coralogix.com,resources=slos/finalizers,verbs=update
+// +kubebuilder:rbac:groups=coralogix.com,resources=coralogixdefaults,verbs=get;list;watch
 
 var _ coralogixreconciler.CoralogixReconciler = &SLOReconciler{}
 
@@ -51,10 +56,16 @@ func (r *SLOReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 
 func (r *SLOReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
 	slo := obj.(*coralogixv1alpha1.SLO)
-	createRequest, err := slo.ExtractSLOCreateRequest()
+	defaults, err := coralogixv1alpha1.GetSLODefaults(ctx, slo.Namespace)
+	if err != nil {
+		return fmt.Errorf("error on getting slo defaults: %w", err)
+	}
+
+	createRequest, err := slo.ExtractSLOCreateRequest(defaults)
 	if err != nil {
 		return fmt.Errorf("error on extracting create request: %w", err)
 	}
+	createRequest.Labels = ptr.To(provenance.WithLabels(ptr.Deref(createRequest.Labels, nil), slo))
 
 	log.Info("Creating remote slo", "slo", utils.FormatJSON(createRequest))
 	createResponse, httpResp, err := r.SLOsClient.
@@ -70,9 +81,19 @@ func (r *SLOReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj
 	sloID := receivedSLO.GetId()
 	revision := ptr.To(receivedSLO.GetRevision()).GetRevision()
 
+	_, appliedDefaults := slo.Spec.WithDefaults(defaults)
 	slo.Status = coralogixv1alpha1.SLOStatus{
-		ID:       ptr.To(sloID),
-		Revision: ptr.To(revision),
+		ID:              ptr.To(sloID),
+		Revision:        ptr.To(revision),
+		CreatedAt:       coralogixreconciler.RemoteTime(receivedSLO.CreateTime),
+		UpdatedAt:       coralogixreconciler.RemoteTime(receivedSLO.UpdateTime),
+		AppliedDefaults: appliedDefaults,
+	}
+
+	// The remote slo exists now, so failing to generate its alerts must not fail the creation, which would create
+	// it again. They are synced again by the next reconciliation.
+	if err := syncSLOAlerts(ctx, log, slo); err != nil {
+		log.Error(err, "Error on syncing slo alerts")
 	}
 
 	return nil
@@ -80,13 +101,22 @@ func (r *SLOReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj
 
 func (r *SLOReconciler) HandleUpdate(ctx context.Context, log logr.Logger, obj client.Object) error {
 	slo := obj.(*coralogixv1alpha1.SLO)
-	updateRequest, err := slo.ExtractSLOUpdateRequest()
+	defaults, err := coralogixv1alpha1.GetSLODefaults(ctx, slo.Namespace)
+	if err != nil {
+		return fmt.Errorf("error on getting slo defaults: %w", err)
+	}
+
+	updateRequest, err := slo.ExtractSLOUpdateRequest(defaults)
 	if err != nil {
 		return fmt.Errorf("error on extracting update request: %w", err)
 	}
+	updateRequest.Labels = ptr.To(provenance.WithLabels(ptr.Deref(updateRequest.Labels, nil), slo))
 	if slo.Status.ID == nil {
 		return fmt.Errorf("slo id is nil")
 	}
+	if err := r.checkProvenance(ctx, slo); err != nil {
+		return err
+	}
 
 	log.Info("Updating remote slo", "slo", utils.FormatJSON(updateRequest))
 	updateResponse, httpResp, err := r.SLOsClient.
@@ -97,6 +127,22 @@ func (r *SLOReconciler) HandleUpdate(ctx context.Context, log logr.Logger, obj c
 		return cxsdk.NewAPIError(httpResp, err)
 	}
 	log.Info("Remote slo updated", "response", utils.FormatJSON(updateResponse))
+
+	receivedSLO := updateResponse.GetSlo()
+	createdAt, updatedAt := coralogixreconciler.RemoteTime(receivedSLO.CreateTime), coralogixreconciler.RemoteTime(receivedSLO.UpdateTime)
+	if _, appliedDefaults := slo.Spec.WithDefaults(defaults); !reflect.DeepEqual(slo.Status.AppliedDefaults, appliedDefaults) ||
+		!createdAt.Equal(slo.Status.CreatedAt) || !updatedAt.Equal(slo.Status.UpdatedAt) {
+		slo.Status.AppliedDefaults = appliedDefaults
+		slo.Status.CreatedAt = createdAt
+		slo.Status.UpdatedAt = updatedAt
+		if err := config.GetClient().Status().Update(ctx, slo); err != nil {
+			return fmt.Errorf("error on updating slo status: %w", err)
+		}
+	}
+
+	if err := syncSLOAlerts(ctx, log, slo); err != nil {
+		return fmt.Errorf("error on syncing slo alerts: %w", err)
+	}
 	return nil
 }
 
@@ -105,6 +151,18 @@ func (r *SLOReconciler) HandleDeletion(ctx context.Context, log logr.Logger, obj
 	if slo.Status.ID == nil {
 		return fmt.Errorf("slo id is nil")
 	}
+	if err := deleteSLOAlerts(ctx, log, slo); err != nil {
+		return err
+	}
+	if err := r.checkProvenance(ctx, slo); err != nil {
+		if provenance.IsForeignError(err) {
+			log.Info("Skipping deletion of remote slo managed by another resource", "sloId", *slo.Status.ID, "reason", err.Error())
+			return nil
+		}
+		if !cxsdk.IsNotFound(err) {
+			return fmt.Errorf("error on getting remote slo: %w", err)
+		}
+	}
 
 	log.Info("Deleting remote slo", "sloId", *slo.Status.ID)
 	deleteResponse, httpResp, err := r.SLOsClient.
@@ -120,6 +178,21 @@ func (r *SLOReconciler) HandleDeletion(ctx context.Context, log logr.Logger, obj
 	return nil
 }
 
+// checkProvenance returns a provenance.ForeignError if the remote slo is managed by another resource.
+func (r *SLOReconciler) checkProvenance(ctx context.Context, slo *coralogixv1alpha1.SLO) error {
+	if !provenance.Enabled() {
+		return nil
+	}
+	getResponse, httpResp, err := r.SLOsClient.
+		SlosServiceGetSlo(ctx, *slo.Status.ID).
+		Execute()
+	if err != nil {
+		return cxsdk.NewAPIError(httpResp, err)
+	}
+	remoteSLO := getResponse.GetSlo()
+	return provenance.Check(slo, remoteSLO.GetLabels())
+}
+
 func (r *SLOReconciler) FinalizerName() string {
 	return "slo.coralogix.com/finalizer"
 }
@@ -136,7 +209,10 @@ func (r *SLOReconciler) RequeueInterval() time.Duration {
 // SetupWithManager sets up the controller with the Manager.
 func (r *SLOReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&coralogixv1alpha1.SLO{}).
-		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		For(&coralogixv1alpha1.SLO{}, builder.WithPredicates(config.GetConfig().Selector.Predicate(), coralogixreconciler.IgnoreInformationalStatusUpdates())).
+		Owns(&coralogixv1beta1.Alert{}, builder.WithPredicates(coralogixreconciler.IgnoreInformationalStatusUpdates())).
+		Watches(&coralogixv1alpha1.CoralogixDefaults{}, coralogixreconciler.EnqueueForCoralogixDefaults(func() client.ObjectList {
+			return &coralogixv1alpha1.SLOList{}
+		})).
 		Complete(r)
 }
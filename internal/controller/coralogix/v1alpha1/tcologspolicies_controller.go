@@ -21,6 +21,7 @@ import (
 
 	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -38,6 +39,8 @@ type TCOLogsPoliciesReconciler struct {
 	TCOPoliciesClient       *tcopolicies.PoliciesServiceAPIService
 	ArchiveRetentionsClient *archiveretentions.RetentionsServiceAPIService
 	Interval                time.Duration
+	// ComposePolicies merges the policies of all selected TCOLogsPolicies into a single overwrite.
+	ComposePolicies bool
 }
 
 // +kubebuilder:rbac:groups=coralogix.com,resources=tcologspolicies,verbs=get;list;watch;create;update;patch;delete
@@ -52,8 +55,33 @@ func (r *TCOLogsPoliciesReconciler) RequeueInterval() time.Duration {
 	return r.Interval
 }
 
-func (r *TCOLogsPoliciesReconciler) overwrite(ctx context.Context, log logr.Logger, tcoLogsPolicies *coralogixv1alpha1.TCOLogsPolicies) error {
-	overwriteRequest, err := tcoLogsPolicies.Spec.ExtractOverwriteLogPoliciesRequest(ctx, r.ArchiveRetentionsClient)
+func (r *TCOLogsPoliciesReconciler) NewSingletonList() client.ObjectList {
+	if r.ComposePolicies {
+		return nil
+	}
+	return &coralogixv1alpha1.TCOLogsPoliciesList{}
+}
+
+// desiredSpec returns the spec to overwrite the account with. When policies are composed, it also returns
+// the statuses of tcoLogsPolicies' own policies.
+func (r *TCOLogsPoliciesReconciler) desiredSpec(ctx context.Context, tcoLogsPolicies *coralogixv1alpha1.TCOLogsPolicies) (*coralogixv1alpha1.TCOLogsPoliciesSpec, []coralogixv1alpha1.TCOPolicyStatus, error) {
+	if !r.ComposePolicies {
+		return &tcoLogsPolicies.Spec, nil, nil
+	}
+
+	sources, err := listTCOPoliciesSources(ctx, tcoLogsPolicies, &coralogixv1alpha1.TCOLogsPoliciesList{}, func(obj client.Object) tcoPoliciesSource[coralogixv1alpha1.TCOLogsPolicy] {
+		source := obj.(*coralogixv1alpha1.TCOLogsPolicies)
+		return tcoPoliciesSource[coralogixv1alpha1.TCOLogsPolicy]{obj: source, order: ptr.Deref(source.Spec.Order, 0), policies: source.Spec.Policies}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	policies, statuses := composeTCOPolicies(sources, func(policy coralogixv1alpha1.TCOLogsPolicy) string { return policy.Name })
+	return &coralogixv1alpha1.TCOLogsPoliciesSpec{Policies: policies}, statuses[tcoLogsPolicies.UID], nil
+}
+
+func (r *TCOLogsPoliciesReconciler) overwrite(ctx context.Context, log logr.Logger, spec *coralogixv1alpha1.TCOLogsPoliciesSpec) error {
+	overwriteRequest, err := spec.ExtractOverwriteLogPoliciesRequest(ctx, r.ArchiveRetentionsClient)
 	if err != nil {
 		return fmt.Errorf("error on extracting overwrite log policies request: %w", err)
 	}
@@ -75,22 +103,48 @@ func (r *TCOLogsPoliciesReconciler) FinalizerName() string {
 
 func (r *TCOLogsPoliciesReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
 	tcoLogsPolicies := obj.(*coralogixv1alpha1.TCOLogsPolicies)
-	if err := r.overwrite(ctx, log, tcoLogsPolicies); err != nil {
+	spec, statuses, err := r.desiredSpec(ctx, tcoLogsPolicies)
+	if err != nil {
+		return err
+	}
+	if err := r.overwrite(ctx, log, spec); err != nil {
 		return err
 	}
+	if err := updateTCOPolicyStatuses(ctx, tcoLogsPolicies, &tcoLogsPolicies.Status.Policies, statuses); err != nil {
+		return fmt.Errorf("error on updating TCOLogsPolicies status: %w", err)
+	}
 
 	return coralogixreconciler.AddFinalizer(ctx, log, tcoLogsPolicies, r)
 }
 
 func (r *TCOLogsPoliciesReconciler) HandleUpdate(ctx context.Context, log logr.Logger, obj client.Object) error {
 	tcoLogsPolicies := obj.(*coralogixv1alpha1.TCOLogsPolicies)
-	if err := r.overwrite(ctx, log, tcoLogsPolicies); err != nil {
+	spec, statuses, err := r.desiredSpec(ctx, tcoLogsPolicies)
+	if err != nil {
+		return err
+	}
+	if err := r.overwrite(ctx, log, spec); err != nil {
 		return err
 	}
+	if err := updateTCOPolicyStatuses(ctx, tcoLogsPolicies, &tcoLogsPolicies.Status.Policies, statuses); err != nil {
+		return fmt.Errorf("error on updating TCOLogsPolicies status: %w", err)
+	}
+
 	return coralogixreconciler.AddFinalizer(ctx, log, tcoLogsPolicies, r)
 }
 
-func (r *TCOLogsPoliciesReconciler) HandleDeletion(ctx context.Context, log logr.Logger, _ client.Object) error {
+func (r *TCOLogsPoliciesReconciler) HandleDeletion(ctx context.Context, log logr.Logger, obj client.Object) error {
+	if r.ComposePolicies {
+		spec, _, err := r.desiredSpec(ctx, obj.(*coralogixv1alpha1.TCOLogsPolicies))
+		if err != nil {
+			return err
+		}
+		if len(spec.Policies) > 0 {
+			log.Info("Removing TCOLogsPolicies from the composed tco-logs-policies")
+			return r.overwrite(ctx, log, spec)
+		}
+	}
+
 	log.Info("Deleting TCOLogsPolicies")
 	_, httpResp, err := r.TCOPoliciesClient.
 		PoliciesServiceAtomicOverwriteLogPolicies(ctx).
@@ -109,8 +163,17 @@ func (r *TCOLogsPoliciesReconciler) HandleDeletion(ctx context.Context, log logr
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *TCOLogsPoliciesReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	peersHandler := coralogixreconciler.SingletonPeersHandler(r.NewSingletonList)
+	if r.ComposePolicies {
+		peersHandler = coralogixreconciler.ComposedPeersHandler(func() client.ObjectList {
+			return &coralogixv1alpha1.TCOLogsPoliciesList{}
+		})
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.TCOLogsPolicies{}).
+		Watches(&coralogixv1alpha1.TCOLogsPolicies{}, peersHandler).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
@@ -0,0 +1,136 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+)
+
+// tcoPoliciesSource is a TCO policies resource contributing its policies to the composed list.
+type tcoPoliciesSource[P any] struct {
+	obj      client.Object
+	order    int32
+	policies []P
+}
+
+// listTCOPoliciesSources lists the selected, non-deleted resources of obj's kind, using obj itself rather than
+// its possibly stale cached copy. A deleted obj is left out, so that its policies are removed from the account.
+func listTCOPoliciesSources[P any](
+	ctx context.Context,
+	obj client.Object,
+	list client.ObjectList,
+	toSource func(client.Object) tcoPoliciesSource[P],
+) ([]tcoPoliciesSource[P], error) {
+	if err := config.GetClient().List(ctx, list); err != nil {
+		return nil, fmt.Errorf("error on listing %T: %w", list, err)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []tcoPoliciesSource[P]
+	for _, item := range items {
+		candidate, ok := item.(client.Object)
+		if !ok {
+			continue
+		}
+		if candidate.GetUID() == obj.GetUID() {
+			candidate = obj
+		}
+		if !candidate.GetDeletionTimestamp().IsZero() ||
+			!config.GetConfig().Selector.Matches(candidate.GetLabels(), candidate.GetNamespace()) {
+			continue
+		}
+		sources = append(sources, toSource(candidate))
+	}
+	return sources, nil
+}
+
+// composeTCOPolicies merges the policies of all sources into a single list, ordered by the sources' order, then
+// namespace and name, keeping each source's own policy order. A policy whose name was already taken by a
+// previous policy is left out. It returns the composed list along with the status of every policy by source UID.
+func composeTCOPolicies[P any](
+	sources []tcoPoliciesSource[P],
+	policyName func(P) string,
+) ([]P, map[types.UID][]coralogixv1alpha1.TCOPolicyStatus) {
+	sources = append([]tcoPoliciesSource[P](nil), sources...)
+	sort.SliceStable(sources, func(i, j int) bool {
+		a, b := sources[i], sources[j]
+		if a.order != b.order {
+			return a.order < b.order
+		}
+		if a.obj.GetNamespace() != b.obj.GetNamespace() {
+			return a.obj.GetNamespace() < b.obj.GetNamespace()
+		}
+		return a.obj.GetName() < b.obj.GetName()
+	})
+
+	type owner struct {
+		source   client.Object
+		position int32
+	}
+	owners := make(map[string]owner)
+	var policies []P
+	statuses := make(map[types.UID][]coralogixv1alpha1.TCOPolicyStatus, len(sources))
+	for _, source := range sources {
+		for _, policy := range source.policies {
+			name := policyName(policy)
+			if existing, ok := owners[name]; ok {
+				statuses[source.obj.GetUID()] = append(statuses[source.obj.GetUID()], coralogixv1alpha1.TCOPolicyStatus{
+					Name:   name,
+					Active: false,
+					Message: fmt.Sprintf("policy name collides with the one of %s/%s at position %d",
+						existing.source.GetNamespace(), existing.source.GetName(), existing.position),
+				})
+				continue
+			}
+
+			position := int32(len(policies))
+			owners[name] = owner{source: source.obj, position: position}
+			policies = append(policies, policy)
+			statuses[source.obj.GetUID()] = append(statuses[source.obj.GetUID()], coralogixv1alpha1.TCOPolicyStatus{
+				Name:     name,
+				Active:   true,
+				Position: &position,
+			})
+		}
+	}
+	return policies, statuses
+}
+
+// updateTCOPolicyStatuses persists the statuses of obj's policies, if they changed.
+func updateTCOPolicyStatuses(
+	ctx context.Context,
+	obj client.Object,
+	current *[]coralogixv1alpha1.TCOPolicyStatus,
+	statuses []coralogixv1alpha1.TCOPolicyStatus,
+) error {
+	if reflect.DeepEqual(*current, statuses) {
+		return nil
+	}
+	*current = statuses
+	return config.GetClient().Status().Update(ctx, obj)
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+)
+
+func TestComposeTCOPoliciesOrdersSourcesAndReportsCollisions(t *testing.T) {
+	platform := tcoLogsPolicies("platform", "platform", ptr.To(int32(-10)), "drop-debug", "keep-audit")
+	teamB := tcoLogsPolicies("team-b", "policies", nil, "team-b-low", "drop-debug")
+	teamA := tcoLogsPolicies("team-a", "policies", nil, "team-a-high")
+
+	sources := []tcoPoliciesSource[coralogixv1alpha1.TCOLogsPolicy]{
+		tcoLogsPoliciesSource(teamB),
+		tcoLogsPoliciesSource(platform),
+		tcoLogsPoliciesSource(teamA),
+	}
+	policies, statuses := composeTCOPolicies(sources, func(policy coralogixv1alpha1.TCOLogsPolicy) string { return policy.Name })
+
+	var names []string
+	for _, policy := range policies {
+		names = append(names, policy.Name)
+	}
+	require.Equal(t, []string{"drop-debug", "keep-audit", "team-a-high", "team-b-low"}, names)
+
+	require.Equal(t, []coralogixv1alpha1.TCOPolicyStatus{
+		{Name: "drop-debug", Active: true, Position: ptr.To(int32(0))},
+		{Name: "keep-audit", Active: true, Position: ptr.To(int32(1))},
+	}, statuses[platform.UID])
+	require.Equal(t, []coralogixv1alpha1.TCOPolicyStatus{
+		{Name: "team-a-high", Active: true, Position: ptr.To(int32(2))},
+	}, statuses[teamA.UID])
+	require.Equal(t, []coralogixv1alpha1.TCOPolicyStatus{
+		{Name: "team-b-low", Active: true, Position: ptr.To(int32(3))},
+		{Name: "drop-debug", Active: false, Message: "policy name collides with the one of platform/platform at position 0"},
+	}, statuses[teamB.UID])
+}
+
+func TestTCOLogsPoliciesDesiredSpecComposesSelectedResources(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+
+	current := tcoLogsPolicies("team-a", "policies", ptr.To(int32(1)), "team-a")
+	platform := tcoLogsPolicies("platform", "platform", nil, "platform")
+	deleting := tcoLogsPolicies("team-b", "policies", nil, "team-b")
+	deleting.DeletionTimestamp = ptr.To(metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+	deleting.Finalizers = []string{"tco-logs-policies.coralogix.com/finalizer"}
+
+	originalClient := config.GetClient()
+	originalSelector := config.GetConfig().Selector
+	t.Cleanup(func() {
+		config.InitClient(originalClient)
+		config.GetConfig().Selector = originalSelector
+	})
+
+	config.InitClient(fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(current, platform, deleting).
+		Build())
+	config.GetConfig().Selector = config.Selector{}
+
+	// The reconciled resource takes precedence over its cached copy.
+	current.Spec.Policies = append(current.Spec.Policies, coralogixv1alpha1.TCOLogsPolicy{Name: "team-a-new"})
+
+	r := &TCOLogsPoliciesReconciler{ComposePolicies: true}
+	spec, statuses, err := r.desiredSpec(context.Background(), current)
+	require.NoError(t, err)
+	require.Len(t, spec.Policies, 3)
+	require.Equal(t, "platform", spec.Policies[0].Name)
+	require.Equal(t, "team-a", spec.Policies[1].Name)
+	require.Equal(t, "team-a-new", spec.Policies[2].Name)
+	require.Equal(t, []coralogixv1alpha1.TCOPolicyStatus{
+		{Name: "team-a", Active: true, Position: ptr.To(int32(1))},
+		{Name: "team-a-new", Active: true, Position: ptr.To(int32(2))},
+	}, statuses)
+
+	r.ComposePolicies = false
+	spec, statuses, err = r.desiredSpec(context.Background(), current)
+	require.NoError(t, err)
+	require.Same(t, &current.Spec, spec)
+	require.Nil(t, statuses)
+}
+
+func tcoLogsPolicies(namespace, name string, order *int32, policyNames ...string) *coralogixv1alpha1.TCOLogsPolicies {
+	tcoLogsPolicies := &coralogixv1alpha1.TCOLogsPolicies{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       types.UID(namespace + "/" + name),
+		},
+		Spec: coralogixv1alpha1.TCOLogsPoliciesSpec{Order: order},
+	}
+	for _, policyName := range policyNames {
+		tcoLogsPolicies.Spec.Policies = append(tcoLogsPolicies.Spec.Policies, coralogixv1alpha1.TCOLogsPolicy{Name: policyName})
+	}
+	return tcoLogsPolicies
+}
+
+func tcoLogsPoliciesSource(tcoLogsPolicies *coralogixv1alpha1.TCOLogsPolicies) tcoPoliciesSource[coralogixv1alpha1.TCOLogsPolicy] {
+	return tcoPoliciesSource[coralogixv1alpha1.TCOLogsPolicy]{
+		obj:      tcoLogsPolicies,
+		order:    ptr.Deref(tcoLogsPolicies.Spec.Order, 0),
+		policies: tcoLogsPolicies.Spec.Policies,
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -38,6 +39,8 @@ type TCORumPoliciesReconciler struct {
 	TCOPoliciesClient       *tcopolicies.PoliciesServiceAPIService
 	ArchiveRetentionsClient *archiveretentions.RetentionsServiceAPIService
 	Interval                time.Duration
+	// ComposePolicies merges the policies of all selected TCORumPolicies into a single overwrite.
+	ComposePolicies bool
 }
 
 // +kubebuilder:rbac:groups=coralogix.com,resources=tcorumpolicies,verbs=get;list;watch;create;update;patch;delete
@@ -52,8 +55,33 @@ func (r *TCORumPoliciesReconciler) RequeueInterval() time.Duration {
 	return r.Interval
 }
 
-func (r *TCORumPoliciesReconciler) overwrite(ctx context.Context, log logr.Logger, tcoRumPolicies *coralogixv1alpha1.TCORumPolicies) error {
-	overwriteRequest, err := tcoRumPolicies.Spec.ExtractOverwriteRumPoliciesRequest(ctx, r.ArchiveRetentionsClient)
+func (r *TCORumPoliciesReconciler) NewSingletonList() client.ObjectList {
+	if r.ComposePolicies {
+		return nil
+	}
+	return &coralogixv1alpha1.TCORumPoliciesList{}
+}
+
+// desiredSpec returns the spec to overwrite the account with. When policies are composed, it also returns
+// the statuses of tcoRumPolicies' own policies.
+func (r *TCORumPoliciesReconciler) desiredSpec(ctx context.Context, tcoRumPolicies *coralogixv1alpha1.TCORumPolicies) (*coralogixv1alpha1.TCORumPoliciesSpec, []coralogixv1alpha1.TCOPolicyStatus, error) {
+	if !r.ComposePolicies {
+		return &tcoRumPolicies.Spec, nil, nil
+	}
+
+	sources, err := listTCOPoliciesSources(ctx, tcoRumPolicies, &coralogixv1alpha1.TCORumPoliciesList{}, func(obj client.Object) tcoPoliciesSource[coralogixv1alpha1.TCORumPolicy] {
+		source := obj.(*coralogixv1alpha1.TCORumPolicies)
+		return tcoPoliciesSource[coralogixv1alpha1.TCORumPolicy]{obj: source, order: ptr.Deref(source.Spec.Order, 0), policies: source.Spec.Policies}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	policies, statuses := composeTCOPolicies(sources, func(policy coralogixv1alpha1.TCORumPolicy) string { return policy.Name })
+	return &coralogixv1alpha1.TCORumPoliciesSpec{Policies: policies}, statuses[tcoRumPolicies.UID], nil
+}
+
+func (r *TCORumPoliciesReconciler) overwrite(ctx context.Context, log logr.Logger, spec *coralogixv1alpha1.TCORumPoliciesSpec) error {
+	overwriteRequest, err := spec.ExtractOverwriteRumPoliciesRequest(ctx, r.ArchiveRetentionsClient)
 	if err != nil {
 		return fmt.Errorf("error on extracting overwrite rum policies request: %w", err)
 	}
@@ -75,22 +103,48 @@ func (r *TCORumPoliciesReconciler) FinalizerName() string {
 
 func (r *TCORumPoliciesReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
 	tcoRumPolicies := obj.(*coralogixv1alpha1.TCORumPolicies)
-	if err := r.overwrite(ctx, log, tcoRumPolicies); err != nil {
+	spec, statuses, err := r.desiredSpec(ctx, tcoRumPolicies)
+	if err != nil {
+		return err
+	}
+	if err := r.overwrite(ctx, log, spec); err != nil {
 		return err
 	}
+	if err := updateTCOPolicyStatuses(ctx, tcoRumPolicies, &tcoRumPolicies.Status.Policies, statuses); err != nil {
+		return fmt.Errorf("error on updating TCORumPolicies status: %w", err)
+	}
 
 	return coralogixreconciler.AddFinalizer(ctx, log, tcoRumPolicies, r)
 }
 
 func (r *TCORumPoliciesReconciler) HandleUpdate(ctx context.Context, log logr.Logger, obj client.Object) error {
 	tcoRumPolicies := obj.(*coralogixv1alpha1.TCORumPolicies)
-	if err := r.overwrite(ctx, log, tcoRumPolicies); err != nil {
+	spec, statuses, err := r.desiredSpec(ctx, tcoRumPolicies)
+	if err != nil {
+		return err
+	}
+	if err := r.overwrite(ctx, log, spec); err != nil {
 		return err
 	}
+	if err := updateTCOPolicyStatuses(ctx, tcoRumPolicies, &tcoRumPolicies.Status.Policies, statuses); err != nil {
+		return fmt.Errorf("error on updating TCORumPolicies status: %w", err)
+	}
+
 	return coralogixreconciler.AddFinalizer(ctx, log, tcoRumPolicies, r)
 }
 
-func (r *TCORumPoliciesReconciler) HandleDeletion(ctx context.Context, log logr.Logger, _ client.Object) error {
+func (r *TCORumPoliciesReconciler) HandleDeletion(ctx context.Context, log logr.Logger, obj client.Object) error {
+	if r.ComposePolicies {
+		spec, _, err := r.desiredSpec(ctx, obj.(*coralogixv1alpha1.TCORumPolicies))
+		if err != nil {
+			return err
+		}
+		if len(spec.Policies) > 0 {
+			log.Info("Removing TCORumPolicies from the composed tco-rum-policies")
+			return r.overwrite(ctx, log, spec)
+		}
+	}
+
 	log.Info("Deleting TCORumPolicies")
 	_, httpResp, err := r.TCOPoliciesClient.
 		PoliciesServiceAtomicOverwriteRumPolicies(ctx).
@@ -108,8 +162,17 @@ func (r *TCORumPoliciesReconciler) HandleDeletion(ctx context.Context, log logr.
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *TCORumPoliciesReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	peersHandler := coralogixreconciler.SingletonPeersHandler(r.NewSingletonList)
+	if r.ComposePolicies {
+		peersHandler = coralogixreconciler.ComposedPeersHandler(func() client.ObjectList {
+			return &coralogixv1alpha1.TCORumPoliciesList{}
+		})
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.TCORumPolicies{}).
+		Watches(&coralogixv1alpha1.TCORumPolicies{}, peersHandler).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -38,6 +39,8 @@ type TCOTracesPoliciesReconciler struct {
 	TCOPoliciesClient       *tcopolicies.PoliciesServiceAPIService
 	ArchiveRetentionsClient *archiveretentions.RetentionsServiceAPIService
 	Interval                time.Duration
+	// ComposePolicies merges the policies of all selected TCOTracesPolicies into a single overwrite.
+	ComposePolicies bool
 }
 
 // +kubebuilder:rbac:groups=coralogix.com,resources=tcotracespolicies,verbs=get;list;watch;create;update;patch;delete
@@ -52,8 +55,33 @@ func (r *TCOTracesPoliciesReconciler) RequeueInterval() time.Duration {
 	return r.Interval
 }
 
-func (r *TCOTracesPoliciesReconciler) overwrite(ctx context.Context, log logr.Logger, tcoTracesPolicies *coralogixv1alpha1.TCOTracesPolicies) error {
-	overwriteRequest, err := tcoTracesPolicies.Spec.ExtractOverwriteTracesPoliciesRequest(ctx, r.ArchiveRetentionsClient)
+func (r *TCOTracesPoliciesReconciler) NewSingletonList() client.ObjectList {
+	if r.ComposePolicies {
+		return nil
+	}
+	return &coralogixv1alpha1.TCOTracesPoliciesList{}
+}
+
+// desiredSpec returns the spec to overwrite the account with. When policies are composed, it also returns
+// the statuses of tcoTracesPolicies' own policies.
+func (r *TCOTracesPoliciesReconciler) desiredSpec(ctx context.Context, tcoTracesPolicies *coralogixv1alpha1.TCOTracesPolicies) (*coralogixv1alpha1.TCOTracesPoliciesSpec, []coralogixv1alpha1.TCOPolicyStatus, error) {
+	if !r.ComposePolicies {
+		return &tcoTracesPolicies.Spec, nil, nil
+	}
+
+	sources, err := listTCOPoliciesSources(ctx, tcoTracesPolicies, &coralogixv1alpha1.TCOTracesPoliciesList{}, func(obj client.Object) tcoPoliciesSource[coralogixv1alpha1.TCOTracesPolicy] {
+		source := obj.(*coralogixv1alpha1.TCOTracesPolicies)
+		return tcoPoliciesSource[coralogixv1alpha1.TCOTracesPolicy]{obj: source, order: ptr.Deref(source.Spec.Order, 0), policies: source.Spec.Policies}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	policies, statuses := composeTCOPolicies(sources, func(policy coralogixv1alpha1.TCOTracesPolicy) string { return policy.Name })
+	return &coralogixv1alpha1.TCOTracesPoliciesSpec{Policies: policies}, statuses[tcoTracesPolicies.UID], nil
+}
+
+func (r *TCOTracesPoliciesReconciler) overwrite(ctx context.Context, log logr.Logger, spec *coralogixv1alpha1.TCOTracesPoliciesSpec) error {
+	overwriteRequest, err := spec.ExtractOverwriteTracesPoliciesRequest(ctx, r.ArchiveRetentionsClient)
 	if err != nil {
 		return fmt.Errorf("error on extracting overwrite log policies request: %w", err)
 	}
@@ -76,21 +104,48 @@ func (r *TCOTracesPoliciesReconciler) FinalizerName() string {
 
 func (r *TCOTracesPoliciesReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
 	tcoTracesPolicies := obj.(*coralogixv1alpha1.TCOTracesPolicies)
-	if err := r.overwrite(ctx, log, tcoTracesPolicies); err != nil {
+	spec, statuses, err := r.desiredSpec(ctx, tcoTracesPolicies)
+	if err != nil {
 		return err
 	}
+	if err := r.overwrite(ctx, log, spec); err != nil {
+		return err
+	}
+	if err := updateTCOPolicyStatuses(ctx, tcoTracesPolicies, &tcoTracesPolicies.Status.Policies, statuses); err != nil {
+		return fmt.Errorf("error on updating TCOTracesPolicies status: %w", err)
+	}
+
 	return coralogixreconciler.AddFinalizer(ctx, log, tcoTracesPolicies, r)
 }
 
 func (r *TCOTracesPoliciesReconciler) HandleUpdate(ctx context.Context, log logr.Logger, obj client.Object) error {
 	tcoTracesPolicies := obj.(*coralogixv1alpha1.TCOTracesPolicies)
-	if err := r.overwrite(ctx, log, tcoTracesPolicies); err != nil {
+	spec, statuses, err := r.desiredSpec(ctx, tcoTracesPolicies)
+	if err != nil {
+		return err
+	}
+	if err := r.overwrite(ctx, log, spec); err != nil {
 		return err
 	}
+	if err := updateTCOPolicyStatuses(ctx, tcoTracesPolicies, &tcoTracesPolicies.Status.Policies, statuses); err != nil {
+		return fmt.Errorf("error on updating TCOTracesPolicies status: %w", err)
+	}
+
 	return coralogixreconciler.AddFinalizer(ctx, log, tcoTracesPolicies, r)
 }
 
-func (r *TCOTracesPoliciesReconciler) HandleDeletion(ctx context.Context, log logr.Logger, _ client.Object) error {
+func (r *TCOTracesPoliciesReconciler) HandleDeletion(ctx context.Context, log logr.Logger, obj client.Object) error {
+	if r.ComposePolicies {
+		spec, _, err := r.desiredSpec(ctx, obj.(*coralogixv1alpha1.TCOTracesPolicies))
+		if err != nil {
+			return err
+		}
+		if len(spec.Policies) > 0 {
+			log.Info("Removing TCOTracesPolicies from the composed tco-traces-policies")
+			return r.overwrite(ctx, log, spec)
+		}
+	}
+
 	log.Info("Deleting TCOTracesPolicies")
 	_, httpResp, err := r.TCOPoliciesClient.
 		PoliciesServiceAtomicOverwriteSpanPolicies(ctx).
@@ -109,8 +164,17 @@ func (r *TCOTracesPoliciesReconciler) HandleDeletion(ctx context.Context, log lo
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *TCOTracesPoliciesReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	peersHandler := coralogixreconciler.SingletonPeersHandler(r.NewSingletonList)
+	if r.ComposePolicies {
+		peersHandler = coralogixreconciler.ComposedPeersHandler(func() client.ObjectList {
+			return &coralogixv1alpha1.TCOTracesPoliciesList{}
+		})
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&coralogixv1alpha1.TCOTracesPolicies{}).
+		Watches(&coralogixv1alpha1.TCOTracesPolicies{}, peersHandler).
 		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
 		Complete(r)
 }
@@ -0,0 +1,146 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cxsdk "github.com/coralogix/coralogix-management-sdk/go"
+	oapicxsdk "github.com/coralogix/coralogix-management-sdk/go/openapi/cxsdk"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// UserReconciler reconciles a User object
+type UserReconciler struct {
+	UsersClient *cxsdk.UsersClient
+	Interval    time.Duration
+}
+
+// +kubebuilder:rbac:groups=coralogix.com,resources=users,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=coralogix.com,resources=users/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=coralogix.com,resources=users/finalizers,verbs=update
+
+var _ coralogixreconciler.CoralogixReconciler = &UserReconciler{}
+
+func (r *UserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return coralogixreconciler.ReconcileResource(ctx, req, &coralogixv1alpha1.User{}, r)
+}
+
+func (r *UserReconciler) FinalizerName() string {
+	return "user.coralogix.com/finalizer"
+}
+
+func (r *UserReconciler) RequeueInterval() time.Duration {
+	return r.Interval
+}
+
+func (r *UserReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
+	user := obj.(*coralogixv1alpha1.User)
+
+	// Users who logged in before being provisioned already exist, and SCIM rejects a second user with their name.
+	existingID, err := r.findUserID(ctx, user.Spec.UserName)
+	if err != nil {
+		return fmt.Errorf("error on listing remote users: %w", err)
+	}
+
+	if existingID != nil {
+		updateRequest := user.Spec.ExtractSCIMUser(existingID)
+		log.Info("Adopting existing remote user", "user", utils.FormatJSON(updateRequest))
+		updateResponse, err := r.UsersClient.Update(ctx, updateRequest)
+		if err != nil {
+			return fmt.Errorf("error on updating existing remote user: %w", err)
+		}
+		log.Info("Existing remote user adopted", "user", utils.FormatJSON(updateResponse))
+		user.Status = coralogixv1alpha1.UserStatus{ID: existingID}
+		return nil
+	}
+
+	createRequest := user.Spec.ExtractSCIMUser(nil)
+	log.Info("Creating remote user", "user", utils.FormatJSON(createRequest))
+	createResponse, err := r.UsersClient.Create(ctx, createRequest)
+	if err != nil {
+		return fmt.Errorf("error on creating remote user: %w", err)
+	}
+	log.Info("Remote user created", "response", utils.FormatJSON(createResponse))
+
+	user.Status = coralogixv1alpha1.UserStatus{
+		ID: createResponse.ID,
+	}
+
+	return nil
+}
+
+func (r *UserReconciler) HandleUpdate(ctx context.Context, log logr.Logger, obj client.Object) error {
+	user := obj.(*coralogixv1alpha1.User)
+	updateRequest := user.Spec.ExtractSCIMUser(user.Status.ID)
+	log.Info("Updating remote user", "user", utils.FormatJSON(updateRequest))
+	updateResponse, err := r.UsersClient.Update(ctx, updateRequest)
+	if err != nil {
+		return err
+	}
+	log.Info("Remote user updated", "user", utils.FormatJSON(updateResponse))
+
+	return nil
+}
+
+// HandleDeletion deactivates the remote user instead of deleting it, since it is a person's account, which may have
+// existed before the User was created and adopted it.
+func (r *UserReconciler) HandleDeletion(ctx context.Context, log logr.Logger, obj client.Object) error {
+	user := obj.(*coralogixv1alpha1.User)
+	id := *user.Status.ID
+	deactivateRequest := user.Spec.ExtractSCIMUser(&id)
+	deactivateRequest.Active = false
+	log.Info("Deactivating user in remote system", "id", id)
+	if _, err := r.UsersClient.Update(ctx, deactivateRequest); err != nil && !oapicxsdk.IsNotFound(err) {
+		log.Error(err, "Error deactivating remote user", "id", id)
+		return fmt.Errorf("error deactivating remote user %s: %w", id, err)
+	}
+	log.Info("User deactivated in remote system", "id", id)
+	return nil
+}
+
+func (r *UserReconciler) findUserID(ctx context.Context, userName string) (*string, error) {
+	users, err := r.UsersClient.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		if user.UserName == userName {
+			return user.ID, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *UserReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&coralogixv1alpha1.User{}).
+		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
+		Complete(r)
+}
@@ -17,18 +17,23 @@ package v1beta1
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/coralogix/coralogix-management-sdk/go/openapi/cxsdk"
 	alerts "github.com/coralogix/coralogix-management-sdk/go/openapi/gen/alert_definitions_service"
 
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
 	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
 	"github.com/coralogix/coralogix-operator/v2/internal/config"
 	coralogixreconciler "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
+	"github.com/coralogix/coralogix-operator/v2/internal/provenance"
 	"github.com/coralogix/coralogix-operator/v2/internal/utils"
 )
 
@@ -41,6 +46,9 @@ type AlertReconciler struct {
 // +kubebuilder:rbac:groups=coralogix.com,resources=alerts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=coralogix.com,resources=alerts/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=coralogix.com,resources=alerts/finalizers,verbs=update
+// +kubebuilder:rbac:groups=coralogix.com,resources=coralogixdefaults,verbs=get;list;watch
+
+var _ coralogixreconciler.DeadlineReconciler = &AlertReconciler{}
 
 func (r *AlertReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	return coralogixreconciler.ReconcileResource(ctx, req, &coralogixv1beta1.Alert{}, r)
@@ -54,19 +62,31 @@ func (r *AlertReconciler) FinalizerName() string {
 	return "alert.coralogix.com/finalizer"
 }
 
+// RequeueDeadline requeues the alert when the shadow alert of its rollout is due for promotion.
+func (r *AlertReconciler) RequeueDeadline(obj client.Object) time.Duration {
+	return obj.(*coralogixv1beta1.Alert).Status.Rollout.PromotionDelay(time.Now())
+}
+
 func (r *AlertReconciler) HandleCreation(ctx context.Context, log logr.Logger, obj client.Object) error {
 	alert := obj.(*coralogixv1beta1.Alert)
+	defaults, err := coralogixv1alpha1.GetAlertDefaults(ctx, alert.Namespace)
+	if err != nil {
+		return fmt.Errorf("error on getting alert defaults: %w", err)
+	}
+
 	props, err := alert.Spec.ExtractAlertDefProperties(
 		&coralogixv1beta1.GetResourceRefProperties{
 			Ctx:       ctx,
 			Log:       log,
 			ClientSet: r.ClientSet,
 			Namespace: alert.Namespace,
+			Defaults:  defaults,
 		},
 	)
 	if err != nil {
 		return fmt.Errorf("error on extracting alert properties: %w", err)
 	}
+	props.EntityLabels = ptr.To(provenance.WithLabels(ptr.Deref(props.EntityLabels, nil), alert))
 
 	createRequest := &alerts.CreateAlertDefinitionRequest{
 		AlertDefProperties: props,
@@ -81,42 +101,131 @@ func (r *AlertReconciler) HandleCreation(ctx context.Context, log logr.Logger, o
 		return fmt.Errorf("error on creating remote alert: %w", cxsdk.NewAPIError(httpResp, err))
 	}
 	log.Info("Remote alert created", "response", utils.FormatJSON(createResponse))
-	alert.Status = coralogixv1beta1.AlertStatus{ID: createResponse.AlertDef.Id}
+	_, appliedDefaults := alert.Spec.WithDefaults(defaults)
+	_, rollout := alert.Spec.NextRolloutStep(nil, false, time.Now())
+	alert.Status = coralogixv1beta1.AlertStatus{
+		ID:              createResponse.AlertDef.Id,
+		CreatedAt:       coralogixreconciler.RemoteTime(createResponse.AlertDef.CreatedTime),
+		UpdatedAt:       coralogixreconciler.RemoteTime(createResponse.AlertDef.UpdatedTime),
+		AppliedDefaults: appliedDefaults,
+		Rollout:         rollout,
+	}
 	return nil
 }
 
 func (r *AlertReconciler) HandleUpdate(ctx context.Context, log logr.Logger, obj client.Object) error {
 	alert := obj.(*coralogixv1beta1.Alert)
+	defaults, err := coralogixv1alpha1.GetAlertDefaults(ctx, alert.Namespace)
+	if err != nil {
+		return fmt.Errorf("error on getting alert defaults: %w", err)
+	}
+
 	props, err := alert.Spec.ExtractAlertDefProperties(
 		&coralogixv1beta1.GetResourceRefProperties{
 			Ctx:       ctx,
 			Log:       log,
 			ClientSet: r.ClientSet,
 			Namespace: alert.Namespace,
+			Defaults:  defaults,
 		},
 	)
 	if err != nil {
 		return fmt.Errorf("error on extracting alert properties: %w", err)
 	}
+	props.EntityLabels = ptr.To(provenance.WithLabels(ptr.Deref(props.EntityLabels, nil), alert))
 
 	if alert.Status.ID == nil {
 		return fmt.Errorf("alert ID is missing")
 	}
+	if err := r.checkProvenance(ctx, alert); err != nil {
+		return err
+	}
 
-	updateRequest := alerts.ReplaceAlertDefinitionRequest{
-		AlertDefProperties: props,
-		Id:                 alert.Status.ID,
+	_, rollback := alert.Annotations[utils.RollbackAnnotationKey]
+	step, rollout := alert.Spec.NextRolloutStep(alert.Status.Rollout, rollback, time.Now())
+	if step != coralogixv1beta1.AlertRolloutStepSync {
+		log.Info("Rolling out alert", "step", step)
 	}
 
-	log.Info("Updating remote alert", "alert", utils.FormatJSON(updateRequest))
-	updateResponse, httpResp, err := r.ClientSet.Alerts().
-		AlertDefsServiceReplaceAlertDef(ctx).
-		ReplaceAlertDefinitionRequest(updateRequest).
-		Execute()
-	if err != nil {
-		return cxsdk.NewAPIError(httpResp, err)
+	_, appliedDefaults := alert.Spec.WithDefaults(defaults)
+	createdAt, updatedAt := alert.Status.CreatedAt, alert.Status.UpdatedAt
+	switch step {
+	case coralogixv1beta1.AlertRolloutStepSync, coralogixv1beta1.AlertRolloutStepPromote:
+		updateRequest := alerts.ReplaceAlertDefinitionRequest{
+			AlertDefProperties: props,
+			Id:                 alert.Status.ID,
+		}
+
+		log.Info("Updating remote alert", "alert", utils.FormatJSON(updateRequest))
+		updateResponse, httpResp, err := r.ClientSet.Alerts().
+			AlertDefsServiceReplaceAlertDef(ctx).
+			ReplaceAlertDefinitionRequest(updateRequest).
+			Execute()
+		if err != nil {
+			return cxsdk.NewAPIError(httpResp, err)
+		}
+		log.Info("Remote alert updated", "alert", utils.FormatJSON(updateResponse))
+		alertDef := updateResponse.GetAlertDef()
+		createdAt, updatedAt = coralogixreconciler.RemoteTime(alertDef.CreatedTime), coralogixreconciler.RemoteTime(alertDef.UpdatedTime)
+	case coralogixv1beta1.AlertRolloutStepCreateShadow:
+		createRequest := alerts.CreateAlertDefinitionRequest{
+			AlertDefProperties: coralogixv1beta1.ShadowAlertDefProperties(props),
+		}
+
+		log.Info("Creating remote shadow alert", "alert", utils.FormatJSON(createRequest))
+		createResponse, httpResp, err := r.ClientSet.Alerts().
+			AlertDefsServiceCreateAlertDef(ctx).
+			CreateAlertDefinitionRequest(createRequest).
+			Execute()
+		if err != nil {
+			return fmt.Errorf("error on creating remote shadow alert: %w", cxsdk.NewAPIError(httpResp, err))
+		}
+		log.Info("Remote shadow alert created", "response", utils.FormatJSON(createResponse))
+		rollout.ShadowID = createResponse.AlertDef.Id
+	case coralogixv1beta1.AlertRolloutStepReplaceShadow:
+		updateRequest := alerts.ReplaceAlertDefinitionRequest{
+			AlertDefProperties: coralogixv1beta1.ShadowAlertDefProperties(props),
+			Id:                 rollout.ShadowID,
+		}
+
+		log.Info("Updating remote shadow alert", "alert", utils.FormatJSON(updateRequest))
+		updateResponse, httpResp, err := r.ClientSet.Alerts().
+			AlertDefsServiceReplaceAlertDef(ctx).
+			ReplaceAlertDefinitionRequest(updateRequest).
+			Execute()
+		if err != nil {
+			return fmt.Errorf("error on updating remote shadow alert: %w", cxsdk.NewAPIError(httpResp, err))
+		}
+		log.Info("Remote shadow alert updated", "alert", utils.FormatJSON(updateResponse))
+	default:
+		// The live alert keeps the spec it was last synced with.
+		appliedDefaults = alert.Status.AppliedDefaults
+	}
+
+	if step == coralogixv1beta1.AlertRolloutStepPromote || step == coralogixv1beta1.AlertRolloutStepRollback {
+		if err := r.deleteShadowAlert(ctx, log, *alert.Status.Rollout.ShadowID); err != nil {
+			return err
+		}
+	}
+
+	if rollback {
+		patch := client.MergeFrom(alert.DeepCopy())
+		delete(alert.Annotations, utils.RollbackAnnotationKey)
+		if err := config.GetClient().Patch(ctx, alert, patch); err != nil {
+			return fmt.Errorf("error on removing alert rollback annotation: %w", err)
+		}
+	}
+
+	if !reflect.DeepEqual(alert.Status.AppliedDefaults, appliedDefaults) || !reflect.DeepEqual(alert.Status.Rollout, rollout) ||
+		!createdAt.Equal(alert.Status.CreatedAt) || !updatedAt.Equal(alert.Status.UpdatedAt) {
+		alert.Status.AppliedDefaults = appliedDefaults
+		alert.Status.Rollout = rollout
+		alert.Status.CreatedAt = createdAt
+		alert.Status.UpdatedAt = updatedAt
+		if err := config.GetClient().Status().Update(ctx, alert); err != nil {
+			return fmt.Errorf("error on updating alert status: %w", err)
+		}
 	}
-	log.Info("Remote alert updated", "alert", utils.FormatJSON(updateResponse))
 	return nil
 }
 
@@ -125,6 +234,20 @@ func (r *AlertReconciler) HandleDeletion(ctx context.Context, log logr.Logger, o
 	if alert.Status.ID == nil {
 		return fmt.Errorf("alert ID is missing")
 	}
+	if err := r.checkProvenance(ctx, alert); err != nil {
+		if provenance.IsForeignError(err) {
+			log.Info("Skipping deletion of remote alert managed by another resource", "id", *alert.Status.ID, "reason", err.Error())
+			return nil
+		}
+		if !cxsdk.IsNotFound(err) {
+			return fmt.Errorf("error getting remote alert %s: %w", *alert.Status.ID, err)
+		}
+	}
+	if rollout := alert.Status.Rollout; rollout != nil && rollout.ShadowID != nil {
+		if err := r.deleteShadowAlert(ctx, log, *rollout.ShadowID); err != nil {
+			return err
+		}
+	}
 	log.Info("Deleting alert from remote system", "id", *alert.Status.ID)
 	_, httpResp, err := r.ClientSet.Alerts().
 		AlertDefsServiceDeleteAlertDef(ctx, *alert.Status.ID).
@@ -139,10 +262,42 @@ func (r *AlertReconciler) HandleDeletion(ctx context.Context, log logr.Logger, o
 	return nil
 }
 
+func (r *AlertReconciler) deleteShadowAlert(ctx context.Context, log logr.Logger, id string) error {
+	log.Info("Deleting shadow alert from remote system", "id", id)
+	_, httpResp, err := r.ClientSet.Alerts().
+		AlertDefsServiceDeleteAlertDef(ctx, id).
+		Execute()
+	if err != nil {
+		if apiErr := cxsdk.NewAPIError(httpResp, err); !cxsdk.IsNotFound(apiErr) {
+			return fmt.Errorf("error deleting remote shadow alert %s: %w", id, apiErr)
+		}
+	}
+	log.Info("Shadow alert deleted from remote system", "id", id)
+	return nil
+}
+
+// checkProvenance returns a provenance.ForeignError if the remote alert is managed by another resource.
+func (r *AlertReconciler) checkProvenance(ctx context.Context, alert *coralogixv1beta1.Alert) error {
+	if !provenance.Enabled() {
+		return nil
+	}
+	getResponse, httpResp, err := r.ClientSet.Alerts().
+		AlertDefsServiceGetAlertDef(ctx, *alert.Status.ID).
+		Execute()
+	if err != nil {
+		return cxsdk.NewAPIError(httpResp, err)
+	}
+	alertDef := getResponse.GetAlertDef()
+	properties := alertDef.GetAlertDefProperties()
+	return provenance.Check(alert, properties.GetEntityLabels())
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *AlertReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&coralogixv1beta1.Alert{}).
-		WithEventFilter(config.GetConfig().Selector.Predicate()).
+		For(&coralogixv1beta1.Alert{}, builder.WithPredicates(config.GetConfig().Selector.Predicate(), coralogixreconciler.IgnoreInformationalStatusUpdates())).
+		Watches(&coralogixv1alpha1.CoralogixDefaults{}, coralogixreconciler.EnqueueForCoralogixDefaults(func() client.ObjectList {
+			return &coralogixv1beta1.AlertList{}
+		})).
 		Complete(r)
 }
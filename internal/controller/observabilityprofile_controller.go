@@ -0,0 +1,309 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	coralogixreconciler "github.com/coralogix/coralogix-operator/v2/internal/controller/coralogix/coralogix-reconciler"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+//+kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch
+
+//+kubebuilder:rbac:groups=coralogix.com,resources=observabilityprofiles,verbs=get;list;watch
+
+//+kubebuilder:rbac:groups=coralogix.com,resources=dashboards,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=coralogix.com,resources=alerts,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=coralogix.com,resources=alertsets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=coralogix.com,resources=slos,verbs=get;list;watch;create;update;patch;delete
+
+// ObservabilityProfileReconciler generates the Dashboards, Alerts, AlertSets and SLOs of the observability profile of the
+// workloads of one kind, e.g. Deployments.
+type ObservabilityProfileReconciler struct {
+	// NewWorkload returns an empty workload of the reconciled kind.
+	NewWorkload func() client.Object
+	// NewWorkloadList returns an empty list of workloads of the reconciled kind.
+	NewWorkloadList func() client.ObjectList
+	Interval        time.Duration
+}
+
+func (r *ObservabilityProfileReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	workload := r.NewWorkload()
+	if err := config.GetClient().Get(ctx, req.NamespacedName, workload); err != nil {
+		// Generated resources are garbage collected via their owner references.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	var profile *coralogixv1alpha1.ObservabilityProfileSpec
+	if profileRef := workload.GetAnnotations()[utils.ObservabilityProfileAnnotationKey]; profileRef != "" &&
+		workload.GetDeletionTimestamp().IsZero() {
+		var err error
+		if profile, err = renderObservabilityProfile(ctx, workload, profileRef); err != nil {
+			log.Error(err, "Received an error while trying to render the observability profile", "profile", profileRef)
+			return ctrl.Result{}, err
+		}
+	}
+
+	if err := syncObservabilityProfileResources(ctx, log, workload, profile); err != nil {
+		log.Error(err, "Received an error while trying to sync the observability profile resources")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: r.Interval}, nil
+}
+
+// observabilityProfileKey returns the key of the profile referenced by the annotation of a workload, either
+// `<name>` in the namespace of the workload, or `<namespace>/<name>`.
+func observabilityProfileKey(workload client.Object, profileRef string) types.NamespacedName {
+	if namespace, name, ok := strings.Cut(profileRef, "/"); ok {
+		return types.NamespacedName{Namespace: namespace, Name: name}
+	}
+	return types.NamespacedName{Namespace: workload.GetNamespace(), Name: profileRef}
+}
+
+func renderObservabilityProfile(ctx context.Context, workload client.Object, profileRef string) (*coralogixv1alpha1.ObservabilityProfileSpec, error) {
+	profile := &coralogixv1alpha1.ObservabilityProfile{}
+	if err := config.GetClient().Get(ctx, observabilityProfileKey(workload, profileRef), profile); err != nil {
+		return nil, fmt.Errorf("error getting ObservabilityProfile %s: %w", profileRef, err)
+	}
+
+	gvk, err := apiutil.GVKForObject(workload, config.GetClient().Scheme())
+	if err != nil {
+		return nil, err
+	}
+	values := coralogixv1alpha1.ObservabilityProfileWorkload{
+		Kind:      gvk.Kind,
+		Name:      workload.GetName(),
+		Namespace: workload.GetNamespace(),
+		Labels:    workload.GetLabels(),
+	}
+	if podTemplate := workloadPodTemplate(workload); podTemplate != nil && len(podTemplate.Spec.Containers) > 0 {
+		values.Container = podTemplate.Spec.Containers[0].Name
+	}
+
+	rendered, err := profile.Spec.Render(values)
+	if err != nil {
+		return nil, fmt.Errorf("error rendering ObservabilityProfile %s: %w", profileRef, err)
+	}
+	return rendered, nil
+}
+
+func workloadPodTemplate(workload client.Object) *corev1.PodTemplateSpec {
+	switch workload := workload.(type) {
+	case *appsv1.Deployment:
+		return &workload.Spec.Template
+	case *appsv1.StatefulSet:
+		return &workload.Spec.Template
+	default:
+		return nil
+	}
+}
+
+// syncObservabilityProfileResources applies the resources of the rendered profile, and deletes the resources
+// generated for the workload that the profile no longer defines. A nil profile deletes them all.
+func syncObservabilityProfileResources(
+	ctx context.Context,
+	log logr.Logger,
+	workload client.Object,
+	profile *coralogixv1alpha1.ObservabilityProfileSpec,
+) error {
+	if profile == nil {
+		profile = &coralogixv1alpha1.ObservabilityProfileSpec{}
+	}
+	// Generated resources carry the labels of the workload, so that they match the same selectors.
+	newMeta := func(name string) metav1.ObjectMeta {
+		labels := maps.Clone(workload.GetLabels())
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[utils.ObservabilityProfileWorkloadLabelKey] = truncateLabelValue(workload.GetName())
+		return metav1.ObjectMeta{
+			Name:      workload.GetName() + "-" + name,
+			Namespace: workload.GetNamespace(),
+			Labels:    labels,
+		}
+	}
+
+	dashboards := make([]*coralogixv1alpha1.Dashboard, 0, len(profile.Dashboards))
+	for _, dashboard := range profile.Dashboards {
+		dashboards = append(dashboards, &coralogixv1alpha1.Dashboard{ObjectMeta: newMeta(dashboard.Name), Spec: dashboard.Spec})
+	}
+	alerts := make([]*coralogixv1beta1.Alert, 0, len(profile.Alerts))
+	for _, alert := range profile.Alerts {
+		alerts = append(alerts, &coralogixv1beta1.Alert{ObjectMeta: newMeta(alert.Name), Spec: alert.Spec})
+	}
+	alertSets := make([]*coralogixv1alpha1.AlertSet, 0, len(profile.AlertSets))
+	for _, alertSet := range profile.AlertSets {
+		alertSets = append(alertSets, &coralogixv1alpha1.AlertSet{ObjectMeta: newMeta(alertSet.Name), Spec: alertSet.Spec})
+	}
+	slos := make([]*coralogixv1alpha1.SLO, 0, len(profile.SLOs))
+	for _, slo := range profile.SLOs {
+		slos = append(slos, &coralogixv1alpha1.SLO{ObjectMeta: newMeta(slo.Name), Spec: slo.Spec})
+	}
+
+	return errors.Join(
+		syncProfileResources(ctx, log, workload, dashboards, &coralogixv1alpha1.DashboardList{},
+			func(existing, desired *coralogixv1alpha1.Dashboard) { existing.Spec = desired.Spec }),
+		syncProfileResources(ctx, log, workload, alerts, &coralogixv1beta1.AlertList{},
+			func(existing, desired *coralogixv1beta1.Alert) { existing.Spec = desired.Spec }),
+		syncProfileResources(ctx, log, workload, alertSets, &coralogixv1alpha1.AlertSetList{},
+			func(existing, desired *coralogixv1alpha1.AlertSet) { existing.Spec = desired.Spec }),
+		syncProfileResources(ctx, log, workload, slos, &coralogixv1alpha1.SLOList{},
+			func(existing, desired *coralogixv1alpha1.SLO) { existing.Spec = desired.Spec }),
+	)
+}
+
+// syncProfileResources creates or updates the desired resources of one kind, refusing to take over resources the
+// workload does not own, and deletes the other resources of that kind generated for the workload.
+func syncProfileResources[T client.Object](
+	ctx context.Context,
+	log logr.Logger,
+	workload client.Object,
+	desired []T,
+	list client.ObjectList,
+	mutateSpec func(existing, desired T),
+) error {
+	var errs []error
+	desiredNames := make(map[string]bool, len(desired))
+	for _, obj := range desired {
+		desiredNames[obj.GetName()] = true
+		want := obj.DeepCopyObject().(T)
+		_, err := controllerutil.CreateOrUpdate(ctx, config.GetClient(), obj, func() error {
+			if obj.GetResourceVersion() != "" && !metav1.IsControlledBy(obj, workload) {
+				return fmt.Errorf("%s already exists and is not generated for %s", obj.GetName(), workload.GetName())
+			}
+			mutateSpec(obj, want)
+			obj.SetLabels(want.GetLabels())
+			return controllerutil.SetControllerReference(workload, obj, config.GetClient().Scheme())
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error applying %s: %w", obj.GetName(), err))
+		}
+	}
+
+	if err := config.GetClient().List(ctx, list,
+		client.InNamespace(workload.GetNamespace()),
+		client.MatchingLabels{utils.ObservabilityProfileWorkloadLabelKey: truncateLabelValue(workload.GetName())}); err != nil {
+		return errors.Join(append(errs, fmt.Errorf("error listing generated resources: %w", err))...)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok || desiredNames[obj.GetName()] || !metav1.IsControlledBy(obj, workload) {
+			continue
+		}
+		log.Info("Deleting resource no longer generated by the observability profile", "name", obj.GetName())
+		if err := config.GetClient().Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Errorf("error deleting %s: %w", obj.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// enqueueWorkloadsForProfile enqueues the workloads using an ObservabilityProfile when it changes.
+func (r *ObservabilityProfileReconciler) enqueueWorkloadsForProfile(ctx context.Context, profile client.Object) []reconcile.Request {
+	list := r.NewWorkloadList()
+	if err := config.GetClient().List(ctx, list); err != nil {
+		log.FromContext(ctx).Error(err, "Error listing workloads using the ObservabilityProfile")
+		return nil
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, item := range items {
+		workload, ok := item.(client.Object)
+		if !ok {
+			continue
+		}
+		profileRef := workload.GetAnnotations()[utils.ObservabilityProfileAnnotationKey]
+		if profileRef != "" && observabilityProfileKey(workload, profileRef) == client.ObjectKeyFromObject(profile) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(workload)})
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ObservabilityProfileReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	gvk, err := apiutil.GVKForObject(r.NewWorkload(), mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+
+	hasProfile := func(obj client.Object) bool {
+		return obj.GetAnnotations()[utils.ObservabilityProfileAnnotationKey] != ""
+	}
+	selector := config.GetConfig().Selector
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(strings.ToLower(gvk.Kind)+"-observabilityprofile").
+		For(r.NewWorkload(), builder.WithPredicates(predicate.Funcs{
+			CreateFunc: func(e event.CreateEvent) bool {
+				return hasProfile(e.Object) && selector.Matches(e.Object.GetLabels(), e.Object.GetNamespace())
+			},
+			// Workloads update their status on every rollout, which does not change the generated resources.
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				changed := e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() ||
+					!maps.Equal(e.ObjectOld.GetAnnotations(), e.ObjectNew.GetAnnotations()) ||
+					!maps.Equal(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+				return changed && (hasProfile(e.ObjectNew) || hasProfile(e.ObjectOld)) &&
+					(selector.Matches(e.ObjectNew.GetLabels(), e.ObjectNew.GetNamespace()) ||
+						selector.Matches(e.ObjectOld.GetLabels(), e.ObjectOld.GetNamespace()))
+			},
+			DeleteFunc: func(e event.DeleteEvent) bool {
+				return false
+			},
+		})).
+		Owns(&coralogixv1alpha1.Dashboard{}).
+		Owns(&coralogixv1beta1.Alert{}).
+		Owns(&coralogixv1alpha1.AlertSet{}).
+		Owns(&coralogixv1alpha1.SLO{}).
+		Watches(&coralogixv1alpha1.ObservabilityProfile{}, handler.EnqueueRequestsFromMapFunc(r.enqueueWorkloadsForProfile)).
+		WithEventFilter(coralogixreconciler.IgnoreInformationalStatusUpdates()).
+		Complete(r)
+}
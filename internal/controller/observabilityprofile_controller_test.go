@@ -0,0 +1,143 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+func TestObservabilityProfileReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+	require.NoError(t, coralogixv1beta1.AddToScheme(scheme))
+
+	profile := &coralogixv1alpha1.ObservabilityProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "http-service", Namespace: "platform"},
+		Spec: coralogixv1alpha1.ObservabilityProfileSpec{
+			Dashboards: []coralogixv1alpha1.ObservabilityProfileDashboard{{
+				Name: "overview",
+				Spec: coralogixv1alpha1.DashboardSpec{Json: ptr.To(`{"name": "${workload.name} overview"}`)},
+			}},
+			AlertSets: []coralogixv1alpha1.ObservabilityProfileAlertSet{{
+				Name: "errors",
+				Spec: coralogixv1alpha1.AlertSetSpec{Alerts: []coralogixv1alpha1.AlertSetItem{{
+					Key:  "5xx",
+					Spec: coralogixv1beta1.AlertSpec{Name: "${workload.name} 5xx"},
+				}}},
+			}},
+			SLOs: []coralogixv1alpha1.ObservabilityProfileSLO{{
+				Name: "availability",
+				Spec: coralogixv1alpha1.SLOSpec{
+					Name: "${workload.name} availability",
+					SliType: coralogixv1alpha1.SliType{RequestBasedMetricSli: &coralogixv1alpha1.RequestBasedMetricSli{
+						GoodEvents:  coralogixv1alpha1.SloMetricEvent{Query: `sum(rate(http_requests_total{container="${workload.container}", code!~"5.."}[5m]))`},
+						TotalEvents: coralogixv1alpha1.SloMetricEvent{Query: `sum(rate(http_requests_total{container="${workload.container}"}[5m]))`},
+					}},
+					TargetThresholdPercentage: resource.MustParse("99.9"),
+				},
+			}},
+		},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "checkout",
+			Namespace:   "payments",
+			UID:         "deployment-uid",
+			Labels:      map[string]string{"team": "payments"},
+			Annotations: map[string]string{utils.ObservabilityProfileAnnotationKey: "platform/http-service"},
+		},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "api"}, {Name: "sidecar"}},
+		}}},
+	}
+
+	originalClient := config.GetClient()
+	t.Cleanup(func() { config.InitClient(originalClient) })
+	config.InitClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(profile, deployment).Build())
+
+	ctx := context.Background()
+	reconciler := &ObservabilityProfileReconciler{
+		NewWorkload:     func() client.Object { return &appsv1.Deployment{} },
+		NewWorkloadList: func() client.ObjectList { return &appsv1.DeploymentList{} },
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "payments", Name: "checkout"}}
+	_, err := reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	var dashboards coralogixv1alpha1.DashboardList
+	require.NoError(t, config.GetClient().List(ctx, &dashboards, client.InNamespace("payments")))
+	require.Len(t, dashboards.Items, 1)
+	dashboard := dashboards.Items[0]
+	require.Equal(t, "checkout-overview", dashboard.Name)
+	require.Equal(t, map[string]string{"team": "payments", utils.ObservabilityProfileWorkloadLabelKey: "checkout"}, dashboard.Labels)
+	require.True(t, isOwnedBy(dashboard.OwnerReferences, "deployment-uid"))
+	require.Equal(t, `{"name": "checkout overview"}`, *dashboard.Spec.Json)
+
+	var alertSets coralogixv1alpha1.AlertSetList
+	require.NoError(t, config.GetClient().List(ctx, &alertSets, client.InNamespace("payments")))
+	require.Len(t, alertSets.Items, 1)
+	alertSet := alertSets.Items[0]
+	require.Equal(t, "checkout-errors", alertSet.Name)
+	require.Equal(t, "payments", alertSet.Labels["team"])
+	require.Equal(t, "checkout 5xx", alertSet.Spec.Alerts[0].Spec.Name)
+
+	var slos coralogixv1alpha1.SLOList
+	require.NoError(t, config.GetClient().List(ctx, &slos, client.InNamespace("payments")))
+	require.Len(t, slos.Items, 1)
+	slo := slos.Items[0]
+	require.Equal(t, "checkout-availability", slo.Name)
+	require.Equal(t, "checkout availability", slo.Spec.Name)
+	require.Equal(t, `sum(rate(http_requests_total{container="api"}[5m]))`, slo.Spec.SliType.RequestBasedMetricSli.TotalEvents.Query)
+
+	// Resources the profile no longer defines are deleted.
+	profile.Spec.Dashboards = nil
+	require.NoError(t, config.GetClient().Update(ctx, profile))
+	_, err = reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, config.GetClient().List(ctx, &dashboards, client.InNamespace("payments")))
+	require.Empty(t, dashboards.Items)
+	require.NoError(t, config.GetClient().List(ctx, &slos, client.InNamespace("payments")))
+	require.Len(t, slos.Items, 1)
+
+	// All the generated resources are deleted once the workload is no longer annotated.
+	deployment.Annotations = nil
+	require.NoError(t, config.GetClient().Update(ctx, deployment))
+	_, err = reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, config.GetClient().List(ctx, &slos, client.InNamespace("payments")))
+	require.Empty(t, slos.Items)
+	require.NoError(t, config.GetClient().List(ctx, &alertSets, client.InNamespace("payments")))
+	require.Empty(t, alertSets.Items)
+}
@@ -130,6 +130,7 @@ func (r *PrometheusRuleReconciler) convertPrometheusRuleRecordingRuleToCxRecordi
 			recordingRuleGroupSet.Labels[managedByLabelKey] = truncateLabelValue(prometheusRule.Name)
 			recordingRuleGroupSet.OwnerReferences = []metav1.OwnerReference{getOwnerReference(prometheusRule)}
 			recordingRuleGroupSet.Spec = desiredRecordingRuleGroupSetSpec
+			utils.PropagateReconcileRequest(prometheusRule, recordingRuleGroupSet)
 			if err = config.GetClient().Create(ctx, recordingRuleGroupSet); err != nil {
 				return fmt.Errorf("received an error while trying to create RecordingRuleGroupSet CRD: %w", err)
 			}
@@ -158,6 +159,10 @@ func (r *PrometheusRuleReconciler) convertPrometheusRuleRecordingRuleToCxRecordi
 		updated = true
 	}
 
+	if utils.PropagateReconcileRequest(prometheusRule, recordingRuleGroupSet) {
+		updated = true
+	}
+
 	if updated {
 		if err := config.GetClient().Update(ctx, recordingRuleGroupSet); err != nil {
 			return fmt.Errorf("received an error while trying to update RecordingRuleGroupSet CRD: %w", err)
@@ -245,6 +250,7 @@ func (r *PrometheusRuleReconciler) convertPrometheusRuleAlertToCxAlert(ctx conte
 					alert.Labels[managedByLabelKey] = truncateLabelValue(prometheusRule.Name)
 					alert.OwnerReferences = []metav1.OwnerReference{getOwnerReference(prometheusRule)}
 					alert.Spec = prometheusAlertingRuleToAlertSpec(&rule)
+					utils.PropagateReconcileRequest(prometheusRule, alert)
 					if err = config.GetClient().Create(ctx, alert); err != nil {
 						errorsEncountered = append(errorsEncountered, fmt.Errorf("error creating Alert CRD %s: %w", alertName, err))
 					}
@@ -295,6 +301,10 @@ func (r *PrometheusRuleReconciler) convertPrometheusRuleAlertToCxAlert(ctx conte
 				updated = true
 			}
 
+			if utils.PropagateReconcileRequest(prometheusRule, alert) {
+				updated = true
+			}
+
 			if updated {
 				if err := config.GetClient().Update(ctx, alert); err != nil {
 					errorsEncountered = append(errorsEncountered, fmt.Errorf("error updating Alert CRD %s: %w", alertName, err))
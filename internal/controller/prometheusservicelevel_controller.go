@@ -0,0 +1,246 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/sloconvert"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+//+kubebuilder:rbac:groups=sloth.slok.dev,resources=prometheusservicelevels,verbs=get;list;watch
+
+//+kubebuilder:rbac:groups=coralogix.com,resources=slos,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// unsupportedFieldsEventReason is the reason of the Events reporting the fields of a PrometheusServiceLevel that
+// could not be converted.
+const unsupportedFieldsEventReason = "UnsupportedFields"
+
+// PrometheusServiceLevelGVK is the GroupVersionKind of the Sloth PrometheusServiceLevel. Sloth types are not
+// imported, so PrometheusServiceLevels are read as unstructured objects.
+var PrometheusServiceLevelGVK = schema.GroupVersionKind{
+	Group:   utils.SlothAPIGroup,
+	Version: utils.V1APIVersion,
+	Kind:    utils.PrometheusServiceLevelKind,
+}
+
+// PrometheusServiceLevelReconciler reconciles a Sloth PrometheusServiceLevel object into SLOs.
+type PrometheusServiceLevelReconciler struct {
+	Interval time.Duration
+	Recorder record.EventRecorder
+}
+
+func (r *PrometheusServiceLevelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	serviceLevel := &unstructured.Unstructured{}
+	serviceLevel.SetGroupVersionKind(PrometheusServiceLevelGVK)
+	if err := config.GetClient().Get(ctx, req.NamespacedName, serviceLevel); err != nil {
+		if k8serrors.IsNotFound(err) {
+			// Owned SLOs are garbage collected via their owner references.
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !shouldTrackPrometheusServiceLevel(serviceLevel) {
+		if err := r.syncSLOs(ctx, serviceLevel, nil); err != nil {
+			log.Error(err, "Received an error while trying to delete PrometheusServiceLevel SLOs")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	result := sloconvert.ConvertSloth(serviceLevel.Object, prometheusServiceLevelConvertOptions(serviceLevel))
+	if len(result.Unsupported) > 0 {
+		log.V(int(zapcore.WarnLevel)).Info("Skipping unsupported PrometheusServiceLevel fields",
+			"unsupported", result.Unsupported)
+		// The skipped fields change the meaning of the SLOs, so they are reported where users look for them.
+		r.Recorder.Eventf(serviceLevel, corev1.EventTypeWarning, unsupportedFieldsEventReason,
+			"Skipped the fields that have no Coralogix equivalent: %s", strings.Join(result.Unsupported, "; "))
+	}
+
+	if err := r.syncSLOs(ctx, serviceLevel, result.SLOs); err != nil {
+		log.Error(err, "Received an error while trying to convert PrometheusServiceLevel to SLO CRDs")
+		return ctrl.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Interval}, nil
+}
+
+// syncSLOs creates and updates the desired SLOs, and deletes the other SLOs owned by the PrometheusServiceLevel.
+func (r *PrometheusServiceLevelReconciler) syncSLOs(
+	ctx context.Context,
+	serviceLevel *unstructured.Unstructured,
+	desiredSLOs []*coralogixv1alpha1.SLO,
+) error {
+	var errorsEncountered []error
+	slosToKeep := make(map[string]bool)
+	for _, desired := range desiredSLOs {
+		desired.Labels = prometheusServiceLevelChildLabels(serviceLevel)
+		desired.OwnerReferences = []metav1.OwnerReference{getPrometheusServiceLevelOwnerReference(serviceLevel)}
+		slosToKeep[desired.Name] = true
+		if err := r.applySLO(ctx, serviceLevel, desired); err != nil {
+			errorsEncountered = append(errorsEncountered, err)
+		}
+	}
+
+	var childSLOs coralogixv1alpha1.SLOList
+	if err := config.GetClient().List(
+		ctx,
+		&childSLOs,
+		client.InNamespace(serviceLevel.GetNamespace()),
+		client.MatchingLabels{managedByLabelKey: truncateLabelValue(serviceLevel.GetName())}); err != nil {
+		return fmt.Errorf("received an error while trying to list SLOs: %w", err)
+	}
+
+	for _, slo := range childSLOs.Items {
+		if !slosToKeep[slo.Name] && isOwnedBy(slo.OwnerReferences, serviceLevel.GetUID()) {
+			if err := config.GetClient().Delete(ctx, &slo); err != nil && !k8serrors.IsNotFound(err) {
+				errorsEncountered = append(errorsEncountered, fmt.Errorf("error deleting SLO CRD %s: %w", slo.Name, err))
+			}
+		}
+	}
+
+	return errors.Join(errorsEncountered...)
+}
+
+func (r *PrometheusServiceLevelReconciler) applySLO(
+	ctx context.Context,
+	serviceLevel *unstructured.Unstructured,
+	desired *coralogixv1alpha1.SLO,
+) error {
+	slo := &coralogixv1alpha1.SLO{}
+	if err := config.GetClient().Get(ctx, client.ObjectKeyFromObject(desired), slo); err != nil {
+		if k8serrors.IsNotFound(err) {
+			utils.PropagateReconcileRequest(serviceLevel, desired)
+			if err = config.GetClient().Create(ctx, desired); err != nil {
+				return fmt.Errorf("error creating SLO CRD %s: %w", desired.Name, err)
+			}
+			return nil
+		}
+		return fmt.Errorf("error getting SLO CRD %s: %w", desired.Name, err)
+	}
+
+	if !isOwnedBy(slo.OwnerReferences, serviceLevel.GetUID()) {
+		return fmt.Errorf("SLO %s already exists and is not managed by PrometheusServiceLevel %s", desired.Name, serviceLevel.GetName())
+	}
+
+	// Quantities are compared by value, since their serialized form is canonicalized by the API server.
+	reconcileRequested := utils.PropagateReconcileRequest(serviceLevel, slo)
+	if !reconcileRequested && reflect.DeepEqual(slo.Labels, desired.Labels) && equality.Semantic.DeepEqual(slo.Spec, desired.Spec) {
+		return nil
+	}
+
+	slo.Labels = desired.Labels
+	slo.Spec = desired.Spec
+	if err := config.GetClient().Update(ctx, slo); err != nil {
+		return fmt.Errorf("error updating SLO CRD %s: %w", desired.Name, err)
+	}
+	return nil
+}
+
+// prometheusServiceLevelConvertOptions returns the conversion options, overridden by the annotations of the
+// PrometheusServiceLevel since Sloth has no time window.
+func prometheusServiceLevelConvertOptions(serviceLevel *unstructured.Unstructured) sloconvert.Options {
+	opts := sloconvert.DefaultOptions()
+	opts.Namespace = serviceLevel.GetNamespace()
+	if value, ok := serviceLevel.GetAnnotations()[utils.PrometheusServiceLevelTimeFrameAnnotationKey]; ok && value != "" {
+		opts.TimeFrame = coralogixv1alpha1.SloTimeFrame(value)
+	}
+	if value, ok := serviceLevel.GetAnnotations()[utils.PrometheusServiceLevelRateWindowAnnotationKey]; ok && value != "" {
+		opts.RateWindow = value
+	}
+	return opts
+}
+
+func prometheusServiceLevelChildLabels(serviceLevel *unstructured.Unstructured) map[string]string {
+	labels := make(map[string]string, len(serviceLevel.GetLabels())+1)
+	for key, value := range serviceLevel.GetLabels() {
+		labels[key] = value
+	}
+	labels[managedByLabelKey] = truncateLabelValue(serviceLevel.GetName())
+	return labels
+}
+
+func getPrometheusServiceLevelOwnerReference(serviceLevel *unstructured.Unstructured) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: PrometheusServiceLevelGVK.GroupVersion().String(),
+		Kind:       PrometheusServiceLevelGVK.Kind,
+		Name:       serviceLevel.GetName(),
+		UID:        serviceLevel.GetUID(),
+	}
+}
+
+func shouldTrackPrometheusServiceLevel(serviceLevel *unstructured.Unstructured) bool {
+	if value, ok := serviceLevel.GetLabels()[utils.TrackPrometheusServiceLevelLabelKey]; ok && value == "true" {
+		return true
+	}
+	return false
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PrometheusServiceLevelReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	shouldTrack := func(labels map[string]string) bool {
+		value, ok := labels[utils.TrackPrometheusServiceLevelLabelKey]
+		return ok && value == "true"
+	}
+
+	serviceLevel := &unstructured.Unstructured{}
+	serviceLevel.SetGroupVersionKind(PrometheusServiceLevelGVK)
+	selector := config.GetConfig().Selector
+	return ctrl.NewControllerManagedBy(mgr).
+		For(serviceLevel).
+		WithEventFilter(predicate.Funcs{
+			CreateFunc: func(e event.CreateEvent) bool {
+				return shouldTrack(e.Object.GetLabels()) &&
+					selector.Matches(e.Object.GetLabels(), e.Object.GetNamespace())
+			},
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return (shouldTrack(e.ObjectNew.GetLabels()) || shouldTrack(e.ObjectOld.GetLabels())) &&
+					(selector.Matches(e.ObjectNew.GetLabels(), e.ObjectNew.GetNamespace()) ||
+						selector.Matches(e.ObjectOld.GetLabels(), e.ObjectOld.GetNamespace()))
+			},
+			DeleteFunc: func(e event.DeleteEvent) bool {
+				return shouldTrack(e.Object.GetLabels()) &&
+					selector.Matches(e.Object.GetLabels(), e.Object.GetNamespace())
+			},
+		}).
+		Complete(r)
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+func TestPrometheusServiceLevelReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+
+	serviceLevel := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"service": "checkout",
+			"slos": []any{
+				map[string]any{
+					"name":      "availability",
+					"objective": 99.9,
+					"sli": map[string]any{"events": map[string]any{
+						"errorQuery": `sum(rate(http_requests_total{code=~"5.."}[{{.window}}]))`,
+						"totalQuery": `sum(rate(http_requests_total[{{.window}}]))`,
+					}},
+				},
+				map[string]any{
+					"name":      "latency",
+					"objective": 99.0,
+					"sli":       map[string]any{"raw": map[string]any{"errorRatioQuery": "slow_ratio"}},
+				},
+			},
+		},
+	}}
+	serviceLevel.SetGroupVersionKind(PrometheusServiceLevelGVK)
+	serviceLevel.SetName("checkout")
+	serviceLevel.SetNamespace("payments")
+	serviceLevel.SetUID("service-level-uid")
+	serviceLevel.SetLabels(map[string]string{utils.TrackPrometheusServiceLevelLabelKey: "true"})
+	serviceLevel.SetAnnotations(map[string]string{
+		utils.PrometheusServiceLevelTimeFrameAnnotationKey:  "7d",
+		utils.PrometheusServiceLevelRateWindowAnnotationKey: "1m",
+	})
+
+	removed := &coralogixv1alpha1.SLO{}
+	removed.Name = "checkout-removed"
+	removed.Namespace = "payments"
+	removed.Labels = prometheusServiceLevelChildLabels(serviceLevel)
+	removed.OwnerReferences = append(removed.OwnerReferences, getPrometheusServiceLevelOwnerReference(serviceLevel))
+
+	originalClient := config.GetClient()
+	t.Cleanup(func() { config.InitClient(originalClient) })
+	config.InitClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(serviceLevel, removed).Build())
+
+	ctx := context.Background()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := &PrometheusServiceLevelReconciler{Recorder: recorder}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "payments", Name: "checkout"}}
+	_, err := reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	var slos coralogixv1alpha1.SLOList
+	require.NoError(t, config.GetClient().List(ctx, &slos, client.InNamespace("payments")))
+	require.Len(t, slos.Items, 1)
+	slo := slos.Items[0]
+	require.Equal(t, "checkout-availability", slo.Name)
+	require.Equal(t, "checkout", slo.Labels[managedByLabelKey])
+	require.True(t, isOwnedBy(slo.OwnerReferences, "service-level-uid"))
+	require.Equal(t, "checkout-availability", slo.Spec.Name)
+	require.Equal(t, ptr.To(coralogixv1alpha1.SloTimeFrame7d), slo.Spec.Window.TimeFrame)
+	require.Equal(t, "sum(rate(http_requests_total[1m]))", slo.Spec.SliType.RequestBasedMetricSli.TotalEvents.Query)
+
+	// The skipped SLO is reported on the PrometheusServiceLevel.
+	require.Len(t, recorder.Events, 1)
+	event := <-recorder.Events
+	require.Contains(t, event, "Warning UnsupportedFields")
+	require.Contains(t, event, "checkout.spec.slos[1].sli")
+
+	// SLOs are deleted once the PrometheusServiceLevel is no longer tracked.
+	serviceLevel.SetLabels(nil)
+	require.NoError(t, config.GetClient().Update(ctx, serviceLevel))
+	_, err = reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, config.GetClient().List(ctx, &slos, client.InNamespace("payments")))
+	require.Empty(t, slos.Items)
+}
@@ -0,0 +1,643 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grafanaconvert converts Grafana dashboards into Coralogix dashboards.
+//
+// Rows become sections, and the panels of a section are laid out in rows by their vertical position. Time series
+// and graph panels become line charts, stat and gauge panels become gauges, table panels become data tables and
+// text panels become markdown widgets. Only PromQL targets are converted, and Grafana's interval variables are
+// replaced by a fixed 5m window. Query variables using label_values, custom and constant variables become
+// dashboard variables. The panels, targets and variables that cannot be converted are reported in
+// Result.Unsupported instead of being dropped silently.
+package grafanaconvert
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Result holds the converted dashboard, and what could not be converted.
+type Result struct {
+	// Dashboard is the Coralogix dashboard, in the JSON format of Dashboard.spec.json.
+	Dashboard []byte
+	// Unsupported lists the panels, targets and variables that could not be converted, as "<path>: <reason>".
+	Unsupported []string
+}
+
+func (r *Result) unsupported(path, format string, args ...any) {
+	r.Unsupported = append(r.Unsupported, path+": "+fmt.Sprintf(format, args...))
+}
+
+// rateInterval replaces the interval variables of Grafana, which Coralogix does not have.
+const rateInterval = "5m"
+
+var intervalVariables = regexp.MustCompile(`\$\{?__(rate_interval|interval)\}?`)
+
+// grafanaDashboard is the part of the Grafana dashboard model that is converted.
+type grafanaDashboard struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Panels      []grafanaPanel    `json:"panels"`
+	Rows        []grafanaRow      `json:"rows"`
+	Templating  grafanaTemplating `json:"templating"`
+	Time        struct {
+		From string `json:"from"`
+	} `json:"time"`
+}
+
+// grafanaRow is a row of the dashboards of schema versions before 16, which have no grid positions.
+type grafanaRow struct {
+	Title     string          `json:"title"`
+	ShowTitle bool            `json:"showTitle"`
+	Collapse  bool            `json:"collapse"`
+	Height    json.RawMessage `json:"height"`
+	Panels    []grafanaPanel  `json:"panels"`
+}
+
+type grafanaPanel struct {
+	Type        string          `json:"type"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	Datasource  json.RawMessage `json:"datasource"`
+	Collapsed   bool            `json:"collapsed"`
+	Span        float64         `json:"span"`
+	GridPos     struct {
+		H int `json:"h"`
+		W int `json:"w"`
+		X int `json:"x"`
+		Y int `json:"y"`
+	} `json:"gridPos"`
+	// Panels are the panels of a collapsed row.
+	Panels  []grafanaPanel  `json:"panels"`
+	Targets []grafanaTarget `json:"targets"`
+	// Content is the content of text panels before Grafana 7.
+	Content string `json:"content"`
+	Options struct {
+		Content       string `json:"content"`
+		ReduceOptions struct {
+			Calcs []string `json:"calcs"`
+		} `json:"reduceOptions"`
+	} `json:"options"`
+	FieldConfig struct {
+		Defaults struct {
+			Unit       string   `json:"unit"`
+			Min        *float64 `json:"min"`
+			Max        *float64 `json:"max"`
+			Thresholds struct {
+				Steps []struct {
+					Value *float64 `json:"value"`
+					Color string   `json:"color"`
+				} `json:"steps"`
+			} `json:"thresholds"`
+		} `json:"defaults"`
+	} `json:"fieldConfig"`
+}
+
+type grafanaTarget struct {
+	RefID        string          `json:"refId"`
+	Expr         string          `json:"expr"`
+	LegendFormat string          `json:"legendFormat"`
+	Instant      bool            `json:"instant"`
+	Format       string          `json:"format"`
+	Hide         bool            `json:"hide"`
+	Datasource   json.RawMessage `json:"datasource"`
+}
+
+type grafanaTemplating struct {
+	List []grafanaVariable `json:"list"`
+}
+
+type grafanaVariable struct {
+	Name  string          `json:"name"`
+	Label string          `json:"label"`
+	Type  string          `json:"type"`
+	Query json.RawMessage `json:"query"`
+	// Options are the values of custom variables.
+	Options []struct {
+		Value string `json:"value"`
+	} `json:"options"`
+}
+
+// Convert converts a Grafana dashboard, either as exported from the UI or wrapped in a "dashboard" field like the
+// Grafana API returns it.
+func Convert(data []byte) (*Result, error) {
+	var wrapped struct {
+		Dashboard json.RawMessage `json:"dashboard"`
+	}
+	if err := json.Unmarshal(data, &wrapped); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal Grafana dashboard: %w", err)
+	}
+	if len(wrapped.Dashboard) > 0 && string(wrapped.Dashboard) != "null" {
+		data = wrapped.Dashboard
+	}
+
+	source := &grafanaDashboard{}
+	if err := json.Unmarshal(data, source); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal Grafana dashboard: %w", err)
+	}
+
+	c := &converter{result: &Result{}, title: source.Title}
+	dashboard := map[string]any{
+		"name":      source.Title,
+		"layout":    map[string]any{"sections": c.sections(source)},
+		"variables": c.variables(source.Templating.List),
+		"filters":   []any{},
+	}
+	if source.Description != "" {
+		dashboard["description"] = source.Description
+	}
+	if timeFrame, ok := relativeTimeFrame(source.Time.From); ok {
+		dashboard["relativeTimeFrame"] = timeFrame
+	}
+
+	var err error
+	if c.result.Dashboard, err = json.Marshal(dashboard); err != nil {
+		return nil, fmt.Errorf("failed to marshal Coralogix dashboard: %w", err)
+	}
+	return c.result, nil
+}
+
+type converter struct {
+	result *Result
+	// title seeds the IDs of the sections, rows and widgets, so that converting the same dashboard twice gives
+	// the same IDs.
+	title string
+}
+
+func (c *converter) id(path string) map[string]any {
+	return map[string]any{"value": uuid.NewSHA1(uuid.NameSpaceURL, []byte(c.title+"/"+path)).String()}
+}
+
+// section is a section being built from the panels of a Grafana row.
+type section struct {
+	title     string
+	collapsed bool
+	panels    []grafanaPanel
+}
+
+func (c *converter) sections(source *grafanaDashboard) []any {
+	var sections []*section
+	if len(source.Rows) > 0 {
+		for _, row := range source.Rows {
+			s := &section{collapsed: row.Collapse}
+			if row.ShowTitle {
+				s.title = row.Title
+			}
+			height := legacyRowHeight(row.Height)
+			x := 0
+			for _, panel := range row.Panels {
+				// Legacy panels have a span out of 12 columns instead of a grid position.
+				span := int(panel.Span)
+				if span <= 0 {
+					span = 12
+				}
+				panel.GridPos.X, panel.GridPos.W, panel.GridPos.H = x, span*2, height
+				x += span * 2
+				s.panels = append(s.panels, panel)
+			}
+			sections = append(sections, s)
+		}
+	} else {
+		current := &section{}
+		sections = append(sections, current)
+		for _, panel := range source.Panels {
+			if panel.Type != "row" {
+				current.panels = append(current.panels, panel)
+				continue
+			}
+			current = &section{title: panel.Title, collapsed: panel.Collapsed, panels: panel.Panels}
+			sections = append(sections, current)
+		}
+	}
+
+	result := make([]any, 0, len(sections))
+	for i, s := range sections {
+		if len(s.panels) == 0 && s.title == "" {
+			continue
+		}
+		path := fmt.Sprintf("section/%d", i)
+		options := map[string]any{"internal": map[string]any{}}
+		if s.title != "" {
+			options = map[string]any{"custom": map[string]any{"name": s.title, "collapsed": s.collapsed}}
+		}
+		result = append(result, map[string]any{
+			"id":      c.id(path),
+			"rows":    c.rows(path, s.panels),
+			"options": options,
+		})
+	}
+	return result
+}
+
+// rows lays out the panels of a section in rows, grouping the panels starting at the same vertical position.
+func (c *converter) rows(path string, panels []grafanaPanel) []any {
+	panels = slices.Clone(panels)
+	slices.SortStableFunc(panels, func(a, b grafanaPanel) int {
+		if a.GridPos.Y != b.GridPos.Y {
+			return a.GridPos.Y - b.GridPos.Y
+		}
+		return a.GridPos.X - b.GridPos.X
+	})
+
+	var rows []any
+	for start := 0; start < len(panels); {
+		end := start
+		height := 0
+		var widgets []any
+		for ; end < len(panels) && panels[end].GridPos.Y == panels[start].GridPos.Y; end++ {
+			panel := panels[end]
+			widget, ok := c.widget(fmt.Sprintf("%s/row/%d/widget/%d", path, len(rows), len(widgets)), panel)
+			if !ok {
+				continue
+			}
+			widgets = append(widgets, widget)
+			height = max(height, panel.GridPos.H)
+		}
+		start = end
+		if len(widgets) == 0 {
+			continue
+		}
+		rows = append(rows, map[string]any{
+			"id": c.id(fmt.Sprintf("%s/row/%d", path, len(rows))),
+			// Grafana grid units are about half as high as Coralogix ones.
+			"appearance": map[string]any{"height": max(height, 4) * 2},
+			"widgets":    widgets,
+		})
+	}
+	if rows == nil {
+		return []any{}
+	}
+	return rows
+}
+
+func (c *converter) widget(path string, panel grafanaPanel) (map[string]any, bool) {
+	panelPath := fmt.Sprintf("panel %q", panel.Title)
+	var definition map[string]any
+	switch panel.Type {
+	case "timeseries", "graph":
+		definition = c.lineChart(path, panelPath, panel)
+	case "stat", "singlestat", "gauge":
+		definition = c.gauge(panelPath, panel)
+	case "table", "table-old":
+		definition = c.dataTable(panelPath, panel)
+	case "text":
+		content := panel.Options.Content
+		if content == "" {
+			content = panel.Content
+		}
+		definition = map[string]any{"markdown": map[string]any{"markdownText": content, "tooltipText": ""}}
+	default:
+		c.result.unsupported(panelPath, "type %s is not supported", panel.Type)
+		return nil, false
+	}
+	if definition == nil {
+		return nil, false
+	}
+
+	widget := map[string]any{
+		"id":         c.id(path),
+		"title":      panel.Title,
+		"definition": definition,
+		// Grafana has 24 columns and Coralogix 12.
+		"layoutColumns": max((panel.GridPos.W+1)/2, 1),
+	}
+	if panel.Description != "" {
+		widget["description"] = panel.Description
+	}
+	return widget, true
+}
+
+func (c *converter) lineChart(path, panelPath string, panel grafanaPanel) map[string]any {
+	targets := c.promQLTargets(panelPath, panel)
+	if len(targets) == 0 {
+		c.result.unsupported(panelPath, "no PromQL target")
+		return nil
+	}
+
+	queryDefinitions := make([]any, 0, len(targets))
+	for i, target := range targets {
+		queryDefinition := map[string]any{
+			"id":         c.id(fmt.Sprintf("%s/query/%d", path, i))["value"],
+			"query":      metricsQuery(target.Expr, ""),
+			"name":       target.RefID,
+			"isVisible":  true,
+			"scaleType":  "SCALE_TYPE_LINEAR",
+			"unit":       lineChartUnit(panel.FieldConfig.Defaults.Unit),
+			"resolution": map[string]any{"bucketsPresented": 96},
+		}
+		if target.LegendFormat != "" {
+			queryDefinition["seriesNameTemplate"] = target.LegendFormat
+		}
+		queryDefinitions = append(queryDefinitions, queryDefinition)
+	}
+	return map[string]any{"lineChart": map[string]any{
+		"legend": map[string]any{
+			"isVisible":    true,
+			"columns":      []any{},
+			"groupByQuery": true,
+			"placement":    "LEGEND_PLACEMENT_AUTO",
+		},
+		"tooltip":          map[string]any{"showLabels": false, "type": "TOOLTIP_TYPE_ALL"},
+		"queryDefinitions": queryDefinitions,
+		"stackedLine":      "STACKED_LINE_UNSPECIFIED",
+	}}
+}
+
+func (c *converter) gauge(panelPath string, panel grafanaPanel) map[string]any {
+	target, ok := c.singlePromQLTarget(panelPath, panel)
+	if !ok {
+		return nil
+	}
+
+	query := metricsQuery(target.Expr, "PROM_QL_QUERY_TYPE_INSTANT")
+	query["metrics"].(map[string]any)["aggregation"] = gaugeAggregation(panel.Options.ReduceOptions.Calcs)
+
+	defaults := panel.FieldConfig.Defaults
+	minimum, maximum := 0.0, 100.0
+	if defaults.Min != nil {
+		minimum = *defaults.Min
+	}
+	if defaults.Max != nil {
+		maximum = *defaults.Max
+	}
+	thresholds := make([]any, 0, len(defaults.Thresholds.Steps))
+	for _, step := range defaults.Thresholds.Steps {
+		// The first step of Grafana has no value, and starts at the minimum.
+		from := minimum
+		if step.Value != nil {
+			from = *step.Value
+		}
+		thresholds = append(thresholds, map[string]any{"from": from, "color": step.Color})
+	}
+	return map[string]any{"gauge": map[string]any{
+		"query":             query,
+		"min":               minimum,
+		"max":               maximum,
+		"showInnerArc":      true,
+		"showOuterArc":      true,
+		"unit":              gaugeUnit(defaults.Unit),
+		"thresholds":        thresholds,
+		"thresholdType":     "THRESHOLD_TYPE_ABSOLUTE",
+		"dataModeType":      "DATA_MODE_TYPE_HIGH_UNSPECIFIED",
+		"displaySeriesName": true,
+	}}
+}
+
+func (c *converter) dataTable(panelPath string, panel grafanaPanel) map[string]any {
+	target, ok := c.singlePromQLTarget(panelPath, panel)
+	if !ok {
+		return nil
+	}
+
+	queryType := "PROM_QL_QUERY_TYPE_RANGE"
+	if target.Instant || target.Format == "table" {
+		queryType = "PROM_QL_QUERY_TYPE_INSTANT"
+	}
+	return map[string]any{"dataTable": map[string]any{
+		"query":          metricsQuery(target.Expr, queryType),
+		"resultsPerPage": 10,
+		"rowStyle":       "ROW_STYLE_ONE_LINE",
+		"columns":        []any{},
+		"dataModeType":   "DATA_MODE_TYPE_HIGH_UNSPECIFIED",
+	}}
+}
+
+// singlePromQLTarget returns the first PromQL target of a panel converted to a widget with a single query.
+func (c *converter) singlePromQLTarget(panelPath string, panel grafanaPanel) (grafanaTarget, bool) {
+	targets := c.promQLTargets(panelPath, panel)
+	if len(targets) == 0 {
+		c.result.unsupported(panelPath, "no PromQL target")
+		return grafanaTarget{}, false
+	}
+	for _, target := range targets[1:] {
+		c.result.unsupported(fmt.Sprintf("%s target %s", panelPath, target.RefID), "only the first target of %s panels is converted", panel.Type)
+	}
+	return targets[0], true
+}
+
+// promQLTargets returns the visible PromQL targets of a panel, and reports the other ones.
+func (c *converter) promQLTargets(panelPath string, panel grafanaPanel) []grafanaTarget {
+	var targets []grafanaTarget
+	for _, target := range panel.Targets {
+		if target.Hide {
+			continue
+		}
+		datasource := target.Datasource
+		if isNull(datasource) {
+			datasource = panel.Datasource
+		}
+		targetPath := fmt.Sprintf("%s target %s", panelPath, target.RefID)
+		if datasourceType := datasourceType(datasource); datasourceType != "" && datasourceType != "prometheus" {
+			c.result.unsupported(targetPath, "datasource type %s is not supported", datasourceType)
+			continue
+		}
+		if target.Expr == "" {
+			c.result.unsupported(targetPath, "only PromQL targets are supported")
+			continue
+		}
+		target.Expr = intervalVariables.ReplaceAllString(target.Expr, rateInterval)
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+func (c *converter) variables(variables []grafanaVariable) []any {
+	result := make([]any, 0, len(variables))
+	for _, variable := range variables {
+		path := fmt.Sprintf("variable %q", variable.Name)
+		var definition map[string]any
+		switch variable.Type {
+		case "query":
+			metric, label, ok := parseLabelValues(variableQuery(variable.Query))
+			if !ok {
+				c.result.unsupported(path, "only label_values(metric, label) queries are supported")
+				continue
+			}
+			definition = multiSelect(map[string]any{"metricLabel": map[string]any{"metricName": metric, "label": label}})
+		case "custom":
+			values := make([]string, 0, len(variable.Options))
+			for _, option := range variable.Options {
+				values = append(values, option.Value)
+			}
+			if len(values) == 0 {
+				for _, value := range strings.Split(variableQuery(variable.Query), ",") {
+					values = append(values, strings.TrimSpace(value))
+				}
+			}
+			definition = multiSelect(map[string]any{"constantList": map[string]any{"values": values}})
+		case "constant":
+			definition = map[string]any{"constant": map[string]any{"value": variableQuery(variable.Query)}}
+		default:
+			c.result.unsupported(path, "type %s is not supported", variable.Type)
+			continue
+		}
+
+		displayName := variable.Label
+		if displayName == "" {
+			displayName = variable.Name
+		}
+		result = append(result, map[string]any{
+			"name":        variable.Name,
+			"displayName": displayName,
+			"definition":  definition,
+		})
+	}
+	return result
+}
+
+func multiSelect(source map[string]any) map[string]any {
+	return map[string]any{"multiSelect": map[string]any{
+		"source":    source,
+		"selection": map[string]any{"all": map[string]any{}},
+	}}
+}
+
+// variableQuery returns the query of a variable, which is an object with a query field since Grafana 8.
+func variableQuery(query json.RawMessage) string {
+	var text string
+	if err := json.Unmarshal(query, &text); err == nil {
+		return text
+	}
+	var object struct {
+		Query string `json:"query"`
+	}
+	_ = json.Unmarshal(query, &object)
+	return object.Query
+}
+
+var labelValuesQuery = regexp.MustCompile(`^\s*label_values\(\s*([a-zA-Z_:][a-zA-Z0-9_:]*)\s*(\{.*\})?\s*,\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*\)\s*$`)
+
+// parseLabelValues parses a label_values(metric, label) query. The label selector of the metric, if any, is
+// dropped.
+func parseLabelValues(query string) (string, string, bool) {
+	match := labelValuesQuery.FindStringSubmatch(query)
+	if match == nil {
+		return "", "", false
+	}
+	return match[1], match[3], true
+}
+
+func metricsQuery(expr, queryType string) map[string]any {
+	metrics := map[string]any{
+		"promqlQuery": map[string]any{"value": expr},
+		"filters":     []any{},
+		"editorMode":  "METRICS_QUERY_EDITOR_MODE_TEXT",
+	}
+	if queryType != "" {
+		metrics["promqlQueryType"] = queryType
+	}
+	return map[string]any{"metrics": metrics}
+}
+
+var lineChartUnits = map[string]string{
+	"ns":          "UNIT_NANOSECONDS",
+	"µs":          "UNIT_MICROSECONDS",
+	"us":          "UNIT_MICROSECONDS",
+	"ms":          "UNIT_MILLISECONDS",
+	"s":           "UNIT_SECONDS",
+	"decbytes":    "UNIT_BYTES",
+	"deckbytes":   "UNIT_KBYTES",
+	"decmbytes":   "UNIT_MBYTES",
+	"decgbytes":   "UNIT_GBYTES",
+	"bytes":       "UNIT_BYTES_IEC",
+	"kbytes":      "UNIT_KIBYTES",
+	"mbytes":      "UNIT_MIBYTES",
+	"gbytes":      "UNIT_GIBYTES",
+	"percent":     "UNIT_PERCENT100",
+	"percentunit": "UNIT_PERCENT01",
+}
+
+func lineChartUnit(unit string) string {
+	if coralogixUnit, ok := lineChartUnits[unit]; ok {
+		return coralogixUnit
+	}
+	return "UNIT_UNSPECIFIED"
+}
+
+func gaugeUnit(unit string) string {
+	if unit == "percent" {
+		return "UNIT_PERCENT"
+	}
+	if coralogixUnit, ok := lineChartUnits[unit]; ok && unit != "percentunit" {
+		return coralogixUnit
+	}
+	return "UNIT_NUMBER"
+}
+
+var gaugeAggregations = map[string]string{
+	"lastNotNull": "AGGREGATION_LAST",
+	"last":        "AGGREGATION_LAST",
+	"min":         "AGGREGATION_MIN",
+	"max":         "AGGREGATION_MAX",
+	"mean":        "AGGREGATION_AVG",
+	"sum":         "AGGREGATION_SUM",
+}
+
+func gaugeAggregation(calcs []string) string {
+	if len(calcs) > 0 {
+		if aggregation, ok := gaugeAggregations[calcs[0]]; ok {
+			return aggregation
+		}
+	}
+	return "AGGREGATION_LAST"
+}
+
+// datasourceType returns the type of a datasource reference, which is only known when it is an object. Datasources
+// referred to by name are assumed to be Prometheus ones.
+func datasourceType(datasource json.RawMessage) string {
+	var reference struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(datasource, &reference); err != nil {
+		return ""
+	}
+	return reference.Type
+}
+
+func isNull(raw json.RawMessage) bool {
+	return len(raw) == 0 || string(raw) == "null"
+}
+
+var relativeTime = regexp.MustCompile(`^now-(\d+)([smhdw])$`)
+
+var relativeTimeUnits = map[string]int{"s": 1, "m": 60, "h": 3600, "d": 86400, "w": 604800}
+
+// relativeTimeFrame converts a Grafana time range start like now-6h into a protobuf duration.
+func relativeTimeFrame(from string) (string, bool) {
+	match := relativeTime.FindStringSubmatch(from)
+	if match == nil {
+		return "", false
+	}
+	value, err := strconv.Atoi(match[1])
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("%ds", value*relativeTimeUnits[match[2]]), true
+}
+
+// legacyRowHeight converts the height of a legacy row, in pixels, into grid units of 30 pixels.
+func legacyRowHeight(height json.RawMessage) int {
+	text := strings.Trim(string(height), `"`)
+	pixels, err := strconv.Atoi(strings.TrimSuffix(text, "px"))
+	if err != nil || pixels <= 0 {
+		return 8
+	}
+	return max(pixels/30, 1)
+}
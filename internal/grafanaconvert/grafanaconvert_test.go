@@ -0,0 +1,187 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grafanaconvert
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const podsDashboard = `{
+  "title": "Kubernetes / Pods",
+  "description": "Pod resources.",
+  "time": {"from": "now-6h", "to": "now"},
+  "templating": {"list": [
+    {"name": "namespace", "label": "Namespace", "type": "query", "query": {"query": "label_values(kube_pod_info{cluster=\"$cluster\"}, namespace)", "refId": "A"}},
+    {"name": "quantile", "type": "custom", "query": "0.5,0.99", "options": [{"value": "0.5"}, {"value": "0.99"}]},
+    {"name": "job", "type": "constant", "query": "kubelet"},
+    {"name": "datasource", "type": "datasource", "query": "prometheus"},
+    {"name": "pod", "type": "query", "query": "query_result(up)"}
+  ]},
+  "panels": [
+    {"type": "stat", "title": "Pods", "gridPos": {"h": 4, "w": 6, "x": 0, "y": 0},
+     "datasource": {"type": "prometheus", "uid": "prometheus"},
+     "options": {"reduceOptions": {"calcs": ["mean"]}},
+     "fieldConfig": {"defaults": {"unit": "percent", "max": 50, "thresholds": {"steps": [{"value": null, "color": "green"}, {"value": 40, "color": "red"}]}}},
+     "targets": [{"refId": "A", "expr": "count(kube_pod_info)"}, {"refId": "B", "expr": "count(up)"}]},
+    {"type": "text", "title": "About", "gridPos": {"h": 4, "w": 18, "x": 6, "y": 0}, "options": {"content": "# Pods"}},
+    {"type": "row", "title": "Resources", "collapsed": false, "gridPos": {"h": 1, "w": 24, "x": 0, "y": 4}},
+    {"type": "timeseries", "title": "CPU", "description": "CPU usage.", "gridPos": {"h": 8, "w": 12, "x": 0, "y": 5},
+     "fieldConfig": {"defaults": {"unit": "s"}},
+     "targets": [{"refId": "A", "expr": "sum(rate(container_cpu_usage_seconds_total[$__rate_interval])) by (pod)", "legendFormat": "{{pod}}"},
+                 {"refId": "B", "expr": "hidden", "hide": true}]},
+    {"type": "piechart", "title": "Share", "gridPos": {"h": 8, "w": 12, "x": 12, "y": 5}},
+    {"type": "row", "title": "Details", "collapsed": true, "gridPos": {"h": 1, "w": 24, "x": 0, "y": 13}, "panels": [
+      {"type": "table", "title": "Restarts", "gridPos": {"h": 6, "w": 24, "x": 0, "y": 14},
+       "targets": [{"refId": "A", "expr": "sum(kube_pod_container_status_restarts_total) by (pod)", "format": "table"}]},
+      {"type": "timeseries", "title": "Logs", "gridPos": {"h": 6, "w": 24, "x": 0, "y": 20},
+       "datasource": {"type": "loki", "uid": "loki"},
+       "targets": [{"refId": "A", "expr": "{namespace=\"default\"}"}]}
+    ]}
+  ]
+}`
+
+func TestConvert(t *testing.T) {
+	result, err := Convert([]byte(podsDashboard))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`panel "Pods" target B: only the first target of stat panels is converted`,
+		`panel "Share": type piechart is not supported`,
+		`panel "Logs" target A: datasource type loki is not supported`,
+		`panel "Logs": no PromQL target`,
+		`variable "datasource": type datasource is not supported`,
+		`variable "pod": only label_values(metric, label) queries are supported`,
+	}, result.Unsupported)
+
+	var dashboard map[string]any
+	require.NoError(t, json.Unmarshal(result.Dashboard, &dashboard))
+	require.Equal(t, "Kubernetes / Pods", dashboard["name"])
+	require.Equal(t, "Pod resources.", dashboard["description"])
+	require.Equal(t, "21600s", dashboard["relativeTimeFrame"])
+
+	sections := dashboard["layout"].(map[string]any)["sections"].([]any)
+	require.Len(t, sections, 3)
+
+	// Panels before the first row.
+	overview := sections[0].(map[string]any)
+	require.Equal(t, map[string]any{"internal": map[string]any{}}, overview["options"])
+	rows := overview["rows"].([]any)
+	require.Len(t, rows, 1)
+	require.Equal(t, map[string]any{"height": float64(8)}, rows[0].(map[string]any)["appearance"])
+	widgets := rows[0].(map[string]any)["widgets"].([]any)
+	require.Len(t, widgets, 2)
+
+	stat := widgets[0].(map[string]any)
+	require.Equal(t, "Pods", stat["title"])
+	require.Equal(t, float64(3), stat["layoutColumns"])
+	gauge := stat["definition"].(map[string]any)["gauge"].(map[string]any)
+	require.Equal(t, "count(kube_pod_info)", gauge["query"].(map[string]any)["metrics"].(map[string]any)["promqlQuery"].(map[string]any)["value"])
+	require.Equal(t, "AGGREGATION_AVG", gauge["query"].(map[string]any)["metrics"].(map[string]any)["aggregation"])
+	require.Equal(t, "UNIT_PERCENT", gauge["unit"])
+	require.Equal(t, float64(50), gauge["max"])
+	require.Equal(t, []any{
+		map[string]any{"from": float64(0), "color": "green"},
+		map[string]any{"from": float64(40), "color": "red"},
+	}, gauge["thresholds"])
+
+	text := widgets[1].(map[string]any)
+	require.Equal(t, map[string]any{"markdown": map[string]any{"markdownText": "# Pods", "tooltipText": ""}}, text["definition"])
+
+	// An expanded row.
+	resources := sections[1].(map[string]any)
+	require.Equal(t, map[string]any{"custom": map[string]any{"name": "Resources", "collapsed": false}}, resources["options"])
+	widgets = resources["rows"].([]any)[0].(map[string]any)["widgets"].([]any)
+	require.Len(t, widgets, 1, "the pie chart is not converted")
+	cpu := widgets[0].(map[string]any)
+	require.Equal(t, "CPU usage.", cpu["description"])
+	queryDefinitions := cpu["definition"].(map[string]any)["lineChart"].(map[string]any)["queryDefinitions"].([]any)
+	require.Len(t, queryDefinitions, 1, "hidden targets are not converted")
+	queryDefinition := queryDefinitions[0].(map[string]any)
+	require.Equal(t, "sum(rate(container_cpu_usage_seconds_total[5m])) by (pod)",
+		queryDefinition["query"].(map[string]any)["metrics"].(map[string]any)["promqlQuery"].(map[string]any)["value"])
+	require.Equal(t, "{{pod}}", queryDefinition["seriesNameTemplate"])
+	require.Equal(t, "UNIT_SECONDS", queryDefinition["unit"])
+
+	// A collapsed row.
+	details := sections[2].(map[string]any)
+	require.Equal(t, map[string]any{"custom": map[string]any{"name": "Details", "collapsed": true}}, details["options"])
+	rows = details["rows"].([]any)
+	require.Len(t, rows, 1, "the row of the Loki panel is dropped")
+	table := rows[0].(map[string]any)["widgets"].([]any)[0].(map[string]any)["definition"].(map[string]any)["dataTable"].(map[string]any)
+	require.Equal(t, "PROM_QL_QUERY_TYPE_INSTANT", table["query"].(map[string]any)["metrics"].(map[string]any)["promqlQueryType"])
+
+	require.Equal(t, []any{
+		map[string]any{
+			"name":        "namespace",
+			"displayName": "Namespace",
+			"definition": map[string]any{"multiSelect": map[string]any{
+				"source":    map[string]any{"metricLabel": map[string]any{"metricName": "kube_pod_info", "label": "namespace"}},
+				"selection": map[string]any{"all": map[string]any{}},
+			}},
+		},
+		map[string]any{
+			"name":        "quantile",
+			"displayName": "quantile",
+			"definition": map[string]any{"multiSelect": map[string]any{
+				"source":    map[string]any{"constantList": map[string]any{"values": []any{"0.5", "0.99"}}},
+				"selection": map[string]any{"all": map[string]any{}},
+			}},
+		},
+		map[string]any{
+			"name":        "job",
+			"displayName": "job",
+			"definition":  map[string]any{"constant": map[string]any{"value": "kubelet"}},
+		},
+	}, dashboard["variables"])
+
+	again, err := Convert([]byte(podsDashboard))
+	require.NoError(t, err)
+	require.Equal(t, string(result.Dashboard), string(again.Dashboard), "the conversion is deterministic")
+}
+
+func TestConvertUnwrapsAPIDashboards(t *testing.T) {
+	result, err := Convert([]byte(`{"dashboard": {"title": "Wrapped", "panels": []}, "meta": {"slug": "wrapped"}}`))
+	require.NoError(t, err)
+	require.Empty(t, result.Unsupported)
+	require.JSONEq(t, `{"name": "Wrapped", "layout": {"sections": []}, "variables": [], "filters": []}`, string(result.Dashboard))
+}
+
+func TestConvertLegacyRows(t *testing.T) {
+	result, err := Convert([]byte(`{"title": "Legacy", "rows": [{"title": "Hidden title", "height": "300px", "panels": [
+		{"type": "graph", "title": "Requests", "span": 6, "targets": [{"refId": "A", "expr": "sum(rate(requests_total[$__interval]))"}]},
+		{"type": "singlestat", "title": "Errors", "span": 6, "targets": [{"refId": "A", "expr": "sum(errors_total)"}]}
+	]}]}`))
+	require.NoError(t, err)
+	require.Empty(t, result.Unsupported)
+
+	var dashboard map[string]any
+	require.NoError(t, json.Unmarshal(result.Dashboard, &dashboard))
+	section := dashboard["layout"].(map[string]any)["sections"].([]any)[0].(map[string]any)
+	require.Equal(t, map[string]any{"internal": map[string]any{}}, section["options"], "untitled rows have no name")
+	row := section["rows"].([]any)[0].(map[string]any)
+	require.Equal(t, map[string]any{"height": float64(20)}, row["appearance"])
+	widgets := row["widgets"].([]any)
+	require.Len(t, widgets, 2)
+	require.Equal(t, float64(6), widgets[0].(map[string]any)["layoutColumns"])
+	require.Contains(t, widgets[0].(map[string]any)["definition"], "lineChart")
+	require.Contains(t, widgets[1].(map[string]any)["definition"], "gauge")
+}
+
+func TestConvertInvalidJSON(t *testing.T) {
+	_, err := Convert([]byte(`{"title": `))
+	require.ErrorContains(t, err, "failed to unmarshal Grafana dashboard")
+}
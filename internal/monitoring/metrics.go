@@ -51,6 +51,8 @@ var metricsList = []prometheus.Collector{
 	resourceInfoMetric,
 	requestsTotalMetric,
 	requestsLatencyMetric,
+	orphanedRemoteObjectsMetric,
+	orphanedRemoteObjectsDeletedMetric,
 }
 
 var (
@@ -83,6 +85,20 @@ var (
 		},
 		[]string{"verb", "url"},
 	)
+	orphanedRemoteObjectsMetric = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cx_operator_orphaned_remote_objects",
+			Help: "Number of remote objects created by the Coralogix Operator without a matching custom resource, as of the last sweep.",
+		},
+		[]string{"kind"},
+	)
+	orphanedRemoteObjectsDeletedMetric = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cx_operator_orphaned_remote_objects_deleted_total",
+			Help: "Total number of orphaned remote objects deleted by the Coralogix Operator.",
+		},
+		[]string{"kind"},
+	)
 )
 
 func SetOperatorInfoMetric(goVersion, operatorVersion, url string) {
@@ -124,6 +140,16 @@ func DeleteResourceInfoMetric(kind, name, namespace string) {
 	resourceInfoMetric.DeleteLabelValues(kind, name, namespace, remoteUnsynced)
 }
 
+func SetOrphanedRemoteObjectsMetric(kind string, count int) {
+	metricsLog.V(1).Info("Setting orphaned remote objects metric", "kind", kind, "count", count)
+	orphanedRemoteObjectsMetric.WithLabelValues(kind).Set(float64(count))
+}
+
+func IncOrphanedRemoteObjectsDeletedMetric(kind string) {
+	metricsLog.V(1).Info("Incrementing orphaned remote objects deleted metric", "kind", kind)
+	orphanedRemoteObjectsDeletedMetric.WithLabelValues(kind).Inc()
+}
+
 var _ clientmetrics.ResultMetric = &ResultAdapter{}
 
 type ResultAdapter struct {
@@ -0,0 +1,302 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package orphangc periodically looks for remote objects stamped with the provenance of this cluster whose custom
+// resource no longer exists, e.g. because its finalizer was removed by hand, its namespace was deleted while the
+// operator was down, or its status lost the ID of the remote object. Orphans are reported through metrics and
+// Events, and deleted only in ModeDelete.
+//
+// Alerts, including the alerts of AlertSets, and SLOs are swept. Dashboards are not, since their provenance is only
+// in their description, which is not part of the dashboards catalog.
+package orphangc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/coralogix/coralogix-management-sdk/go/openapi/cxsdk"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/monitoring"
+	"github.com/coralogix/coralogix-operator/v2/internal/provenance"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// Mode defines what the sweeper does with the orphans it finds.
+type Mode string
+
+const (
+	// ModeDisabled does not start the sweeper.
+	ModeDisabled Mode = "disabled"
+	// ModeDryRun only reports orphans.
+	ModeDryRun Mode = "dry-run"
+	// ModeDelete reports and deletes orphans.
+	ModeDelete Mode = "delete"
+)
+
+const (
+	ReasonOrphanedRemoteObject        = "OrphanedRemoteObject"
+	ReasonOrphanedRemoteObjectDeleted = "OrphanedRemoteObjectDeleted"
+)
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Sweeper finds and deletes orphaned remote objects. It only runs on the leader.
+type Sweeper struct {
+	ClientSet *cxsdk.ClientSet
+	// Reader is used to get the custom resources, bypassing the cache so that a resource whose remote object was
+	// just recreated is never seen with the ID it had before.
+	Reader   client.Reader
+	Recorder record.EventRecorder
+	Mode     Mode
+	Interval time.Duration
+	// MaxDeletions caps the number of orphans deleted by a single sweep. Orphans above it are deleted by the
+	// following sweeps.
+	MaxDeletions int
+}
+
+var _ manager.LeaderElectionRunnable = &Sweeper{}
+
+// remoteObject is a remote object stamped with the provenance of this cluster.
+type remoteObject struct {
+	// kind is the kind of the remote object, e.g. "alert".
+	kind   string
+	id     string
+	name   string
+	labels map[string]string
+	// createdTime is when the remote object was created, or zero if the API does not return it.
+	createdTime time.Time
+}
+
+func (o remoteObject) owner() string {
+	return fmt.Sprintf("%s %s/%s", o.labels[provenance.KindLabel], o.labels[provenance.NamespaceLabel], o.labels[provenance.NameLabel])
+}
+
+// SetupWithManager adds the sweeper to the manager.
+func (s *Sweeper) SetupWithManager(mgr ctrl.Manager) error {
+	return mgr.Add(s)
+}
+
+func (s *Sweeper) NeedLeaderElection() bool {
+	return true
+}
+
+func (s *Sweeper) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("orphan-gc")
+	log.Info("Starting orphaned remote objects sweeper", "mode", s.Mode, "interval", s.Interval, "maxDeletions", s.MaxDeletions)
+	wait.JitterUntilWithContext(ctx, func(ctx context.Context) {
+		if err := s.sweep(ctx, log); err != nil {
+			log.Error(err, "Error sweeping orphaned remote objects")
+		}
+	}, s.Interval, 0.1, true)
+	return nil
+}
+
+func (s *Sweeper) sweep(ctx context.Context, log logr.Logger) error {
+	var remoteObjects []remoteObject
+	alerts, err := s.listAlerts(ctx)
+	if err != nil {
+		return err
+	}
+	remoteObjects = append(remoteObjects, alerts...)
+	slos, err := s.listSLOs(ctx)
+	if err != nil {
+		return err
+	}
+	remoteObjects = append(remoteObjects, slos...)
+
+	orphans := map[string]int{"alert": 0, "slo": 0}
+	deletions := 0
+	for _, obj := range remoteObjects {
+		orphaned, err := s.isOrphaned(ctx, obj)
+		if err != nil {
+			return err
+		}
+		if !orphaned {
+			continue
+		}
+
+		orphans[obj.kind]++
+		log.Info("Found orphaned remote object", "kind", obj.kind, "id", obj.id, "name", obj.name, "owner", obj.owner())
+		s.event(obj, corev1.EventTypeWarning, ReasonOrphanedRemoteObject, "Remote %s %q (%s) has no matching %s", obj.kind, obj.name, obj.id, obj.owner())
+
+		if s.Mode != ModeDelete {
+			continue
+		}
+		if deletions >= s.MaxDeletions {
+			log.Info("Skipping deletion of orphaned remote object, the maximum deletions per sweep was reached",
+				"kind", obj.kind, "id", obj.id, "maxDeletions", s.MaxDeletions)
+			continue
+		}
+		if err := s.delete(ctx, obj); err != nil {
+			log.Error(err, "Error deleting orphaned remote object", "kind", obj.kind, "id", obj.id)
+			continue
+		}
+		deletions++
+		orphans[obj.kind]--
+		monitoring.IncOrphanedRemoteObjectsDeletedMetric(obj.kind)
+		log.Info("Orphaned remote object deleted", "kind", obj.kind, "id", obj.id)
+		s.event(obj, corev1.EventTypeNormal, ReasonOrphanedRemoteObjectDeleted, "Remote %s %q (%s) of %s was deleted", obj.kind, obj.name, obj.id, obj.owner())
+	}
+
+	for kind, count := range orphans {
+		monitoring.SetOrphanedRemoteObjectsMetric(kind, count)
+	}
+	return nil
+}
+
+func (s *Sweeper) listAlerts(ctx context.Context) ([]remoteObject, error) {
+	listResponse, httpResp, err := s.ClientSet.Alerts().AlertDefsServiceListAlertDefs(ctx).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("error on listing remote alerts: %w", cxsdk.NewAPIError(httpResp, err))
+	}
+
+	var remoteObjects []remoteObject
+	for _, alertDef := range listResponse.GetAlertDefs() {
+		properties := alertDef.GetAlertDefProperties()
+		if labels := properties.GetEntityLabels(); isStamped(labels) {
+			remoteObjects = append(remoteObjects, remoteObject{
+				kind: "alert", id: alertDef.GetId(), name: properties.GetName(), labels: labels, createdTime: alertDef.GetCreatedTime(),
+			})
+		}
+	}
+	return remoteObjects, nil
+}
+
+func (s *Sweeper) listSLOs(ctx context.Context) ([]remoteObject, error) {
+	listResponse, httpResp, err := s.ClientSet.SLOs().SlosServiceListSlos(ctx).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("error on listing remote slos: %w", cxsdk.NewAPIError(httpResp, err))
+	}
+
+	var remoteObjects []remoteObject
+	for _, slo := range listResponse.Slos {
+		if labels := slo.GetLabels(); isStamped(labels) {
+			remoteObjects = append(remoteObjects, remoteObject{
+				kind: "slo", id: slo.GetId(), name: slo.GetName(), labels: labels, createdTime: slo.GetCreateTime(),
+			})
+		}
+	}
+	return remoteObjects, nil
+}
+
+// isStamped returns whether the labels show a remote object was created by a resource of this cluster.
+func isStamped(labels map[string]string) bool {
+	return labels[provenance.ClusterLabel] == config.GetConfig().ClusterName
+}
+
+// isOrphaned returns whether the resource managing a remote object was deleted, or now manages another remote
+// object. Resources still creating their remote object, i.e. without an ID in their status, are never considered
+// to have lost it, and neither are remote objects stamped without a kind. The shadow alerts of rollouts, marked by
+// their coralogixv1beta1.ShadowAlertLabelKey label, are orphaned as soon as their resource does not record them, e.g.
+// when the status update recording a created shadow alert failed, since their resource never looks for them again.
+//
+// Remote objects created within the last sweep interval are never orphaned either, since their resource may not have
+// recorded them yet, e.g. the alert of an item just added to an AlertSet, whose other items already have IDs.
+func (s *Sweeper) isOrphaned(ctx context.Context, obj remoteObject) (bool, error) {
+	if time.Since(obj.createdTime) < s.Interval {
+		return false, nil
+	}
+
+	key := client.ObjectKey{Namespace: obj.labels[provenance.NamespaceLabel], Name: obj.labels[provenance.NameLabel]}
+	_, shadow := obj.labels[coralogixv1beta1.ShadowAlertLabelKey]
+	switch obj.labels[provenance.KindLabel] {
+	case utils.AlertKind:
+		alert := &coralogixv1beta1.Alert{}
+		if found, err := s.get(ctx, key, alert); !found {
+			return err == nil, err
+		}
+		if shadow {
+			rollout := alert.Status.Rollout
+			return rollout == nil || rollout.ShadowID == nil || *rollout.ShadowID != obj.id, nil
+		}
+		return alert.Status.ID != nil && *alert.Status.ID != obj.id, nil
+	case utils.AlertSetKind:
+		alertSet := &coralogixv1alpha1.AlertSet{}
+		if found, err := s.get(ctx, key, alertSet); !found {
+			return err == nil, err
+		}
+		var ids []string
+		for _, item := range alertSet.Status.Alerts {
+			if shadow {
+				if item.Rollout != nil && item.Rollout.ShadowID != nil {
+					ids = append(ids, *item.Rollout.ShadowID)
+				}
+				continue
+			}
+			if item.ID == nil {
+				return false, nil
+			}
+			ids = append(ids, *item.ID)
+		}
+		return !slices.Contains(ids, obj.id), nil
+	case utils.SLOKind:
+		slo := &coralogixv1alpha1.SLO{}
+		if found, err := s.get(ctx, key, slo); !found {
+			return err == nil, err
+		}
+		return slo.Status.ID != nil && *slo.Status.ID != obj.id, nil
+	default:
+		return false, nil
+	}
+}
+
+func (s *Sweeper) get(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
+	if err := s.Reader.Get(ctx, key, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error on getting %s: %w", key, err)
+	}
+	return true, nil
+}
+
+func (s *Sweeper) delete(ctx context.Context, obj remoteObject) error {
+	var httpResp *http.Response
+	var err error
+	switch obj.kind {
+	case "alert":
+		_, httpResp, err = s.ClientSet.Alerts().AlertDefsServiceDeleteAlertDef(ctx, obj.id).Execute()
+	case "slo":
+		_, httpResp, err = s.ClientSet.SLOs().SlosServiceDeleteSlo(ctx, obj.id).Execute()
+	}
+	if err != nil {
+		if apiErr := cxsdk.NewAPIError(httpResp, err); !cxsdk.IsNotFound(apiErr) {
+			return apiErr
+		}
+	}
+	return nil
+}
+
+// event records an Event on the namespace of the resource that managed the remote object, since the resource itself
+// is usually gone.
+func (s *Sweeper) event(obj remoteObject, eventType, reason, messageFmt string, args ...interface{}) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: obj.labels[provenance.NamespaceLabel]}}
+	s.Recorder.Eventf(namespace, eventType, reason, messageFmt, args...)
+}
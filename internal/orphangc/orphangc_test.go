@@ -0,0 +1,115 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orphangc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/provenance"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+func TestIsOrphaned(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, coralogixv1alpha1.AddToScheme(scheme))
+	require.NoError(t, coralogixv1beta1.AddToScheme(scheme))
+
+	sweeper := &Sweeper{
+		Interval: time.Hour,
+		Reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&coralogixv1beta1.Alert{
+				ObjectMeta: metav1.ObjectMeta{Name: "errors", Namespace: "checkout"},
+				Status:     coralogixv1beta1.AlertStatus{ID: ptr.To("alert-1")},
+			},
+			&coralogixv1beta1.Alert{
+				ObjectMeta: metav1.ObjectMeta{Name: "creating", Namespace: "checkout"},
+			},
+			&coralogixv1beta1.Alert{
+				ObjectMeta: metav1.ObjectMeta{Name: "rolling-out", Namespace: "checkout"},
+				Status: coralogixv1beta1.AlertStatus{
+					ID:      ptr.To("alert-10"),
+					Rollout: &coralogixv1beta1.AlertRolloutStatus{ShadowID: ptr.To("alert-8")},
+				},
+			},
+			&coralogixv1alpha1.AlertSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "latency", Namespace: "checkout"},
+				Status: coralogixv1alpha1.AlertSetStatus{Alerts: []coralogixv1alpha1.AlertSetItemStatus{
+					{Key: "p95", ID: ptr.To("alert-2")},
+					{Key: "p99", ID: ptr.To("alert-3"), Rollout: &coralogixv1beta1.AlertRolloutStatus{ShadowID: ptr.To("alert-11")}},
+				}},
+			},
+		).Build(),
+	}
+
+	remote := func(id, kind, name string) remoteObject {
+		return remoteObject{kind: "alert", id: id, labels: map[string]string{
+			provenance.KindLabel:      kind,
+			provenance.NamespaceLabel: "checkout",
+			provenance.NameLabel:      name,
+		}}
+	}
+
+	recent := func(id, kind, name string) remoteObject {
+		obj := remote(id, kind, name)
+		obj.createdTime = time.Now().Add(-time.Minute)
+		return obj
+	}
+
+	shadow := func(obj remoteObject) remoteObject {
+		obj.labels[coralogixv1beta1.ShadowAlertLabelKey] = "shadow"
+		return obj
+	}
+
+	for _, tc := range []struct {
+		name     string
+		obj      remoteObject
+		orphaned bool
+	}{
+		{name: "managed alert", obj: remote("alert-1", utils.AlertKind, "errors")},
+		{name: "alert replaced by another one", obj: remote("alert-0", utils.AlertKind, "errors"), orphaned: true},
+		{name: "deleted alert", obj: remote("alert-1", utils.AlertKind, "deleted"), orphaned: true},
+		{name: "alert still being created", obj: remote("alert-4", utils.AlertKind, "creating")},
+		{name: "alert of an alert set", obj: remote("alert-3", utils.AlertSetKind, "latency")},
+		{name: "alert removed from an alert set", obj: remote("alert-5", utils.AlertSetKind, "latency"), orphaned: true},
+		{name: "alert just added to an alert set", obj: recent("alert-7", utils.AlertSetKind, "latency")},
+		{name: "deleted alert created within the sweep interval", obj: recent("alert-1", utils.AlertKind, "deleted")},
+		{name: "shadow alert", obj: shadow(remote("alert-8", utils.AlertKind, "rolling-out"))},
+		{name: "shadow alert replaced by another one", obj: shadow(remote("alert-9", utils.AlertKind, "rolling-out")), orphaned: true},
+		{name: "shadow alert never recorded", obj: shadow(remote("alert-9", utils.AlertKind, "errors")), orphaned: true},
+		{name: "shadow alert just created", obj: shadow(recent("alert-9", utils.AlertKind, "errors"))},
+		{name: "shadow alert of a deleted alert", obj: shadow(remote("alert-8", utils.AlertKind, "deleted")), orphaned: true},
+		{name: "shadow alert of an alert set", obj: shadow(remote("alert-11", utils.AlertSetKind, "latency"))},
+		{name: "shadow alert of an alert set never recorded", obj: shadow(remote("alert-9", utils.AlertSetKind, "latency")), orphaned: true},
+		{name: "shadow alert of an alert set just created", obj: shadow(recent("alert-9", utils.AlertSetKind, "latency"))},
+		{name: "deleted slo", obj: remote("slo-1", utils.SLOKind, "availability"), orphaned: true},
+		{name: "stamped without a kind", obj: remote("alert-6", "", "deleted")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			orphaned, err := sweeper.isOrphaned(context.Background(), tc.obj)
+			require.NoError(t, err)
+			require.Equal(t, tc.orphaned, orphaned)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package provenance stamps the remote objects created by the operator with the cluster, namespace, name, UID and
+// kind of the custom resource managing them, and the version of the operator. The provenance is written as labels
+// where the Coralogix API has them, as a line of the description otherwise, and as a suffix of the name for objects
+// with neither, e.g. outbound webhooks.
+//
+// Before a remote object is updated or deleted, its provenance is compared with the custom resource, so that an
+// operator never modifies an object managed from another cluster or by another resource. Remote objects without
+// provenance, e.g. created before it was enabled, are not considered foreign and are stamped on their next update.
+// The alerts of AlertSets are stamped but not checked, since they are synced with bulk requests.
+package provenance
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+)
+
+const (
+	ClusterLabel         = "k8s.cluster.name"
+	NamespaceLabel       = "k8s.namespace.name"
+	NameLabel            = "k8s.resource.name"
+	UIDLabel             = "k8s.resource.uid"
+	KindLabel            = "k8s.resource.kind"
+	OperatorVersionLabel = "coralogix-operator.version"
+
+	descriptionPrefix = "Managed by coralogix-operator: "
+	nameSuffixPrefix  = " [coralogix-operator: "
+	nameSuffixEnd     = "]"
+)
+
+// ownerLabels are the labels identifying the resource managing a remote object. The UID is not part of them, so
+// that a resource recreated with the same name, e.g. when restored from a backup, keeps managing its objects.
+var ownerLabels = []string{ClusterLabel, NamespaceLabel, NameLabel}
+
+// ForeignError is returned when a remote object is managed by another resource.
+type ForeignError struct {
+	// Owner is the resource managing the remote object, as `<cluster>/<namespace>/<name>`.
+	Owner string
+}
+
+func (e *ForeignError) Error() string {
+	return fmt.Sprintf("remote object is managed by %s", e.Owner)
+}
+
+// IsForeignError returns whether err wraps a ForeignError.
+func IsForeignError(err error) bool {
+	var foreignErr *ForeignError
+	return errors.As(err, &foreignErr)
+}
+
+// Enabled returns whether remote objects are stamped with their provenance.
+func Enabled() bool {
+	return config.GetConfig().ProvenanceLabels
+}
+
+// Labels returns the provenance labels of the remote objects of obj, or nil if provenance is disabled.
+func Labels(obj client.Object) map[string]string {
+	if !Enabled() {
+		return nil
+	}
+
+	labels := map[string]string{
+		ClusterLabel:   config.GetConfig().ClusterName,
+		NamespaceLabel: obj.GetNamespace(),
+		NameLabel:      obj.GetName(),
+		UIDLabel:       string(obj.GetUID()),
+	}
+	if version := config.GetConfig().OperatorVersion; version != "" {
+		labels[OperatorVersionLabel] = version
+	}
+	if scheme := config.GetScheme(); scheme != nil {
+		if gvk, err := apiutil.GVKForObject(obj, scheme); err == nil {
+			labels[KindLabel] = gvk.Kind
+		}
+	}
+	return labels
+}
+
+// WithLabels returns a copy of labels with the provenance labels of obj added, overriding labels with the same keys.
+// labels is returned as is if provenance is disabled.
+func WithLabels(labels map[string]string, obj client.Object) map[string]string {
+	provenance := Labels(obj)
+	if provenance == nil {
+		return labels
+	}
+
+	out := maps.Clone(labels)
+	if out == nil {
+		out = make(map[string]string, len(provenance))
+	}
+	maps.Copy(out, provenance)
+	return out
+}
+
+// WithDescription returns the description with a last line describing the provenance of obj. A provenance line
+// already in the description, e.g. when it was exported from Coralogix, is replaced. The description is returned
+// as is if provenance is disabled.
+func WithDescription(description string, obj client.Object) string {
+	provenance := Labels(obj)
+	if provenance == nil {
+		return description
+	}
+
+	var pairs []string
+	for _, key := range slices.Sorted(maps.Keys(provenance)) {
+		pairs = append(pairs, key+"="+provenance[key])
+	}
+	line := descriptionPrefix + strings.Join(pairs, ", ")
+
+	description = stripDescription(description)
+	if description == "" {
+		return line
+	}
+	return description + "\n\n" + line
+}
+
+// FromDescription returns the provenance labels written into the description by WithDescription, or nil if it has
+// none.
+func FromDescription(description string) map[string]string {
+	index := strings.LastIndex(description, descriptionPrefix)
+	if index < 0 {
+		return nil
+	}
+
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(description[index+len(descriptionPrefix):], ", ") {
+		if key, value, found := strings.Cut(strings.TrimSpace(pair), "="); found {
+			labels[key] = value
+		}
+	}
+	return labels
+}
+
+func stripDescription(description string) string {
+	if index := strings.LastIndex(description, descriptionPrefix); index >= 0 {
+		description = description[:index]
+	}
+	return strings.TrimRight(description, "\n ")
+}
+
+// WithNameSuffix returns the name with a suffix describing the cluster, namespace and name of obj. A suffix already
+// in the name is replaced. The name is returned as is if provenance is disabled.
+func WithNameSuffix(name string, obj client.Object) string {
+	if !Enabled() {
+		return name
+	}
+
+	owner := fmt.Sprintf("%s/%s/%s", config.GetConfig().ClusterName, obj.GetNamespace(), obj.GetName())
+	return StripNameSuffix(name) + nameSuffixPrefix + owner + nameSuffixEnd
+}
+
+// FromNameSuffix returns the cluster, namespace and name labels written into the name by WithNameSuffix, or nil if
+// it has none.
+func FromNameSuffix(name string) map[string]string {
+	index := strings.LastIndex(name, nameSuffixPrefix)
+	if index < 0 || !strings.HasSuffix(name, nameSuffixEnd) {
+		return nil
+	}
+
+	owner := strings.TrimSuffix(name[index+len(nameSuffixPrefix):], nameSuffixEnd)
+	// Namespaces and names cannot contain slashes, so only the cluster name may.
+	parts := strings.Split(owner, "/")
+	if len(parts) < 3 {
+		return nil
+	}
+	return map[string]string{
+		ClusterLabel:   strings.Join(parts[:len(parts)-2], "/"),
+		NamespaceLabel: parts[len(parts)-2],
+		NameLabel:      parts[len(parts)-1],
+	}
+}
+
+// StripNameSuffix returns the name without the suffix written by WithNameSuffix.
+func StripNameSuffix(name string) string {
+	if FromNameSuffix(name) == nil {
+		return name
+	}
+	return name[:strings.LastIndex(name, nameSuffixPrefix)]
+}
+
+// Check returns a ForeignError if the provenance labels of a remote object show that it is managed by another
+// resource than obj. It always succeeds if provenance is disabled or the remote object has no provenance.
+func Check(obj client.Object, remote map[string]string) error {
+	if !Enabled() {
+		return nil
+	}
+	if _, found := remote[ClusterLabel]; !found {
+		return nil
+	}
+
+	local := Labels(obj)
+	for _, key := range ownerLabels {
+		if remote[key] != local[key] {
+			return &ForeignError{
+				Owner: fmt.Sprintf("%s/%s/%s", remote[ClusterLabel], remote[NamespaceLabel], remote[NameLabel]),
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provenance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+)
+
+var resource = &corev1.ConfigMap{
+	ObjectMeta: metav1.ObjectMeta{Name: "errors", Namespace: "checkout", UID: "0b5e2f6a"},
+}
+
+func TestWithLabels(t *testing.T) {
+	setupProvenanceTest(t, true)
+
+	labels := map[string]string{"owner": "checkout", ClusterLabel: "other"}
+	require.Equal(t, map[string]string{
+		"owner":              "checkout",
+		ClusterLabel:         "production",
+		NamespaceLabel:       "checkout",
+		NameLabel:            "errors",
+		UIDLabel:             "0b5e2f6a",
+		OperatorVersionLabel: "2.0.0",
+	}, WithLabels(labels, resource))
+	require.Equal(t, "other", labels[ClusterLabel], "the labels are not modified")
+
+	require.Len(t, WithLabels(nil, resource), 5)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	originalScheme := config.GetScheme()
+	t.Cleanup(func() { config.InitScheme(originalScheme) })
+	config.InitScheme(scheme)
+	require.Equal(t, "ConfigMap", Labels(resource)[KindLabel])
+}
+
+func TestWithDescription(t *testing.T) {
+	setupProvenanceTest(t, true)
+
+	description := WithDescription("Checkout errors.", resource)
+	require.Equal(t, "Checkout errors.\n\nManaged by coralogix-operator: coralogix-operator.version=2.0.0, "+
+		"k8s.cluster.name=production, k8s.namespace.name=checkout, k8s.resource.name=errors, k8s.resource.uid=0b5e2f6a",
+		description)
+	require.Equal(t, Labels(resource), FromDescription(description))
+	require.Equal(t, description, WithDescription(description, resource), "an existing provenance line is replaced")
+
+	require.Equal(t, Labels(resource), FromDescription(WithDescription("", resource)))
+	require.Nil(t, FromDescription("Checkout errors."))
+}
+
+func TestWithNameSuffix(t *testing.T) {
+	setupProvenanceTest(t, true)
+
+	name := WithNameSuffix("Checkout on-call", resource)
+	require.Equal(t, "Checkout on-call [coralogix-operator: production/checkout/errors]", name)
+	require.Equal(t, map[string]string{
+		ClusterLabel:   "production",
+		NamespaceLabel: "checkout",
+		NameLabel:      "errors",
+	}, FromNameSuffix(name))
+	require.NoError(t, Check(resource, FromNameSuffix(name)))
+	require.Equal(t, name, WithNameSuffix(name, resource), "an existing suffix is replaced")
+	require.Equal(t, "Checkout on-call", StripNameSuffix(name))
+
+	require.Equal(t, "eu/prod", FromNameSuffix("On-call [coralogix-operator: eu/prod/checkout/errors]")[ClusterLabel])
+	require.True(t, IsForeignError(Check(resource,
+		FromNameSuffix("Checkout on-call [coralogix-operator: staging/checkout/errors]"))))
+
+	for _, name := range []string{"Checkout on-call", "On-call [team]", "On-call [coralogix-operator: errors]"} {
+		require.Nil(t, FromNameSuffix(name), name)
+		require.Equal(t, name, StripNameSuffix(name))
+	}
+}
+
+func TestCheck(t *testing.T) {
+	setupProvenanceTest(t, true)
+
+	require.NoError(t, Check(resource, Labels(resource)))
+	require.NoError(t, Check(resource, map[string]string{"owner": "checkout"}), "objects without provenance are not foreign")
+
+	recreated := WithLabels(nil, resource)
+	recreated[UIDLabel] = "7c1d9e4b"
+	require.NoError(t, Check(resource, recreated), "a recreated resource keeps managing its objects")
+
+	for _, key := range []string{ClusterLabel, NamespaceLabel, NameLabel} {
+		t.Run(key, func(t *testing.T) {
+			remote := WithLabels(nil, resource)
+			remote[key] = "other"
+			err := Check(resource, remote)
+			require.True(t, IsForeignError(fmt.Errorf("wrapped: %w", err)))
+			require.Equal(t, fmt.Sprintf("remote object is managed by %s/%s/%s",
+				remote[ClusterLabel], remote[NamespaceLabel], remote[NameLabel]), err.Error())
+		})
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	setupProvenanceTest(t, false)
+
+	labels := map[string]string{"owner": "checkout"}
+	require.Equal(t, labels, WithLabels(labels, resource))
+	require.Equal(t, "Checkout errors.", WithDescription("Checkout errors.", resource))
+	require.Equal(t, "Checkout on-call", WithNameSuffix("Checkout on-call", resource))
+	require.NoError(t, Check(resource, map[string]string{ClusterLabel: "other"}))
+}
+
+func setupProvenanceTest(t *testing.T, enabled bool) {
+	cfg := config.GetConfig()
+	original := *cfg
+	t.Cleanup(func() { *cfg = original })
+
+	cfg.ProvenanceLabels = enabled
+	cfg.ClusterName = "production"
+	cfg.OperatorVersion = "2.0.0"
+}
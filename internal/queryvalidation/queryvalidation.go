@@ -0,0 +1,318 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package queryvalidation checks the syntax of the queries embedded in the custom resources before they are
+// sent to Coralogix, so that mistakes are reported with their position instead of as opaque remote errors.
+//
+// PromQL queries are parsed with the upstream Prometheus parser. Lucene and DataPrime queries only go through
+// a structural lint (balanced brackets, terminated strings, dangling operators), which catches the common
+// mistakes without rejecting syntax that Coralogix accepts.
+package queryvalidation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+func init() {
+	// Coralogix supports the experimental PromQL functions, so they must not be reported as unknown.
+	parser.EnableExperimentalFunctions = true
+}
+
+// Error is a syntax error at a position of a query.
+type Error struct {
+	// Language is the query language, e.g. PromQL.
+	Language string
+	// Line and Column are 1-based, with the column counted in characters.
+	Line   int
+	Column int
+	// Message describes the error.
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid %s query at %d:%d: %s", e.Language, e.Line, e.Column, e.Message)
+}
+
+// IsError returns whether err wraps a query syntax error.
+func IsError(err error) bool {
+	var queryErr *Error
+	return errors.As(err, &queryErr)
+}
+
+// PromQL checks that the query is a valid PromQL expression.
+func PromQL(query string) error {
+	_, err := parser.ParseExpr(query)
+	if err == nil {
+		return nil
+	}
+
+	var parseErrors parser.ParseErrors
+	if errors.As(err, &parseErrors) && len(parseErrors) > 0 {
+		return newError("PromQL", query, int(parseErrors[0].PositionRange.Start), parseErrors[0].Err.Error())
+	}
+	var parseErr *parser.ParseErr
+	if errors.As(err, &parseErr) {
+		return newError("PromQL", query, int(parseErr.PositionRange.Start), parseErr.Err.Error())
+	}
+	return newError("PromQL", query, 0, err.Error())
+}
+
+// Lucene checks the structure of a Lucene query: balanced parentheses and range brackets, terminated phrases,
+// fields with a value and boolean operators with both operands.
+func Lucene(query string) error {
+	l := &linter{language: "Lucene", query: query}
+	expectOperand := true
+	operatorPos := -1
+	for l.pos < len(query) {
+		c := query[l.pos]
+		switch {
+		case isSpace(c):
+			l.pos++
+		case c == '\\':
+			if l.pos+1 == len(query) {
+				return l.errorAt(l.pos, "escape character at the end of the query")
+			}
+			l.pos += 2
+			expectOperand = false
+		case c == '"':
+			if err := l.skipString('"'); err != nil {
+				return err
+			}
+			expectOperand = false
+		case c == '(':
+			l.open()
+			expectOperand = true
+			operatorPos = -1
+		case c == '[' || c == '{':
+			if l.inRange() {
+				return l.errorAt(l.pos, fmt.Sprintf("unexpected %q inside a range", c))
+			}
+			l.open()
+		case c == ')':
+			if expectOperand && operatorPos >= 0 {
+				return l.errorAt(operatorPos, "missing operand after operator")
+			}
+			if err := l.close(c, "("); err != nil {
+				return err
+			}
+			expectOperand = false
+		case c == ']' || c == '}':
+			if err := l.close(c, "[{"); err != nil {
+				return err
+			}
+			expectOperand = false
+		default:
+			start := l.pos
+			word := l.word()
+			switch word {
+			case "AND", "OR", "&&", "||":
+				if expectOperand {
+					return l.errorAt(start, fmt.Sprintf("missing operand before %s", word))
+				}
+				expectOperand = true
+				operatorPos = start
+			case "NOT", "!":
+				expectOperand = true
+				operatorPos = start
+			default:
+				if strings.HasSuffix(word, ":") && !strings.HasSuffix(word, `\:`) && l.valueMissing() {
+					return l.errorAt(start, fmt.Sprintf("missing value for field %s", strings.TrimSuffix(word, ":")))
+				}
+				if !l.inRange() {
+					expectOperand = false
+					operatorPos = -1
+				}
+			}
+		}
+	}
+	if expectOperand && operatorPos >= 0 {
+		return l.errorAt(operatorPos, "missing operand after operator")
+	}
+	return l.unclosed()
+}
+
+// DataPrime checks the structure of a DataPrime query: balanced brackets, terminated strings and no empty
+// pipeline stage.
+func DataPrime(query string) error {
+	l := &linter{language: "DataPrime", query: query}
+	stageEmpty := true
+	pipePos := -1
+	for l.pos < len(query) {
+		c := query[l.pos]
+		switch {
+		case isSpace(c):
+			l.pos++
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			if err := l.skipString(c); err != nil {
+				return err
+			}
+		case c == '(' || c == '[' || c == '{':
+			l.open()
+		case c == ')':
+			if err := l.close(c, "("); err != nil {
+				return err
+			}
+		case c == ']':
+			if err := l.close(c, "["); err != nil {
+				return err
+			}
+		case c == '}':
+			if err := l.close(c, "{"); err != nil {
+				return err
+			}
+		case c == '|' && len(l.stack) == 0 && !strings.HasPrefix(query[l.pos:], "||"):
+			if stageEmpty {
+				return l.errorAt(l.pos, "empty pipeline stage before |")
+			}
+			stageEmpty = true
+			pipePos = l.pos
+			l.pos++
+			continue
+		default:
+			if c == '|' && strings.HasPrefix(query[l.pos:], "||") {
+				l.pos++
+			}
+			l.pos++
+		}
+		stageEmpty = false
+	}
+	if err := l.unclosed(); err != nil {
+		return err
+	}
+	if stageEmpty && pipePos >= 0 {
+		return l.errorAt(pipePos, "empty pipeline stage after |")
+	}
+	return nil
+}
+
+type linter struct {
+	language string
+	query    string
+	pos      int
+	// stack holds the positions of the open brackets.
+	stack []int
+}
+
+func (l *linter) open() {
+	l.stack = append(l.stack, l.pos)
+	l.pos++
+}
+
+// close matches a closing bracket with the innermost open one, which must be one of openers.
+func (l *linter) close(c byte, openers string) error {
+	if len(l.stack) == 0 {
+		return l.errorAt(l.pos, fmt.Sprintf("unexpected %q without a matching opening bracket", c))
+	}
+	open := l.stack[len(l.stack)-1]
+	if !strings.ContainsRune(openers, rune(l.query[open])) {
+		return l.errorAt(l.pos, fmt.Sprintf("unexpected %q, %q opened at %s is not closed", c, l.query[open], l.position(open)))
+	}
+	l.stack = l.stack[:len(l.stack)-1]
+	l.pos++
+	return nil
+}
+
+func (l *linter) unclosed() error {
+	if len(l.stack) == 0 {
+		return nil
+	}
+	open := l.stack[len(l.stack)-1]
+	return l.errorAt(open, fmt.Sprintf("%q is never closed", l.query[open]))
+}
+
+// inRange returns whether the innermost open bracket is a Lucene range bracket.
+func (l *linter) inRange() bool {
+	return len(l.stack) > 0 && l.query[l.stack[len(l.stack)-1]] != '('
+}
+
+// skipString advances past a string delimited by quote, where backslash escapes the next character.
+func (l *linter) skipString(quote byte) error {
+	start := l.pos
+	for l.pos++; l.pos < len(l.query); l.pos++ {
+		switch l.query[l.pos] {
+		case '\\':
+			l.pos++
+		case quote:
+			l.pos++
+			return nil
+		}
+	}
+	return l.errorAt(start, "unterminated string")
+}
+
+// word advances past a Lucene term, and returns it.
+func (l *linter) word() string {
+	start := l.pos
+	for l.pos < len(l.query) {
+		c := l.query[l.pos]
+		if isSpace(c) || strings.IndexByte(`"()[]{}`, c) >= 0 {
+			break
+		}
+		if c == '\\' && l.pos+1 < len(l.query) {
+			l.pos++
+		}
+		l.pos++
+		if c == ':' {
+			// The value of a field may directly follow it, e.g. field:(a OR b) or field:"a b".
+			break
+		}
+	}
+	return l.query[start:l.pos]
+}
+
+// valueMissing returns whether a field is followed by nothing, a closing parenthesis or an operator.
+func (l *linter) valueMissing() bool {
+	rest := strings.TrimLeft(l.query[l.pos:], " \t\r\n")
+	if rest == "" || rest[0] == ')' {
+		return true
+	}
+	for _, operator := range []string{"AND", "OR", "&&", "||"} {
+		if rest == operator || strings.HasPrefix(rest, operator+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *linter) errorAt(pos int, message string) error {
+	return newError(l.language, l.query, pos, message)
+}
+
+func (l *linter) position(pos int) string {
+	line, column := lineColumn(l.query, pos)
+	return fmt.Sprintf("%d:%d", line, column)
+}
+
+func newError(language, query string, pos int, message string) *Error {
+	line, column := lineColumn(query, pos)
+	return &Error{Language: language, Line: line, Column: column, Message: message}
+}
+
+// lineColumn converts a byte offset of the query to a 1-based line and column.
+func lineColumn(query string, pos int) (int, int) {
+	pos = min(max(pos, 0), len(query))
+	line := 1 + strings.Count(query[:pos], "\n")
+	lineStart := strings.LastIndexByte(query[:pos], '\n') + 1
+	return line, 1 + utf8.RuneCountInString(query[lineStart:pos])
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryvalidation
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromQL(t *testing.T) {
+	require.NoError(t, PromQL(`sum(rate(http_requests_total{job="api", code=~"5.."}[5m])) by (service) > 0.1`))
+	require.NoError(t, PromQL(`{"k8s.namespace.name"="default"}`))
+
+	err := PromQL("sum(rate(http_requests_total[5m])\n  by (service)")
+	require.EqualError(t, err, `invalid PromQL query at 2:3: unexpected <by> in aggregation`)
+
+	var queryErr *Error
+	require.ErrorAs(t, PromQL(`rate(up[5m]`), &queryErr)
+	require.Equal(t, "PromQL", queryErr.Language)
+	require.Equal(t, 1, queryErr.Line)
+}
+
+func TestLucene(t *testing.T) {
+	valid := []string{
+		``,
+		`error`,
+		`level:error AND NOT service:checkout`,
+		`status:[500 TO 599} || (message:"connection refused" AND -retry:true)`,
+		`path:\/api\/v1 OR url:http://example.com`,
+		`kubernetes.pod_name:api-* && !level:debug`,
+	}
+	for _, query := range valid {
+		require.NoError(t, Lucene(query), query)
+	}
+
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{`level:error AND (service:api`, `invalid Lucene query at 1:17: '(' is never closed`},
+		{`level:error)`, `invalid Lucene query at 1:12: unexpected ')' without a matching opening bracket`},
+		{`message:"timeout`, `invalid Lucene query at 1:9: unterminated string`},
+		{`level:error AND`, `invalid Lucene query at 1:13: missing operand after operator`},
+		{`OR level:error`, `invalid Lucene query at 1:1: missing operand before OR`},
+		{`(a OR ) AND b`, `invalid Lucene query at 1:4: missing operand after operator`},
+		{"level:error\nAND service: AND b", `invalid Lucene query at 2:5: missing value for field service`},
+		{`status:[500 TO 599)`, `invalid Lucene query at 1:19: unexpected ')', '[' opened at 1:8 is not closed`},
+	}
+	for _, tt := range tests {
+		require.EqualError(t, Lucene(tt.query), tt.expected, tt.query)
+	}
+}
+
+func TestDataPrime(t *testing.T) {
+	valid := []string{
+		`source logs | filter $d.kubernetes.pod_id:string == '122' || $l.applicationname == "api"`,
+		`source logs | filter $d.message ~ 'a|b' | groupby $l.subsystemname aggregate count() as c`,
+		"source logs | filter `$d.weird field` != null",
+	}
+	for _, query := range valid {
+		require.NoError(t, DataPrime(query), query)
+	}
+
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{`source logs | filter $d.x == 'abc`, `invalid DataPrime query at 1:30: unterminated string`},
+		{`source logs | filter ($d.x == 1`, `invalid DataPrime query at 1:22: '(' is never closed`},
+		{`source logs | filter $d.x in [1, 2)`, `invalid DataPrime query at 1:35: unexpected ')', '[' opened at 1:30 is not closed`},
+		{`source logs | | filter true`, `invalid DataPrime query at 1:15: empty pipeline stage before |`},
+		{"source logs\n| filter true |", `invalid DataPrime query at 2:15: empty pipeline stage after |`},
+	}
+	for _, tt := range tests {
+		require.EqualError(t, DataPrime(tt.query), tt.expected, tt.query)
+	}
+}
+
+func TestIsError(t *testing.T) {
+	err := fmt.Errorf("spec.filter.whatExpression: %w", DataPrime(`source logs | filter (`))
+	require.True(t, IsError(errors.Join(errors.New("other"), err)))
+	require.False(t, IsError(errors.New("remote error")))
+}
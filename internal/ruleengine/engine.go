@@ -0,0 +1,391 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ruleengine applies the rules of a RuleGroup to sample logs locally, so that rule-groups can be
+// tested without sending them to Coralogix.
+//
+// The engine follows the documented semantics of the parsing rules: subgroups are applied in order, and within
+// a subgroup only the first rule that matches the log is applied. Fields are addressed like in Coralogix,
+// where "text" is the whole log and "text.a.b" is a nested field of a JSON log. Regular expressions use
+// the Go RE2 syntax, so constructs like look-arounds or back-references are reported as errors.
+package ruleengine
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+)
+
+const textField = "text"
+
+// Log is a log processed by the rules of a rule-group.
+type Log struct {
+	ApplicationName string
+	SubsystemName   string
+	Severity        *coralogixv1alpha1.RuleSeverity
+
+	// Body is either a string for plain text logs, or a map[string]any for JSON logs.
+	Body any
+
+	// Metadata holds the fields extracted by jsonExtract rules, keyed by destination field.
+	Metadata map[coralogixv1alpha1.DestinationField]string
+
+	// Timestamp is set by extractTimestamp rules.
+	Timestamp *time.Time
+
+	Blocked bool
+}
+
+// NewLog returns a log with the given body, parsed as JSON if it's a JSON object.
+func NewLog(body string) *Log {
+	return &Log{Body: parseBody(body), Metadata: map[coralogixv1alpha1.DestinationField]string{}}
+}
+
+func parseBody(body string) any {
+	var object map[string]any
+	if err := json.Unmarshal([]byte(body), &object); err == nil && object != nil {
+		return object
+	}
+	return body
+}
+
+// Apply applies the rules of the rule-group to the log.
+func Apply(spec *coralogixv1alpha1.RuleGroupSpec, log *Log) error {
+	if !spec.Active || !matches(spec, log) {
+		return nil
+	}
+
+	for i, subgroup := range spec.RuleSubgroups {
+		if !subgroup.Active {
+			continue
+		}
+		for _, rule := range subgroup.Rules {
+			if !rule.Active {
+				continue
+			}
+			applied, err := applyRule(rule, log)
+			if err != nil {
+				return fmt.Errorf("subgroup %d, rule %q: %w", i, rule.Name, err)
+			}
+			if log.Blocked {
+				return nil
+			}
+			if applied {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func matches(spec *coralogixv1alpha1.RuleGroupSpec, log *Log) bool {
+	if len(spec.Applications) > 0 && log.ApplicationName != "" && !slices.Contains(spec.Applications, log.ApplicationName) {
+		return false
+	}
+	if len(spec.Subsystems) > 0 && log.SubsystemName != "" && !slices.Contains(spec.Subsystems, log.SubsystemName) {
+		return false
+	}
+	if len(spec.Severities) > 0 && log.Severity != nil && !slices.Contains(spec.Severities, *log.Severity) {
+		return false
+	}
+	return true
+}
+
+// applyRule applies a single rule, and returns whether it matched the log.
+func applyRule(rule coralogixv1alpha1.Rule, log *Log) (bool, error) {
+	switch {
+	case rule.Parse != nil:
+		return applyParse(rule.Parse, log)
+	case rule.Extract != nil:
+		return applyExtract(rule.Extract, log)
+	case rule.Replace != nil:
+		return applyReplace(rule.Replace, log)
+	case rule.Block != nil:
+		return applyBlock(rule.Block, log)
+	case rule.JsonExtract != nil:
+		return applyJsonExtract(rule.JsonExtract, log), nil
+	case rule.RemoveFields != nil:
+		return applyRemoveFields(rule.RemoveFields, log), nil
+	case rule.JsonStringify != nil:
+		return applyJsonStringify(rule.JsonStringify, log)
+	case rule.ParseJsonField != nil:
+		return applyParseJsonField(rule.ParseJsonField, log)
+	case rule.ExtractTimestamp != nil:
+		return applyExtractTimestamp(rule.ExtractTimestamp, log)
+	}
+	return false, fmt.Errorf("no rule type is set")
+}
+
+func applyParse(parse *coralogixv1alpha1.Parse, log *Log) (bool, error) {
+	groups, ok, err := namedGroups(parse.Regex, log, parse.SourceField)
+	if err != nil || !ok {
+		return false, err
+	}
+	return true, log.set(parse.DestinationField, groups)
+}
+
+// applyExtract adds the named groups as fields of the log. A plain text log is turned into a JSON log
+// holding the original text in its "text" field.
+func applyExtract(extract *coralogixv1alpha1.Extract, log *Log) (bool, error) {
+	groups, ok, err := namedGroups(extract.Regex, log, extract.SourceField)
+	if err != nil || !ok {
+		return false, err
+	}
+	if text, isText := log.Body.(string); isText {
+		log.Body = map[string]any{textField: text}
+	}
+	maps.Copy(log.Body.(map[string]any), groups)
+	return true, nil
+}
+
+func applyReplace(replace *coralogixv1alpha1.Replace, log *Log) (bool, error) {
+	re, err := compile(replace.Regex)
+	if err != nil {
+		return false, err
+	}
+	value, ok := log.stringValue(replace.SourceField)
+	if !ok || !re.MatchString(value) {
+		return false, nil
+	}
+
+	replaced := re.ReplaceAllString(value, replace.ReplacementString)
+	if replace.DestinationField == textField {
+		log.Body = parseBody(replaced)
+		return true, nil
+	}
+	return true, log.set(replace.DestinationField, replaced)
+}
+
+// applyBlock blocks the log if it matches the regex, or if it doesn't when blockingAllMatchingBlocks is false.
+func applyBlock(block *coralogixv1alpha1.Block, log *Log) (bool, error) {
+	re, err := compile(block.Regex)
+	if err != nil {
+		return false, err
+	}
+	value, _ := log.stringValue(block.SourceField)
+	if re.MatchString(value) == block.BlockingAllMatchingBlocks {
+		log.Blocked = true
+		return true, nil
+	}
+	return false, nil
+}
+
+func applyJsonExtract(jsonExtract *coralogixv1alpha1.JsonExtract, log *Log) bool {
+	value, ok := log.stringValue(textField + "." + jsonExtract.JsonKey)
+	if !ok {
+		return false
+	}
+	log.Metadata[jsonExtract.DestinationField] = value
+	return true
+}
+
+// applyRemoveFields removes the excluded fields of the log. Fields outside of "text", like the Coralogix
+// metadata, are not part of the log and are ignored.
+func applyRemoveFields(removeFields *coralogixv1alpha1.RemoveFields, log *Log) bool {
+	removed := false
+	for _, field := range removeFields.ExcludedFields {
+		if log.remove(field) {
+			removed = true
+		}
+	}
+	return removed
+}
+
+func applyJsonStringify(jsonStringify *coralogixv1alpha1.JsonStringify, log *Log) (bool, error) {
+	value, ok := log.get(jsonStringify.SourceField)
+	if !ok {
+		return false, nil
+	}
+	stringified, err := json.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+	if !jsonStringify.KeepSourceField && jsonStringify.SourceField != jsonStringify.DestinationField {
+		log.remove(jsonStringify.SourceField)
+	}
+	return true, log.set(jsonStringify.DestinationField, string(stringified))
+}
+
+// applyParseJsonField parses a field holding a JSON string. With keepDestinationField, the parsed fields are
+// merged into an existing JSON destination field instead of overriding it.
+func applyParseJsonField(parseJsonField *coralogixv1alpha1.ParseJsonField, log *Log) (bool, error) {
+	value, ok := log.get(parseJsonField.SourceField)
+	if !ok {
+		return false, nil
+	}
+	text, ok := value.(string)
+	if !ok {
+		return false, nil
+	}
+	var parsed any
+	if err := json.Unmarshal([]byte(text), &parsed); err != nil {
+		return false, nil
+	}
+
+	if !parseJsonField.KeepSourceField && parseJsonField.SourceField != parseJsonField.DestinationField {
+		log.remove(parseJsonField.SourceField)
+	}
+	if parseJsonField.KeepDestinationField {
+		existing, existingOK := log.get(parseJsonField.DestinationField)
+		existingObject, isObject := existing.(map[string]any)
+		parsedObject, parsedIsObject := parsed.(map[string]any)
+		if existingOK && isObject && parsedIsObject {
+			maps.Copy(existingObject, parsedObject)
+			return true, nil
+		}
+	}
+	return true, log.set(parseJsonField.DestinationField, parsed)
+}
+
+func applyExtractTimestamp(extractTimestamp *coralogixv1alpha1.ExtractTimestamp, log *Log) (bool, error) {
+	value, ok := log.stringValue(extractTimestamp.SourceField)
+	if !ok {
+		return false, nil
+	}
+	timestamp, err := parseTimestamp(extractTimestamp.FieldFormatStandard, extractTimestamp.TimeFormat, value)
+	if err != nil {
+		return false, err
+	}
+	log.Timestamp = &timestamp
+	return true, nil
+}
+
+func compile(expr string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid or unsupported regex %q: %w", expr, err)
+	}
+	return re, nil
+}
+
+// namedGroups returns the named groups of the first match of the regex in the field, and whether it matched.
+func namedGroups(expr string, log *Log, field string) (map[string]any, bool, error) {
+	re, err := compile(expr)
+	if err != nil {
+		return nil, false, err
+	}
+	value, ok := log.stringValue(field)
+	if !ok {
+		return nil, false, nil
+	}
+	match := re.FindStringSubmatchIndex(value)
+	if match == nil {
+		return nil, false, nil
+	}
+
+	groups := map[string]any{}
+	for i, name := range re.SubexpNames() {
+		if name == "" || match[2*i] < 0 {
+			continue
+		}
+		groups[name] = value[match[2*i]:match[2*i+1]]
+	}
+	return groups, true, nil
+}
+
+// get returns the value of a field of the log, where "text" is the whole log.
+func (l *Log) get(field string) (any, bool) {
+	if field == textField {
+		return l.Body, true
+	}
+	path, ok := strings.CutPrefix(field, textField+".")
+	if !ok {
+		return nil, false
+	}
+
+	var current any = l.Body
+	for _, key := range strings.Split(path, ".") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = object[key]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// stringValue returns the value of a field as a string, with JSON values serialized.
+func (l *Log) stringValue(field string) (string, bool) {
+	value, ok := l.get(field)
+	if !ok {
+		return "", false
+	}
+	if text, ok := value.(string); ok {
+		return text, true
+	}
+	serialized, err := json.Marshal(value)
+	if err != nil {
+		return "", false
+	}
+	return string(serialized), true
+}
+
+// set sets a field of the log, creating the intermediate JSON objects as needed.
+func (l *Log) set(field string, value any) error {
+	if field == textField {
+		l.Body = value
+		return nil
+	}
+	path, ok := strings.CutPrefix(field, textField+".")
+	if !ok {
+		return fmt.Errorf("unsupported field %q, expected %q or a %q nested field", field, textField, textField+".")
+	}
+
+	root, ok := l.Body.(map[string]any)
+	if !ok {
+		return fmt.Errorf("cannot set field %q of a plain text log", field)
+	}
+	keys := strings.Split(path, ".")
+	object := root
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := object[key].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			object[key] = next
+		}
+		object = next
+	}
+	object[keys[len(keys)-1]] = value
+	return nil
+}
+
+// remove removes a nested field of the log, and returns whether it existed.
+func (l *Log) remove(field string) bool {
+	path, ok := strings.CutPrefix(field, textField+".")
+	if !ok {
+		return false
+	}
+	keys := strings.Split(path, ".")
+	parent, ok := l.get(strings.Join(append([]string{textField}, keys[:len(keys)-1]...), "."))
+	if !ok {
+		return false
+	}
+	object, ok := parent.(map[string]any)
+	if !ok {
+		return false
+	}
+	if _, ok := object[keys[len(keys)-1]]; !ok {
+		return false
+	}
+	delete(object, keys[len(keys)-1])
+	return true
+}
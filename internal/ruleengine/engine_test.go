@@ -0,0 +1,204 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ruleengine
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+)
+
+func TestApplyRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     coralogixv1alpha1.Rule
+		input    string
+		expected any
+		blocked  bool
+	}{
+		{
+			name:     "parse",
+			rule:     coralogixv1alpha1.Rule{Parse: &coralogixv1alpha1.Parse{SourceField: "text", DestinationField: "text", Regex: `(?P<method>[A-Z]+) (?P<path>\S+) (?P<status>\d+)`}},
+			input:    "GET /health 200",
+			expected: map[string]any{"method": "GET", "path": "/health", "status": "200"},
+		},
+		{
+			name:     "parse without match",
+			rule:     coralogixv1alpha1.Rule{Parse: &coralogixv1alpha1.Parse{SourceField: "text", DestinationField: "text", Regex: `(?P<status>\d{3})`}},
+			input:    "no status",
+			expected: "no status",
+		},
+		{
+			name:     "extract from plain text",
+			rule:     coralogixv1alpha1.Rule{Extract: &coralogixv1alpha1.Extract{SourceField: "text", Regex: `took (?P<duration>\d+)ms`}},
+			input:    "request took 42ms",
+			expected: map[string]any{"text": "request took 42ms", "duration": "42"},
+		},
+		{
+			name:     "replace into JSON",
+			rule:     coralogixv1alpha1.Rule{Replace: &coralogixv1alpha1.Replace{SourceField: "text", DestinationField: "text", Regex: `^.*?{`, ReplacementString: "{"}},
+			input:    `2024-01-01 INFO {"level":"info"}`,
+			expected: map[string]any{"level": "info"},
+		},
+		{
+			name:     "replace nested field",
+			rule:     coralogixv1alpha1.Rule{Replace: &coralogixv1alpha1.Replace{SourceField: "text.user.email", DestinationField: "text.user.email", Regex: `^[^@]+`, ReplacementString: "***"}},
+			input:    `{"user":{"email":"jane@example.com"}}`,
+			expected: map[string]any{"user": map[string]any{"email": "***@example.com"}},
+		},
+		{
+			name:    "block matching",
+			rule:    coralogixv1alpha1.Rule{Block: &coralogixv1alpha1.Block{SourceField: "text", Regex: `healthcheck`, BlockingAllMatchingBlocks: true}},
+			input:   "GET /healthcheck",
+			blocked: true,
+		},
+		{
+			name:     "allow matching",
+			rule:     coralogixv1alpha1.Rule{Block: &coralogixv1alpha1.Block{SourceField: "text", Regex: `ERROR`}},
+			input:    "ERROR something failed",
+			expected: "ERROR something failed",
+		},
+		{
+			name:     "remove fields",
+			rule:     coralogixv1alpha1.Rule{RemoveFields: &coralogixv1alpha1.RemoveFields{ExcludedFields: []string{"text.password", "coralogix.metadata.className"}}},
+			input:    `{"user":"jane","password":"secret"}`,
+			expected: map[string]any{"user": "jane"},
+		},
+		{
+			name:     "json stringify",
+			rule:     coralogixv1alpha1.Rule{JsonStringify: &coralogixv1alpha1.JsonStringify{SourceField: "text.request", DestinationField: "text.request_raw"}},
+			input:    `{"request":{"id":1}}`,
+			expected: map[string]any{"request_raw": `{"id":1}`},
+		},
+		{
+			name:     "parse json field",
+			rule:     coralogixv1alpha1.Rule{ParseJsonField: &coralogixv1alpha1.ParseJsonField{SourceField: "text.payload", DestinationField: "text.data", KeepSourceField: false, KeepDestinationField: true}},
+			input:    `{"payload":"{\"b\":2}","data":{"a":1}}`,
+			expected: map[string]any{"data": map[string]any{"a": float64(1), "b": float64(2)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := NewLog(tt.input)
+			require.NoError(t, Apply(ruleGroupSpec([]coralogixv1alpha1.Rule{tt.rule}), log))
+			require.Equal(t, tt.blocked, log.Blocked)
+			if !tt.blocked {
+				require.Equal(t, tt.expected, log.Body)
+			}
+		})
+	}
+}
+
+func TestApplyRulesInSubgroupStopsAtFirstMatch(t *testing.T) {
+	spec := ruleGroupSpec(
+		[]coralogixv1alpha1.Rule{
+			{Name: "no match", Extract: &coralogixv1alpha1.Extract{SourceField: "text", Regex: `user=(?P<user>\w+)`}},
+			{Name: "match", Extract: &coralogixv1alpha1.Extract{SourceField: "text", Regex: `status=(?P<status>\d+)`}},
+			{Name: "skipped", Extract: &coralogixv1alpha1.Extract{SourceField: "text", Regex: `(?P<anything>.+)`}},
+		},
+		[]coralogixv1alpha1.Rule{
+			{Name: "next subgroup", JsonExtract: &coralogixv1alpha1.JsonExtract{JsonKey: "status", DestinationField: coralogixv1alpha1.DestinationFieldCategory}},
+		},
+	)
+
+	log := NewLog("status=500")
+	require.NoError(t, Apply(spec, log))
+	require.Equal(t, map[string]any{"text": "status=500", "status": "500"}, log.Body)
+	require.Equal(t, "500", log.Metadata[coralogixv1alpha1.DestinationFieldCategory])
+}
+
+func TestApplySkipsNonMatchingLogsAndInactiveRules(t *testing.T) {
+	rule := coralogixv1alpha1.Rule{Block: &coralogixv1alpha1.Block{SourceField: "text", Regex: ".*", BlockingAllMatchingBlocks: true}}
+	spec := ruleGroupSpec([]coralogixv1alpha1.Rule{rule})
+	spec.Applications = []string{"payments"}
+
+	log := NewLog("anything")
+	log.ApplicationName = "checkout"
+	require.NoError(t, Apply(spec, log))
+	require.False(t, log.Blocked)
+
+	spec.RuleSubgroups[0].Rules[0].Active = false
+	log = NewLog("anything")
+	require.NoError(t, Apply(spec, log))
+	require.False(t, log.Blocked)
+}
+
+func TestApplyReportsUnsupportedRegex(t *testing.T) {
+	spec := ruleGroupSpec([]coralogixv1alpha1.Rule{
+		{Name: "lookahead", Block: &coralogixv1alpha1.Block{SourceField: "text", Regex: `foo(?=bar)`}},
+	})
+	require.ErrorContains(t, Apply(spec, NewLog("foobar")), `subgroup 0, rule "lookahead": invalid or unsupported regex`)
+}
+
+func TestParseTimestamp(t *testing.T) {
+	expected := time.Date(2024, 3, 5, 14, 7, 9, 123000000, time.UTC)
+	tests := []struct {
+		standard coralogixv1alpha1.FieldFormatStandard
+		format   string
+		value    string
+	}{
+		{coralogixv1alpha1.FieldFormatStandardStrftime, "%Y-%m-%dT%H:%M:%S.%f%z", "2024-03-05T14:07:09.123000+0000"},
+		{coralogixv1alpha1.FieldFormatStandardJavaSDF, "yyyy-MM-dd'T'HH:mm:ss.SSSXXX", "2024-03-05T14:07:09.123Z"},
+		{coralogixv1alpha1.FieldFormatStandardGolang, time.RFC3339Nano, "2024-03-05T14:07:09.123Z"},
+		{coralogixv1alpha1.FieldFormatStandardMilliTS, "", "1709647629123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.standard), func(t *testing.T) {
+			timestamp, err := parseTimestamp(tt.standard, tt.format, tt.value)
+			require.NoError(t, err)
+			require.True(t, expected.Equal(timestamp), "got %s", timestamp)
+		})
+	}
+}
+
+func TestRunTests(t *testing.T) {
+	spec := ruleGroupSpec([]coralogixv1alpha1.Rule{
+		{Name: "parse", Parse: &coralogixv1alpha1.Parse{SourceField: "text", DestinationField: "text", Regex: `level=(?P<level>\w+) msg=(?P<msg>.+)`}},
+	})
+	spec.Tests = []coralogixv1alpha1.RuleGroupTest{
+		{Name: "parses", Input: "level=info msg=started", ExpectedOutput: ptr.To(`{"msg": "started", "level": "info"}`)},
+		{Name: "wrong output", Input: "level=info msg=started", ExpectedOutput: ptr.To(`{"level":"warn"}`)},
+		{Name: "not blocked", Input: "level=info msg=started", ExpectBlocked: true},
+	}
+
+	results := RunTests(spec)
+	require.Equal(t, []coralogixv1alpha1.RuleGroupTestResult{
+		{Name: "parses", Passed: true},
+		{Name: "wrong output", Message: `output is {"level":"info","msg":"started"}, expected {"level":"warn"}`},
+		{Name: "not blocked", Message: "the log was not blocked"},
+	}, results)
+	require.EqualError(t, FailedTestsError(results),
+		`2 of 3 rule-group tests failed: "wrong output": output is {"level":"info","msg":"started"}, expected {"level":"warn"}; "not blocked": the log was not blocked`)
+	require.True(t, IsTestsFailedError(fmt.Errorf("wrapped: %w", FailedTestsError(results))))
+	require.NoError(t, FailedTestsError(results[:1]))
+}
+
+func ruleGroupSpec(subgroups ...[]coralogixv1alpha1.Rule) *coralogixv1alpha1.RuleGroupSpec {
+	spec := &coralogixv1alpha1.RuleGroupSpec{Name: "test", Active: true}
+	for _, rules := range subgroups {
+		for i := range rules {
+			rules[i].Active = true
+		}
+		spec.RuleSubgroups = append(spec.RuleSubgroups, coralogixv1alpha1.RuleSubGroup{Active: true, Rules: rules})
+	}
+	return spec
+}
@@ -0,0 +1,171 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ruleengine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+)
+
+// RunTests runs the tests of the rule-group, in order.
+func RunTests(spec *coralogixv1alpha1.RuleGroupSpec) []coralogixv1alpha1.RuleGroupTestResult {
+	results := make([]coralogixv1alpha1.RuleGroupTestResult, 0, len(spec.Tests))
+	for _, test := range spec.Tests {
+		result := coralogixv1alpha1.RuleGroupTestResult{Name: test.Name, Passed: true}
+		if err := runTest(spec, test); err != nil {
+			result.Passed = false
+			result.Message = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// TestsFailedError is returned when tests of a rule-group failed.
+type TestsFailedError struct {
+	// Failed describes the failed tests, as `"<name>": <message>`.
+	Failed []string
+	// Total is the number of tests.
+	Total int
+}
+
+func (e *TestsFailedError) Error() string {
+	return fmt.Sprintf("%d of %d rule-group tests failed: %s", len(e.Failed), e.Total, strings.Join(e.Failed, "; "))
+}
+
+// IsTestsFailedError returns whether err wraps a TestsFailedError.
+func IsTestsFailedError(err error) bool {
+	var testsErr *TestsFailedError
+	return errors.As(err, &testsErr)
+}
+
+// FailedTestsError returns a TestsFailedError describing the failed tests, or nil if all of them passed.
+func FailedTestsError(results []coralogixv1alpha1.RuleGroupTestResult) error {
+	var failed []string
+	for _, result := range results {
+		if !result.Passed {
+			failed = append(failed, fmt.Sprintf("%q: %s", result.Name, result.Message))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return &TestsFailedError{Failed: failed, Total: len(results)}
+}
+
+func runTest(spec *coralogixv1alpha1.RuleGroupSpec, test coralogixv1alpha1.RuleGroupTest) error {
+	log := NewLog(test.Input)
+	log.ApplicationName = test.ApplicationName
+	log.SubsystemName = test.SubsystemName
+	log.Severity = test.Severity
+	if err := Apply(spec, log); err != nil {
+		return err
+	}
+
+	if log.Blocked != test.ExpectBlocked {
+		if log.Blocked {
+			return errors.New("the log was blocked")
+		}
+		return errors.New("the log was not blocked")
+	}
+
+	var errs []error
+	if test.ExpectedOutput != nil {
+		if err := compareOutput(*test.ExpectedOutput, log.Body); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, field := range slices.Sorted(maps.Keys(test.ExpectedMetadata)) {
+		expected := test.ExpectedMetadata[field]
+		if actual, ok := log.Metadata[coralogixv1alpha1.DestinationField(field)]; !ok {
+			errs = append(errs, fmt.Errorf("metadata field %s was not extracted, expected %q", field, expected))
+		} else if actual != expected {
+			errs = append(errs, fmt.Errorf("metadata field %s is %q, expected %q", field, actual, expected))
+		}
+	}
+	if test.ExpectedTimestamp != nil {
+		if err := compareTimestamp(*test.ExpectedTimestamp, log.Timestamp); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// compareOutput compares the log body with the expected output, as JSON if both are JSON objects.
+func compareOutput(expected string, body any) error {
+	actual, err := bodyString(body)
+	if err != nil {
+		return err
+	}
+
+	expectedBody := parseBody(expected)
+	if _, ok := expectedBody.(map[string]any); ok {
+		if _, ok := body.(map[string]any); ok {
+			normalized, err := normalizeJSON(body)
+			if err != nil {
+				return err
+			}
+			if reflect.DeepEqual(expectedBody, normalized) {
+				return nil
+			}
+			return fmt.Errorf("output is %s, expected %s", actual, expected)
+		}
+	}
+	if actual != expected {
+		return fmt.Errorf("output is %q, expected %q", actual, expected)
+	}
+	return nil
+}
+
+func compareTimestamp(expected string, actual *time.Time) error {
+	expectedTimestamp, err := time.Parse(time.RFC3339Nano, expected)
+	if err != nil {
+		return fmt.Errorf("invalid expected timestamp %q: %w", expected, err)
+	}
+	if actual == nil {
+		return fmt.Errorf("no timestamp was extracted, expected %s", expected)
+	}
+	if !actual.Equal(expectedTimestamp) {
+		return fmt.Errorf("timestamp is %s, expected %s", actual.Format(time.RFC3339Nano), expected)
+	}
+	return nil
+}
+
+func bodyString(body any) (string, error) {
+	if text, ok := body.(string); ok {
+		return text, nil
+	}
+	serialized, err := json.Marshal(body)
+	return string(serialized), err
+}
+
+// normalizeJSON round-trips a value through JSON, so that it can be compared with a parsed JSON value.
+func normalizeJSON(value any) (any, error) {
+	serialized, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var normalized any
+	err = json.Unmarshal(serialized, &normalized)
+	return normalized, err
+}
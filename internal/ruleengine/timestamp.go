@@ -0,0 +1,136 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ruleengine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+)
+
+var (
+	strftimeToLayout = map[byte]string{
+		'Y': "2006", 'y': "06", 'm': "01", 'd': "02", 'e': "_2", 'H': "15", 'I': "03", 'M': "04", 'S': "05",
+		'f': "000000", 'z': "-0700", 'Z': "MST", 'b': "Jan", 'h': "Jan", 'B': "January", 'a': "Mon", 'A': "Monday",
+		'p': "PM", 'j': "002", 'F': "2006-01-02", 'T': "15:04:05", '%': "%",
+	}
+
+	// javaSDFToLayout is ordered so that longer patterns take precedence.
+	javaSDFToLayout = []struct{ pattern, layout string }{
+		{"yyyy", "2006"}, {"yy", "06"}, {"MMMM", "January"}, {"MMM", "Jan"}, {"MM", "01"}, {"M", "1"},
+		{"dd", "02"}, {"d", "2"}, {"HH", "15"}, {"hh", "03"}, {"h", "3"}, {"mm", "04"}, {"m", "4"},
+		{"ss", "05"}, {"s", "5"}, {"SSSSSSSSS", "000000000"}, {"SSSSSS", "000000"}, {"SSS", "000"},
+		{"EEEE", "Monday"}, {"EEE", "Mon"}, {"a", "PM"}, {"XXX", "Z07:00"}, {"XX", "Z0700"}, {"X", "Z07"},
+		{"Z", "-0700"}, {"z", "MST"},
+	}
+)
+
+func parseTimestamp(standard coralogixv1alpha1.FieldFormatStandard, format, value string) (time.Time, error) {
+	switch standard {
+	case coralogixv1alpha1.FieldFormatStandardSecondTS:
+		return parseEpoch(value, time.Second)
+	case coralogixv1alpha1.FieldFormatStandardMilliTS:
+		return parseEpoch(value, time.Millisecond)
+	case coralogixv1alpha1.FieldFormatStandardMicroTS:
+		return parseEpoch(value, time.Microsecond)
+	case coralogixv1alpha1.FieldFormatStandardNanoTS:
+		return parseEpoch(value, time.Nanosecond)
+	}
+
+	var layout string
+	var err error
+	switch standard {
+	case coralogixv1alpha1.FieldFormatStandardGolang:
+		layout = format
+	case coralogixv1alpha1.FieldFormatStandardStrftime:
+		layout, err = strftimeLayout(format)
+	case coralogixv1alpha1.FieldFormatStandardJavaSDF:
+		layout = javaSDFLayout(format)
+	default:
+		err = fmt.Errorf("unsupported format standard %q", standard)
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	timestamp, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse %q with %s format %q: %w", value, standard, format, err)
+	}
+	return timestamp, nil
+}
+
+func parseEpoch(value string, unit time.Duration) (time.Time, error) {
+	epoch, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse %q as an epoch timestamp: %w", value, err)
+	}
+	return time.Unix(0, 0).Add(time.Duration(epoch) * unit).UTC(), nil
+}
+
+func strftimeLayout(format string) (string, error) {
+	var layout strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			layout.WriteByte(format[i])
+			continue
+		}
+		if i+1 == len(format) {
+			return "", fmt.Errorf("strftime format %q ends with %%", format)
+		}
+		i++
+		directive, ok := strftimeToLayout[format[i]]
+		if !ok {
+			return "", fmt.Errorf("unsupported strftime directive %%%c", format[i])
+		}
+		layout.WriteString(directive)
+	}
+	return layout.String(), nil
+}
+
+// javaSDFLayout converts a SimpleDateFormat pattern, where text between single quotes is literal.
+func javaSDFLayout(format string) string {
+	var layout strings.Builder
+	for i := 0; i < len(format); {
+		if format[i] == '\'' {
+			end := strings.IndexByte(format[i+1:], '\'')
+			if end < 0 {
+				layout.WriteString(format[i+1:])
+				break
+			}
+			layout.WriteString(format[i+1 : i+1+end])
+			i += end + 2
+			continue
+		}
+
+		matched := false
+		for _, token := range javaSDFToLayout {
+			if strings.HasPrefix(format[i:], token.pattern) {
+				layout.WriteString(token.layout)
+				i += len(token.pattern)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			layout.WriteByte(format[i])
+			i++
+		}
+	}
+	return layout.String()
+}
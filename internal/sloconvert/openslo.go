@@ -0,0 +1,371 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sloconvert
+
+import (
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+)
+
+// OpenSLOAPIVersion is the supported version of the OpenSLO specification.
+const OpenSLOAPIVersion = "openslo/v1"
+
+func isOpenSLO(document map[string]any) bool {
+	apiVersion, _ := document["apiVersion"].(string)
+	return strings.HasPrefix(apiVersion, "openslo/")
+}
+
+var openSLOTimeFrames = map[string]coralogixv1alpha1.SloTimeFrame{
+	"7d":  coralogixv1alpha1.SloTimeFrame7d,
+	"1w":  coralogixv1alpha1.SloTimeFrame7d,
+	"14d": coralogixv1alpha1.SloTimeFrame14d,
+	"2w":  coralogixv1alpha1.SloTimeFrame14d,
+	"21d": coralogixv1alpha1.SloTimeFrame21d,
+	"3w":  coralogixv1alpha1.SloTimeFrame21d,
+	"28d": coralogixv1alpha1.SloTimeFrame28d,
+	"4w":  coralogixv1alpha1.SloTimeFrame28d,
+}
+
+var openSLOOperators = map[string]coralogixv1alpha1.ComparisonOperator{
+	"lt":  "lessThan",
+	"lte": "lessThanOrEquals",
+	"gt":  "greaterThan",
+	"gte": "greaterThanOrEquals",
+}
+
+var openSLOTimeSliceWindows = map[string]coralogixv1alpha1.SloWindowEnum{
+	"1m":  "1m",
+	"60s": "1m",
+	"5m":  "5m",
+}
+
+func convertOpenSLODocument(result *Result, document map[string]any, indicators map[string]map[string]any, opts Options) {
+	name, _ := nestedString(document, "metadata", "name")
+	path := fmt.Sprintf("%s %s", document["kind"], name)
+	if document["apiVersion"] != OpenSLOAPIVersion {
+		result.unsupported(path, "apiVersion %s is not supported, only %s is", document["apiVersion"], OpenSLOAPIVersion)
+		return
+	}
+
+	switch document["kind"] {
+	case "SLO":
+		convertOpenSLO(result, document, indicators, opts)
+	case "SLI":
+		// Converted with the SLOs referring to them.
+	default:
+		result.unsupported(path, "kind %s is not supported", document["kind"])
+	}
+}
+
+// convertOpenSLO converts an OpenSLO SLO into one SLO per objective.
+func convertOpenSLO(result *Result, document map[string]any, indicators map[string]map[string]any, opts Options) {
+	name, _ := nestedString(document, "metadata", "name")
+	root := newObject(result, "SLO "+name, document)
+	root.ignore("apiVersion", "kind")
+	metadata := root.object("metadata")
+	metadata.ignore("name")
+	displayName := metadata.string("displayName")
+	labels := metadata.stringMap("labels")
+	metadata.done()
+	spec := root.object("spec")
+	root.done()
+
+	description := spec.string("description")
+	if service := spec.string("service"); service != "" {
+		labels = withLabel(labels, "service", service)
+	}
+
+	timeFrame, ok := openSLOTimeFrame(spec)
+	if !ok {
+		return
+	}
+
+	var indicator *object
+	switch {
+	case spec.has("indicator"):
+		indicator = spec.object("indicator")
+	case spec.has("indicatorRef"):
+		ref := spec.string("indicatorRef")
+		document, ok := indicators[ref]
+		if !ok {
+			result.unsupported(spec.fieldPath("indicatorRef"), "SLI %s is not found", ref)
+			return
+		}
+		indicator = newObject(result, "SLI "+ref, document)
+		indicator.ignore("apiVersion", "kind")
+	default:
+		result.unsupported(spec.path, "indicator or indicatorRef is required")
+		return
+	}
+	indicator.object("metadata").ignore("name", "displayName", "labels", "annotations")
+	indicatorSpec := indicator.object("spec")
+	indicatorSpec.ignore("description")
+	indicator.done()
+
+	budgetingMethod := spec.string("budgetingMethod")
+	var sli func(objective *object) (coralogixv1alpha1.SliType, bool)
+	switch {
+	case budgetingMethod == "Occurrences" && indicatorSpec.has("ratioMetric"):
+		sliType, ok := openSLORatioMetric(indicatorSpec.object("ratioMetric"))
+		if !ok {
+			return
+		}
+		sli = func(*object) (coralogixv1alpha1.SliType, bool) { return sliType, true }
+	case budgetingMethod == "Timeslices" && indicatorSpec.has("thresholdMetric"):
+		query, ok := openSLOQuery(indicatorSpec.object("thresholdMetric"))
+		if !ok {
+			return
+		}
+		sli = func(objective *object) (coralogixv1alpha1.SliType, bool) {
+			return openSLOThresholdObjective(objective, query)
+		}
+	default:
+		var metric string
+		for _, key := range []string{"ratioMetric", "thresholdMetric"} {
+			if indicatorSpec.has(key) {
+				metric = key
+			}
+		}
+		result.unsupported(spec.fieldPath("budgetingMethod"),
+			"%s with %s is not supported, only Occurrences with ratioMetric and Timeslices with thresholdMetric are", budgetingMethod, metric)
+		return
+	}
+	indicatorSpec.done()
+
+	if spec.has("alertPolicies") {
+		spec.value("alertPolicies")
+		result.unsupported(spec.fieldPath("alertPolicies"), "not supported, use spec.alerting of the generated SLO")
+	}
+
+	objectives := spec.list("objectives")
+	spec.done()
+	if len(objectives) == 0 {
+		result.unsupported(spec.fieldPath("objectives"), "at least one objective is required")
+		return
+	}
+
+	for i, objective := range objectives {
+		sliType, ok := sli(objective)
+		if !ok {
+			continue
+		}
+
+		target, ok := openSLOTarget(objective)
+		if !ok {
+			continue
+		}
+
+		sloName, specName := name, displayName
+		if specName == "" {
+			specName = name
+		}
+		objectiveName := objective.string("displayName")
+		if len(objectives) > 1 {
+			suffix := objectiveName
+			if suffix == "" {
+				suffix = strconv.Itoa(i)
+			}
+			sloName = resourceName(name, suffix)
+			specName = fmt.Sprintf("%s (%s)", specName, suffix)
+		}
+		objective.ignore("compositeWeight")
+		if objective.has("indicator") || objective.has("indicatorRef") {
+			objective.ignore("indicator", "indicatorRef")
+			result.unsupported(objective.path, "objective indicators of composite SLOs are not supported, the SLO indicator is used")
+		}
+		objective.done()
+
+		slo := newSLO(sloName, opts)
+		slo.Spec = coralogixv1alpha1.SLOSpec{
+			Name:                      specName,
+			SliType:                   sliType,
+			Window:                    coralogixv1alpha1.SloWindow{TimeFrame: ptr.To(timeFrame)},
+			TargetThresholdPercentage: target,
+		}
+		if description != "" {
+			slo.Spec.Description = ptr.To(description)
+		}
+		if len(labels) > 0 {
+			slo.Spec.Labels = ptr.To(maps.Clone(labels))
+		}
+		result.SLOs = append(result.SLOs, slo)
+	}
+}
+
+func openSLOTimeFrame(spec *object) (coralogixv1alpha1.SloTimeFrame, bool) {
+	timeWindows := spec.list("timeWindow")
+	if len(timeWindows) == 0 {
+		spec.result.unsupported(spec.fieldPath("timeWindow"), "a rolling time window is required")
+		return "", false
+	}
+	if len(timeWindows) > 1 {
+		spec.result.unsupported(spec.fieldPath("timeWindow"), "only the first time window is used")
+	}
+
+	timeWindow := timeWindows[0]
+	duration := timeWindow.string("duration")
+	rolling := timeWindow.bool("isRolling")
+	timeWindow.ignore("calendar")
+	timeWindow.done()
+	if !rolling {
+		spec.result.unsupported(timeWindow.path, "calendar time windows are not supported, only rolling ones are")
+		return "", false
+	}
+	timeFrame, ok := openSLOTimeFrames[duration]
+	if !ok {
+		spec.result.unsupported(timeWindow.fieldPath("duration"), "%s is not supported, only 7d, 14d, 21d and 28d are", duration)
+		return "", false
+	}
+	return timeFrame, true
+}
+
+// openSLORatioMetric converts a ratio metric into a request-based SLI. Bad events are subtracted from the total
+// ones, since the SLO counts good events.
+func openSLORatioMetric(ratioMetric *object) (coralogixv1alpha1.SliType, bool) {
+	ratioMetric.ignore("counter")
+	if ratioMetric.has("raw") {
+		ratioMetric.ignore("raw", "rawType")
+		ratioMetric.result.unsupported(ratioMetric.fieldPath("raw"), "raw ratio metrics are not supported, use good or bad and total metrics")
+		return coralogixv1alpha1.SliType{}, false
+	}
+	if !ratioMetric.has("total") || !ratioMetric.has("good") && !ratioMetric.has("bad") {
+		ratioMetric.result.unsupported(ratioMetric.path, "good or bad, and total metrics are required")
+		return coralogixv1alpha1.SliType{}, false
+	}
+
+	total, ok := openSLOQuery(ratioMetric.object("total"))
+	if !ok {
+		return coralogixv1alpha1.SliType{}, false
+	}
+	var good string
+	if ratioMetric.has("good") {
+		good, ok = openSLOQuery(ratioMetric.object("good"))
+	} else {
+		var bad string
+		bad, ok = openSLOQuery(ratioMetric.object("bad"))
+		good = fmt.Sprintf("(%s) - (%s)", total, bad)
+	}
+	if !ok {
+		return coralogixv1alpha1.SliType{}, false
+	}
+	ratioMetric.done()
+
+	return coralogixv1alpha1.SliType{
+		RequestBasedMetricSli: &coralogixv1alpha1.RequestBasedMetricSli{
+			GoodEvents:  coralogixv1alpha1.SloMetricEvent{Query: good},
+			TotalEvents: coralogixv1alpha1.SloMetricEvent{Query: total},
+		},
+	}, true
+}
+
+// openSLOQuery returns the query of a Prometheus metric source.
+func openSLOQuery(metric *object) (string, bool) {
+	metricSource := metric.object("metricSource")
+	metric.done()
+	if metricSource.has("metricSourceRef") {
+		metricSource.ignore("metricSourceRef")
+	}
+	sourceType := metricSource.string("type")
+	sourceSpec := metricSource.object("spec")
+	metricSource.done()
+	if !strings.EqualFold(sourceType, "Prometheus") {
+		metricSource.result.unsupported(metricSource.fieldPath("type"), "%s metric sources are not supported, only Prometheus ones are", sourceType)
+		return "", false
+	}
+
+	query := sourceSpec.string("query")
+	sourceSpec.done()
+	if query == "" {
+		metricSource.result.unsupported(sourceSpec.fieldPath("query"), "a query is required")
+		return "", false
+	}
+	return query, true
+}
+
+// openSLOThresholdObjective converts the threshold of a Timeslices objective into a window-based SLI.
+func openSLOThresholdObjective(objective *object, query string) (coralogixv1alpha1.SliType, bool) {
+	op := objective.string("op")
+	operator, ok := openSLOOperators[op]
+	if !ok {
+		objective.result.unsupported(objective.fieldPath("op"), "%q is not supported, only lt, lte, gt and gte are", op)
+		return coralogixv1alpha1.SliType{}, false
+	}
+	value, ok := objective.float("value")
+	if !ok {
+		objective.result.unsupported(objective.fieldPath("value"), "a numeric value is required")
+		return coralogixv1alpha1.SliType{}, false
+	}
+	threshold, err := quantity(value)
+	if err != nil {
+		objective.result.unsupported(objective.fieldPath("value"), "%s", err)
+		return coralogixv1alpha1.SliType{}, false
+	}
+	timeSliceWindow := objective.string("timeSliceWindow")
+	window, ok := openSLOTimeSliceWindows[timeSliceWindow]
+	if !ok {
+		objective.result.unsupported(objective.fieldPath("timeSliceWindow"), "%q is not supported, only 1m and 5m are", timeSliceWindow)
+		return coralogixv1alpha1.SliType{}, false
+	}
+
+	return coralogixv1alpha1.SliType{
+		WindowBasedMetricSli: &coralogixv1alpha1.WindowBasedMetricSli{
+			Query:              &coralogixv1alpha1.SloMetricEvent{Query: query},
+			Window:             window,
+			ComparisonOperator: operator,
+			Threshold:          threshold,
+		},
+	}, true
+}
+
+// openSLOTarget returns the target percentage of an objective, set either as a ratio by target or as a
+// percentage by targetPercent.
+func openSLOTarget(objective *object) (resource.Quantity, bool) {
+	var target float64
+	var ok bool
+	switch {
+	case objective.has("targetPercent"):
+		target, ok = objective.float("targetPercent")
+		objective.ignore("target")
+	case objective.has("target"):
+		target, ok = objective.float("target")
+		target *= 100
+	}
+	if !ok || target <= 0 || target > 100 {
+		objective.result.unsupported(objective.path, "a target between 0 and 1, or targetPercent between 0 and 100, is required")
+		return resource.Quantity{}, false
+	}
+
+	percentage, err := quantity(target)
+	if err != nil {
+		objective.result.unsupported(objective.path, "%s", err)
+		return resource.Quantity{}, false
+	}
+	return percentage, true
+}
+
+func withLabel(labels map[string]string, key, value string) map[string]string {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[key] = value
+	return labels
+}
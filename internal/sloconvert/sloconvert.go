@@ -0,0 +1,251 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sloconvert converts OpenSLO and Sloth specifications into SLO resources.
+//
+// Ratio SLIs become request-based SLIs with good and total events, and threshold SLIs become window-based
+// SLIs. Every field of the source that has no equivalent is reported in Result.Unsupported instead of being
+// dropped, and so are the SLOs that cannot be converted at all.
+package sloconvert
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// Options of the conversion.
+type Options struct {
+	// Namespace of the generated SLOs.
+	Namespace string
+	// TimeFrame of the SLOs converted from specifications without a time window, like Sloth ones.
+	TimeFrame coralogixv1alpha1.SloTimeFrame
+	// RateWindow replaces the {{.window}} placeholder of Sloth queries, e.g. 5m.
+	RateWindow string
+}
+
+// DefaultOptions returns the options used when none are set.
+func DefaultOptions() Options {
+	return Options{TimeFrame: coralogixv1alpha1.SloTimeFrame28d, RateWindow: "5m"}
+}
+
+// Result holds the converted SLOs, and what could not be converted.
+type Result struct {
+	SLOs []*coralogixv1alpha1.SLO
+	// Unsupported lists the fields and SLOs of the sources that could not be converted, as "<path>: <reason>".
+	Unsupported []string
+}
+
+func (r *Result) unsupported(path, format string, args ...any) {
+	r.Unsupported = append(r.Unsupported, path+": "+fmt.Sprintf(format, args...))
+}
+
+// Convert converts the OpenSLO and Sloth documents, ignoring the documents of other kinds. OpenSLO SLI
+// documents are used to resolve the indicatorRef of the SLOs.
+func Convert(documents []map[string]any, opts Options) *Result {
+	result := &Result{}
+	indicators := map[string]map[string]any{}
+	for _, document := range documents {
+		if isOpenSLO(document) && document["kind"] == "SLI" {
+			if name, ok := nestedString(document, "metadata", "name"); ok {
+				indicators[name] = document
+			}
+		}
+	}
+
+	for _, document := range documents {
+		switch {
+		case isOpenSLO(document):
+			convertOpenSLODocument(result, document, indicators, opts)
+		case document["apiVersion"] == SlothAPIVersion && document["kind"] == SlothKind:
+			convertSloth(result, document, opts)
+		}
+	}
+	return result
+}
+
+// ConvertSloth converts a Sloth PrometheusServiceLevel.
+func ConvertSloth(document map[string]any, opts Options) *Result {
+	result := &Result{}
+	convertSloth(result, document, opts)
+	return result
+}
+
+func newSLO(name string, opts Options) *coralogixv1alpha1.SLO {
+	return &coralogixv1alpha1.SLO{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: coralogixv1alpha1.GroupVersion.String(),
+			Kind:       utils.SLOKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: opts.Namespace},
+	}
+}
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// resourceName converts the parts to a valid resource name.
+func resourceName(parts ...string) string {
+	name := invalidNameCharacters.ReplaceAllString(strings.ToLower(strings.Join(parts, "-")), "-")
+	if len(name) > 253 {
+		name = name[:253]
+	}
+	return strings.Trim(name, "-")
+}
+
+// quantity returns the value rounded to 4 decimals, which drops float artifacts like 99.90000000000001.
+func quantity(value float64) (resource.Quantity, error) {
+	rounded := math.Round(value*1e4) / 1e4
+	return resource.ParseQuantity(strconv.FormatFloat(rounded, 'f', -1, 64))
+}
+
+// object reads the fields of a source object, and reports the ones that are not read as unsupported.
+type object struct {
+	path   string
+	fields map[string]any
+	read   map[string]bool
+	result *Result
+}
+
+func newObject(result *Result, path string, value any) *object {
+	fields, _ := value.(map[string]any)
+	return &object{path: path, fields: fields, read: map[string]bool{}, result: result}
+}
+
+func (o *object) has(key string) bool {
+	_, ok := o.fields[key]
+	return ok
+}
+
+func (o *object) fieldPath(key string) string {
+	if o.path == "" {
+		return key
+	}
+	return o.path + "." + key
+}
+
+func (o *object) value(key string) any {
+	o.read[key] = true
+	return o.fields[key]
+}
+
+func (o *object) string(key string) string {
+	value := o.value(key)
+	if value == nil {
+		return ""
+	}
+	if text, ok := value.(string); ok {
+		return text
+	}
+	return fmt.Sprint(value)
+}
+
+func (o *object) float(key string) (float64, bool) {
+	switch value := o.value(key).(type) {
+	case float64:
+		return value, true
+	case int64:
+		return float64(value), true
+	case int:
+		return float64(value), true
+	case string:
+		parsed, err := strconv.ParseFloat(value, 64)
+		return parsed, err == nil
+	}
+	return 0, false
+}
+
+func (o *object) bool(key string) bool {
+	value, _ := o.value(key).(bool)
+	return value
+}
+
+func (o *object) object(key string) *object {
+	return newObject(o.result, o.fieldPath(key), o.value(key))
+}
+
+func (o *object) list(key string) []*object {
+	items, _ := o.value(key).([]any)
+	result := make([]*object, 0, len(items))
+	for i, item := range items {
+		result = append(result, newObject(o.result, fmt.Sprintf("%s[%d]", o.fieldPath(key), i), item))
+	}
+	return result
+}
+
+// stringMap reads a map of strings. In OpenSLO, label values may also be lists, which are joined by commas.
+func (o *object) stringMap(key string) map[string]string {
+	values, _ := o.value(key).(map[string]any)
+	if len(values) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(values))
+	for name, value := range values {
+		switch value := value.(type) {
+		case string:
+			result[name] = value
+		case []any:
+			items := make([]string, 0, len(value))
+			for _, item := range value {
+				items = append(items, fmt.Sprint(item))
+			}
+			result[name] = strings.Join(items, ",")
+		default:
+			result[name] = fmt.Sprint(value)
+		}
+	}
+	return result
+}
+
+// ignore marks fields as read, for fields that are consumed elsewhere or have no effect on the SLO.
+func (o *object) ignore(keys ...string) {
+	for _, key := range keys {
+		o.read[key] = true
+	}
+}
+
+// done reports the fields that were not read as unsupported.
+func (o *object) done() {
+	var unread []string
+	for key := range o.fields {
+		if !o.read[key] {
+			unread = append(unread, key)
+		}
+	}
+	slices.Sort(unread)
+	for _, key := range unread {
+		o.result.unsupported(o.fieldPath(key), "not supported")
+	}
+}
+
+func nestedString(document map[string]any, keys ...string) (string, bool) {
+	var current any = document
+	for _, key := range keys {
+		fields, ok := current.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		current = fields[key]
+	}
+	value, ok := current.(string)
+	return value, ok
+}
@@ -0,0 +1,327 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sloconvert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/yaml"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+)
+
+const slothServiceLevel = `
+apiVersion: sloth.slok.dev/v1
+kind: PrometheusServiceLevel
+metadata:
+  name: checkout
+  namespace: payments
+spec:
+  service: checkout
+  labels:
+    team: payments
+  slos:
+    - name: requests-availability
+      objective: 99.9
+      description: Checkout requests availability.
+      labels:
+        tier: "1"
+      sli:
+        events:
+          errorQuery: sum(rate(http_requests_total{job="checkout",code=~"5.."}[{{.window}}]))
+          totalQuery: sum(rate(http_requests_total{job="checkout"}[{{.window}}]))
+      alerting:
+        name: CheckoutHighErrorRate
+        labels:
+          category: availability
+        annotations:
+          summary: High error rate on checkout requests
+        ticketAlert:
+          disable: true
+    - name: latency
+      objective: 99
+      sli:
+        raw:
+          errorRatioQuery: sum(rate(slow_requests_total[{{.window}}])) / sum(rate(requests_total[{{.window}}]))
+`
+
+const openSLOSpecifications = `
+apiVersion: openslo/v1
+kind: SLI
+metadata:
+  name: checkout-errors
+spec:
+  ratioMetric:
+    counter: true
+    bad:
+      metricSource:
+        type: Prometheus
+        spec:
+          query: sum(rate(http_requests_total{code=~"5.."}[5m]))
+    total:
+      metricSource:
+        type: Prometheus
+        spec:
+          query: sum(rate(http_requests_total[5m]))
+---
+apiVersion: openslo/v1
+kind: SLO
+metadata:
+  name: checkout-availability
+  displayName: Checkout availability
+  annotations:
+    owner: payments
+spec:
+  service: checkout
+  indicatorRef: checkout-errors
+  timeWindow:
+    - duration: 4w
+      isRolling: true
+  budgetingMethod: Occurrences
+  objectives:
+    - displayName: good
+      target: 0.999
+  alertPolicies:
+    - checkout-page
+---
+apiVersion: openslo/v1
+kind: SLO
+metadata:
+  name: checkout-latency
+spec:
+  indicator:
+    metadata:
+      name: checkout-latency
+    spec:
+      thresholdMetric:
+        metricSource:
+          type: Prometheus
+          spec:
+            query: histogram_quantile(0.99, sum(rate(http_request_duration_seconds_bucket[5m])) by (le))
+  timeWindow:
+    - duration: 7d
+      isRolling: true
+  budgetingMethod: Timeslices
+  objectives:
+    - displayName: fast
+      op: lte
+      value: 0.3
+      targetPercent: 95
+      timeSliceWindow: 1m
+    - displayName: acceptable
+      op: lt
+      value: 1
+      target: 0.99
+      timeSliceWindow: 5m
+---
+apiVersion: openslo/v1
+kind: SLO
+metadata:
+  name: monthly
+spec:
+  indicatorRef: checkout-errors
+  timeWindow:
+    - duration: 1M
+      calendar:
+        startTime: "2024-01-01 00:00:00"
+        timeZone: UTC
+  budgetingMethod: Occurrences
+  objectives:
+    - target: 0.99
+---
+apiVersion: openslo/v1
+kind: AlertPolicy
+metadata:
+  name: checkout-page
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ignored
+`
+
+func TestConvertSloth(t *testing.T) {
+	result := Convert(documents(t, slothServiceLevel), DefaultOptions())
+
+	require.Len(t, result.SLOs, 1)
+	slo := result.SLOs[0]
+	require.Equal(t, "checkout-requests-availability", slo.Name)
+	require.Equal(t, "payments", slo.Namespace)
+	require.Equal(t, "checkout-requests-availability", slo.Spec.Name)
+	require.Equal(t, "Checkout requests availability.", *slo.Spec.Description)
+	require.Equal(t, map[string]string{"team": "payments", "tier": "1", "service": "checkout"}, *slo.Spec.Labels)
+	require.Equal(t, coralogixv1alpha1.SloTimeFrame28d, *slo.Spec.Window.TimeFrame)
+	require.True(t, resource.MustParse("99.9").Equal(slo.Spec.TargetThresholdPercentage))
+	require.Equal(t, &coralogixv1alpha1.RequestBasedMetricSli{
+		GoodEvents: coralogixv1alpha1.SloMetricEvent{
+			Query: `(sum(rate(http_requests_total{job="checkout"}[5m]))) - (sum(rate(http_requests_total{job="checkout",code=~"5.."}[5m])))`,
+		},
+		TotalEvents: coralogixv1alpha1.SloMetricEvent{Query: `sum(rate(http_requests_total{job="checkout"}[5m]))`},
+	}, slo.Spec.SliType.RequestBasedMetricSli)
+
+	require.Equal(t, &coralogixv1alpha1.SLOAlerting{
+		BurnRates: []coralogixv1alpha1.SLOBurnRateAlerting{
+			{
+				Name:        "page-1h",
+				WindowHours: 1,
+				Dual:        true,
+				Thresholds:  []coralogixv1alpha1.SLOAlertThreshold{{Threshold: resource.MustParse("14.4"), Priority: coralogixv1beta1.AlertPriorityP1}},
+			},
+			{
+				Name:        "page-6h",
+				WindowHours: 6,
+				Dual:        true,
+				Thresholds:  []coralogixv1alpha1.SLOAlertThreshold{{Threshold: resource.MustParse("6"), Priority: coralogixv1beta1.AlertPriorityP1}},
+			},
+		},
+		EntityLabels: map[string]string{"category": "availability"},
+	}, slo.Spec.Alerting)
+
+	require.Equal(t, []string{
+		"PrometheusServiceLevel checkout.spec.slos[0].alerting.annotations: not supported",
+		"PrometheusServiceLevel checkout.spec.slos[0].alerting.name: not supported",
+		"PrometheusServiceLevel checkout.spec.slos[1].sli: only events SLIs are supported, raw and plugin SLIs have no total events",
+	}, result.Unsupported)
+}
+
+func TestConvertSlothOptions(t *testing.T) {
+	result := ConvertSloth(documents(t, slothServiceLevel)[0], Options{
+		Namespace:  "slos",
+		TimeFrame:  coralogixv1alpha1.SloTimeFrame7d,
+		RateWindow: "1m",
+	})
+
+	require.Len(t, result.SLOs, 1)
+	slo := result.SLOs[0]
+	require.Equal(t, "slos", slo.Namespace)
+	require.Equal(t, coralogixv1alpha1.SloTimeFrame7d, *slo.Spec.Window.TimeFrame)
+	require.Equal(t, `sum(rate(http_requests_total{job="checkout"}[1m]))`, slo.Spec.SliType.RequestBasedMetricSli.TotalEvents.Query)
+}
+
+func TestConvertOpenSLO(t *testing.T) {
+	result := Convert(documents(t, openSLOSpecifications), Options{Namespace: "payments"})
+
+	require.Len(t, result.SLOs, 3)
+	availability := result.SLOs[0]
+	require.Equal(t, "checkout-availability", availability.Name)
+	require.Equal(t, "payments", availability.Namespace)
+	require.Equal(t, "Checkout availability", availability.Spec.Name)
+	require.Equal(t, map[string]string{"service": "checkout"}, *availability.Spec.Labels)
+	require.Equal(t, coralogixv1alpha1.SloTimeFrame28d, *availability.Spec.Window.TimeFrame)
+	require.True(t, resource.MustParse("99.9").Equal(availability.Spec.TargetThresholdPercentage))
+	require.Equal(t, &coralogixv1alpha1.RequestBasedMetricSli{
+		GoodEvents: coralogixv1alpha1.SloMetricEvent{
+			Query: `(sum(rate(http_requests_total[5m]))) - (sum(rate(http_requests_total{code=~"5.."}[5m])))`,
+		},
+		TotalEvents: coralogixv1alpha1.SloMetricEvent{Query: "sum(rate(http_requests_total[5m]))"},
+	}, availability.Spec.SliType.RequestBasedMetricSli)
+
+	fast := result.SLOs[1]
+	require.Equal(t, "checkout-latency-fast", fast.Name)
+	require.Equal(t, "checkout-latency (fast)", fast.Spec.Name)
+	require.Nil(t, fast.Spec.Labels)
+	require.Equal(t, coralogixv1alpha1.SloTimeFrame7d, *fast.Spec.Window.TimeFrame)
+	require.True(t, resource.MustParse("95").Equal(fast.Spec.TargetThresholdPercentage))
+	windowBased := fast.Spec.SliType.WindowBasedMetricSli
+	require.Equal(t, "histogram_quantile(0.99, sum(rate(http_request_duration_seconds_bucket[5m])) by (le))", windowBased.Query.Query)
+	require.Equal(t, coralogixv1alpha1.SloWindowEnum("1m"), windowBased.Window)
+	require.Equal(t, coralogixv1alpha1.ComparisonOperator("lessThanOrEquals"), windowBased.ComparisonOperator)
+	require.True(t, resource.MustParse("0.3").Equal(windowBased.Threshold))
+
+	acceptable := result.SLOs[2]
+	require.Equal(t, "checkout-latency-acceptable", acceptable.Name)
+	require.True(t, resource.MustParse("99").Equal(acceptable.Spec.TargetThresholdPercentage))
+	require.Equal(t, coralogixv1alpha1.ComparisonOperator("lessThan"), acceptable.Spec.SliType.WindowBasedMetricSli.ComparisonOperator)
+	require.Equal(t, coralogixv1alpha1.SloWindowEnum("5m"), acceptable.Spec.SliType.WindowBasedMetricSli.Window)
+
+	require.Equal(t, []string{
+		"SLO checkout-availability.metadata.annotations: not supported",
+		"SLO checkout-availability.spec.alertPolicies: not supported, use spec.alerting of the generated SLO",
+		"SLO monthly.spec.timeWindow[0]: calendar time windows are not supported, only rolling ones are",
+		"AlertPolicy checkout-page: kind AlertPolicy is not supported",
+	}, result.Unsupported)
+}
+
+func TestConvertOpenSLOUnsupportedIndicators(t *testing.T) {
+	result := Convert(documents(t, `
+apiVersion: openslo/v1
+kind: SLO
+metadata:
+  name: datadog
+spec:
+  indicator:
+    metadata:
+      name: datadog
+    spec:
+      ratioMetric:
+        counter: true
+        good:
+          metricSource:
+            type: Datadog
+            spec:
+              query: sum:requests.success{*}.as_count()
+        total:
+          metricSource:
+            type: Datadog
+            spec:
+              query: sum:requests.total{*}.as_count()
+  timeWindow:
+    - duration: 28d
+      isRolling: true
+  budgetingMethod: Occurrences
+  objectives:
+    - target: 0.99
+---
+apiVersion: openslo/v1
+kind: SLO
+metadata:
+  name: ratio-timeslices
+spec:
+  indicatorRef: missing
+  timeWindow:
+    - duration: 28d
+      isRolling: true
+  budgetingMethod: RatioTimeslices
+  objectives:
+    - target: 0.99
+---
+apiVersion: openslo/v1alpha
+kind: SLO
+metadata:
+  name: old
+`), DefaultOptions())
+
+	require.Empty(t, result.SLOs)
+	require.Equal(t, []string{
+		"SLO datadog.spec.indicator.spec.ratioMetric.total.metricSource.type: Datadog metric sources are not supported, only Prometheus ones are",
+		"SLO ratio-timeslices.spec.indicatorRef: SLI missing is not found",
+		"SLO old: apiVersion openslo/v1alpha is not supported, only openslo/v1 is",
+	}, result.Unsupported)
+}
+
+func documents(t *testing.T, manifests string) []map[string]any {
+	t.Helper()
+	var result []map[string]any
+	for _, manifest := range strings.Split(manifests, "\n---\n") {
+		document := map[string]any{}
+		require.NoError(t, yaml.Unmarshal([]byte(manifest), &document))
+		result = append(result, document)
+	}
+	return result
+}
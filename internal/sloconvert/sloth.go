@@ -0,0 +1,192 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sloconvert
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+const (
+	// SlothAPIVersion is the API version of the Sloth PrometheusServiceLevel.
+	SlothAPIVersion = utils.SlothAPIGroup + "/" + utils.V1APIVersion
+	// SlothKind is the kind of the Sloth PrometheusServiceLevel.
+	SlothKind = utils.PrometheusServiceLevelKind
+)
+
+// slothWindowPlaceholder matches the {{.window}} placeholder of Sloth queries.
+var slothWindowPlaceholder = regexp.MustCompile(`{{\s*\.window\s*}}`)
+
+// slothBurnRate is a multi-window burn-rate alert generated by Sloth, following the Google SRE workbook.
+type slothBurnRate struct {
+	name        string
+	windowHours int
+	burnRate    string
+	priority    coralogixv1beta1.AlertPriority
+}
+
+var (
+	slothPageBurnRates = []slothBurnRate{
+		{name: "page-1h", windowHours: 1, burnRate: "14.4", priority: coralogixv1beta1.AlertPriorityP1},
+		{name: "page-6h", windowHours: 6, burnRate: "6", priority: coralogixv1beta1.AlertPriorityP1},
+	}
+	slothTicketBurnRates = []slothBurnRate{
+		{name: "ticket-1d", windowHours: 24, burnRate: "3", priority: coralogixv1beta1.AlertPriorityP3},
+		{name: "ticket-3d", windowHours: 72, burnRate: "1", priority: coralogixv1beta1.AlertPriorityP3},
+	}
+)
+
+// convertSloth converts each SLO of a PrometheusServiceLevel. Sloth has no time window, so the SLOs get
+// opts.TimeFrame, and its page and ticket alerts become the matching burn-rate policies of spec.alerting.
+func convertSloth(result *Result, document map[string]any, opts Options) {
+	name, _ := nestedString(document, "metadata", "name")
+	if opts.Namespace == "" {
+		opts.Namespace, _ = nestedString(document, "metadata", "namespace")
+	}
+	if opts.TimeFrame == "" {
+		opts.TimeFrame = DefaultOptions().TimeFrame
+	}
+	if opts.RateWindow == "" {
+		opts.RateWindow = DefaultOptions().RateWindow
+	}
+
+	// The metadata of the PrometheusServiceLevel only names the SLOs, so it is not walked.
+	spec := newObject(result, fmt.Sprintf("%s %s", SlothKind, name), document["spec"])
+	spec.path += ".spec"
+	service := spec.string("service")
+	labels := spec.stringMap("labels")
+	slos := spec.list("slos")
+	spec.done()
+
+	for _, item := range slos {
+		sloName := item.string("name")
+		sli := item.object("sli")
+		errorQuery, totalQuery, ok := slothEvents(sli)
+		if !ok {
+			continue
+		}
+
+		objective, ok := item.float("objective")
+		if !ok || objective <= 0 || objective > 100 {
+			result.unsupported(item.fieldPath("objective"), "an objective between 0 and 100 is required")
+			continue
+		}
+		target, err := quantity(objective)
+		if err != nil {
+			result.unsupported(item.fieldPath("objective"), "%s", err)
+			continue
+		}
+
+		sloLabels := map[string]string{}
+		maps.Copy(sloLabels, labels)
+		maps.Copy(sloLabels, item.stringMap("labels"))
+		if service != "" {
+			sloLabels["service"] = service
+		}
+
+		errorQuery = slothWindowPlaceholder.ReplaceAllString(errorQuery, opts.RateWindow)
+		totalQuery = slothWindowPlaceholder.ReplaceAllString(totalQuery, opts.RateWindow)
+		slo := newSLO(resourceName(name, sloName), opts)
+		slo.Spec = coralogixv1alpha1.SLOSpec{
+			Name: sloName,
+			SliType: coralogixv1alpha1.SliType{
+				RequestBasedMetricSli: &coralogixv1alpha1.RequestBasedMetricSli{
+					GoodEvents:  coralogixv1alpha1.SloMetricEvent{Query: fmt.Sprintf("(%s) - (%s)", totalQuery, errorQuery)},
+					TotalEvents: coralogixv1alpha1.SloMetricEvent{Query: totalQuery},
+				},
+			},
+			Window:                    coralogixv1alpha1.SloWindow{TimeFrame: ptr.To(opts.TimeFrame)},
+			TargetThresholdPercentage: target,
+			Alerting:                  slothAlerting(item.object("alerting")),
+		}
+		if service != "" {
+			slo.Spec.Name = fmt.Sprintf("%s-%s", service, sloName)
+		}
+		if description := item.string("description"); description != "" {
+			slo.Spec.Description = ptr.To(description)
+		}
+		if len(sloLabels) > 0 {
+			slo.Spec.Labels = ptr.To(sloLabels)
+		}
+		item.done()
+		result.SLOs = append(result.SLOs, slo)
+	}
+}
+
+// slothEvents returns the error and total queries of an events SLI. Raw and plugin SLIs only provide an error
+// ratio, which can't be split into good and total events.
+func slothEvents(sli *object) (string, string, bool) {
+	if !sli.has("events") {
+		sli.ignore("raw", "plugin")
+		sli.result.unsupported(sli.path, "only events SLIs are supported, raw and plugin SLIs have no total events")
+		return "", "", false
+	}
+
+	events := sli.object("events")
+	sli.done()
+	errorQuery, totalQuery := events.string("errorQuery"), events.string("totalQuery")
+	events.done()
+	if errorQuery == "" || totalQuery == "" {
+		sli.result.unsupported(events.path, "errorQuery and totalQuery are required")
+		return "", "", false
+	}
+	return errorQuery, totalQuery, true
+}
+
+// slothAlerting converts the page and ticket alerts of an SLO. Their labels and annotations are Prometheus
+// alert settings, so they are reported; the alerting labels become entity labels of the generated Alerts.
+func slothAlerting(alerting *object) *coralogixv1alpha1.SLOAlerting {
+	if alerting.fields == nil {
+		return nil
+	}
+
+	result := &coralogixv1alpha1.SLOAlerting{EntityLabels: alerting.stringMap("labels")}
+	for _, alertBurnRates := range []struct {
+		key       string
+		burnRates []slothBurnRate
+	}{{"pageAlert", slothPageBurnRates}, {"ticketAlert", slothTicketBurnRates}} {
+		alert := alerting.object(alertBurnRates.key)
+		disabled := alert.bool("disable")
+		alert.done()
+		if disabled {
+			continue
+		}
+		for _, burnRate := range alertBurnRates.burnRates {
+			result.BurnRates = append(result.BurnRates, coralogixv1alpha1.SLOBurnRateAlerting{
+				Name:        burnRate.name,
+				WindowHours: burnRate.windowHours,
+				Dual:        true,
+				Thresholds: []coralogixv1alpha1.SLOAlertThreshold{{
+					Threshold: resource.MustParse(burnRate.burnRate),
+					Priority:  burnRate.priority,
+				}},
+			})
+		}
+	}
+	alerting.done()
+
+	if len(result.BurnRates) == 0 {
+		return nil
+	}
+	return result
+}
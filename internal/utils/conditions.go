@@ -28,8 +28,14 @@ const (
 	ReasonInternalK8sError         = "InternalK8sError"
 	ReasonDeserializationError     = "DeserializationError"
 	ReasonPartialFailure           = "PartialFailure"
+	ReasonSingletonConflict        = "SingletonConflict"
+	ReasonInvalidQuery             = "InvalidQuery"
+	ReasonChildResourcesUnsynced   = "ChildResourcesUnsynced"
+	ReasonForeignRemoteObject      = "ForeignRemoteObject"
+	ReasonRuleGroupTestsFailed     = "RuleGroupTestsFailed"
 
 	ConditionTypeRemoteSynced = "RemoteSynced"
+	ConditionTypeConflict     = "Conflict"
 )
 
 // SetSyncedConditionFalse sets the RemoteSynced condition to False. returns true if the conditions are changed by this call.
@@ -54,6 +60,22 @@ func SetSyncedConditionTrue(conditions *[]metav1.Condition, observedGeneration i
 	})
 }
 
+// SetConflictConditionTrue sets the Conflict condition to True. returns true if the conditions are changed by this call.
+func SetConflictConditionTrue(conditions *[]metav1.Condition, observedGeneration int64, reason, message string) bool {
+	return meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               ConditionTypeConflict,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: observedGeneration,
+	})
+}
+
+// RemoveConflictCondition removes the Conflict condition. returns true if the conditions are changed by this call.
+func RemoveConflictCondition(conditions *[]metav1.Condition) bool {
+	return meta.RemoveStatusCondition(conditions, ConditionTypeConflict)
+}
+
 // GetReasonForRemoteSyncedCondition returns the Reason for the RemoteSynced condition from the given conditions slice.
 func GetReasonForRemoteSyncedCondition(conditions []metav1.Condition) string {
 	cond := meta.FindStatusCondition(conditions, ConditionTypeRemoteSynced)
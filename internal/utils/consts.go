@@ -16,6 +16,7 @@ package utils
 
 const (
 	MonitoringAPIGroup = "monitoring.coreos.com"
+	SlothAPIGroup      = "sloth.slok.dev"
 	CoralogixAPIGroup  = "coralogix.com"
 
 	V1alpha1APIVersion = "v1alpha1"
@@ -31,6 +32,8 @@ const (
 	CustomRoleKind             = "CustomRole"
 	ScopeKind                  = "Scope"
 	GroupKind                  = "Group"
+	UserKind                   = "User"
+	CoralogixTenantKind        = "CoralogixTenant"
 	TCOLogsPoliciesKind        = "TCOLogsPolicies"
 	TCOTracesPoliciesKind      = "TCOTracesPolicies"
 	TCORumPoliciesKind         = "TCORumPolicies"
@@ -38,8 +41,12 @@ const (
 	IntegrationKind            = "Integration"
 	AlertSchedulerKind         = "AlertScheduler"
 	PrometheusRuleKind         = "PrometheusRule"
+	AlertmanagerConfigKind     = "AlertmanagerConfig"
+	PrometheusServiceLevelKind = "PrometheusServiceLevel"
 	DashboardKind              = "Dashboard"
 	DashboardsFolderKind       = "DashboardsFolder"
+	DashboardTemplateKind      = "DashboardTemplate"
+	ObservabilityProfileKind   = "ObservabilityProfile"
 	ViewKind                   = "View"
 	ViewFolderKind             = "ViewFolder"
 	ConnectorKind              = "Connector"
@@ -54,9 +61,32 @@ const (
 	EnrichmentKind             = "Enrichment"
 	AIEvaluationKind           = "AIEvaluation"
 	AICustomEvaluationKind     = "AICustomEvaluation"
+	AIEvaluationSetKind        = "AIEvaluationSet"
 
 	TrackPrometheusRuleAlertsLabelKey         = "app.coralogix.com/track-alerting-rules"
 	TrackPrometheusRuleRecordingRulesLabelKey = "app.coralogix.com/track-recording-rules"
+	TrackAlertmanagerConfigLabelKey           = "app.coralogix.com/track-alertmanager-config"
+	TrackPrometheusServiceLevelLabelKey       = "app.coralogix.com/track-prometheus-service-level"
+	SLOLabelKey                               = "app.coralogix.com/slo"
+	ObservabilityProfileWorkloadLabelKey      = "app.coralogix.com/observability-profile-workload"
+
+	AlertmanagerConfigSlackIntegrationIDAnnotationKey = "app.coralogix.com/slack-integration-id"
+	AlertmanagerConfigRoutingTeamAnnotationKey        = "app.coralogix.com/routing-team"
+	AlertmanagerConfigRoutingServiceAnnotationKey     = "app.coralogix.com/routing-service"
+	AlertmanagerConfigRoutingEnvironmentAnnotationKey = "app.coralogix.com/routing-environment"
+	PrometheusServiceLevelTimeFrameAnnotationKey      = "app.coralogix.com/slo-time-frame"
+	PrometheusServiceLevelRateWindowAnnotationKey     = "app.coralogix.com/slo-rate-window"
 
 	LogVerbosityAnnotationKey = "app.coralogix.com/log-verbosity"
+
+	MuteUntilAnnotationKey  = "app.coralogix.com/mute-until"
+	MuteReasonAnnotationKey = "app.coralogix.com/mute-reason"
+
+	RollbackAnnotationKey = "app.coralogix.com/rollback"
+
+	ObservabilityProfileAnnotationKey = "app.coralogix.com/observability-profile"
+
+	ReconcileRequestedAtAnnotationKey = "app.coralogix.com/reconcile-requested-at"
+
+	ReconcileIntervalAnnotationKey = "app.coralogix.com/reconcile-interval"
 )
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -26,6 +27,7 @@ import (
 func GetGVKs(scheme *runtime.Scheme) []schema.GroupVersionKind {
 	result := []schema.GroupVersionKind{
 		{Group: MonitoringAPIGroup, Version: V1APIVersion, Kind: PrometheusRuleKind},
+		{Group: MonitoringAPIGroup, Version: V1alpha1APIVersion, Kind: AlertmanagerConfigKind},
 	}
 
 	result = append(result, GetGVKsInVersion(V1alpha1APIVersion, scheme)...)
@@ -62,3 +64,29 @@ func FormatJSON(obj any) string {
 	}
 	return string(formattedReq)
 }
+
+// HandledReconcileRequest returns the reconcile request to record in the status of a resource once it is reconciled:
+// the value of its reconcile-requested-at annotation, or lastHandled if the annotation is not set.
+func HandledReconcileRequest(obj metav1.Object, lastHandled string) string {
+	if requestedAt := obj.GetAnnotations()[ReconcileRequestedAtAnnotationKey]; requestedAt != "" {
+		return requestedAt
+	}
+	return lastHandled
+}
+
+// PropagateReconcileRequest copies the reconcile-requested-at annotation of a resource to a resource generated from
+// it, so that requesting the reconciliation of the former also reconciles the latter. It returns true if the
+// annotations of the generated resource changed.
+func PropagateReconcileRequest(from, to metav1.Object) bool {
+	requestedAt := from.GetAnnotations()[ReconcileRequestedAtAnnotationKey]
+	if requestedAt == "" || to.GetAnnotations()[ReconcileRequestedAtAnnotationKey] == requestedAt {
+		return false
+	}
+	annotations := to.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ReconcileRequestedAtAnnotationKey] = requestedAt
+	to.SetAnnotations(annotations)
+	return true
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cxsdk "github.com/coralogix/coralogix-management-sdk/go"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	coralogixv1beta1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+var _ = Describe("CoralogixDefaults", Ordered, func() {
+	var (
+		crClient     client.Client
+		alertsClient *cxsdk.AlertsClient
+		// The defaults apply to the whole namespace, so they get their own to not affect the other specs.
+		namespace = uniqueName("defaults")
+		alertName = uniqueName("alert-with-defaults")
+	)
+
+	BeforeAll(func(ctx context.Context) {
+		crClient = ClientsInstance.GetControllerRuntimeClient()
+		alertsClient = ClientsInstance.GetCoralogixClientSet().Alerts()
+		Expect(crClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})).To(Succeed())
+	})
+
+	AfterAll(func(ctx context.Context) {
+		Expect(crClient.Delete(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})).To(Succeed())
+	})
+
+	It("Should merge the defaults into the alerts of the namespace", func(ctx context.Context) {
+		By("Creating CoralogixDefaults")
+		defaults := &coralogixv1alpha1.CoralogixDefaults{
+			ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: namespace},
+			Spec: coralogixv1alpha1.CoralogixDefaultsSpec{
+				EntityLabels:  map[string]string{"owner": "checkout"},
+				RoutingLabels: &coralogixv1alpha1.RoutingLabels{Team: ptr.To("checkout")},
+			},
+		}
+		Expect(crClient.Create(ctx, defaults)).To(Succeed())
+
+		By("Creating Alert")
+		query := "error"
+		alert := &coralogixv1beta1.Alert{
+			ObjectMeta: metav1.ObjectMeta{Name: alertName, Namespace: namespace},
+			Spec: coralogixv1beta1.AlertSpec{
+				Name:         alertName,
+				Priority:     coralogixv1beta1.AlertPriorityP3,
+				EntityLabels: map[string]string{"owner": "payments"},
+				TypeDefinition: coralogixv1beta1.AlertTypeDefinition{
+					LogsImmediate: &coralogixv1beta1.LogsImmediate{
+						LogsFilter: &coralogixv1beta1.LogsFilter{
+							SimpleFilter: coralogixv1beta1.LogsSimpleFilter{LuceneQuery: &query},
+						},
+					},
+				},
+			},
+		}
+		Expect(crClient.Create(ctx, alert)).To(Succeed())
+
+		By("Verifying the applied defaults are recorded in the status")
+		var alertID string
+		Eventually(func(g Gomega) {
+			fetchedAlert := &coralogixv1beta1.Alert{}
+			g.Expect(crClient.Get(ctx, types.NamespacedName{Name: alertName, Namespace: namespace}, fetchedAlert)).To(Succeed())
+			g.Expect(meta.IsStatusConditionTrue(fetchedAlert.Status.Conditions, utils.ConditionTypeRemoteSynced)).To(BeTrue())
+			g.Expect(fetchedAlert.Status.AppliedDefaults).To(Equal(&coralogixv1beta1.AppliedDefaults{
+				Sources: []string{namespace + "/defaults"},
+				Fields:  []string{"entityLabels.routing.team"},
+			}))
+			alertID = *fetchedAlert.Status.ID
+		}, time.Minute, time.Second).Should(Succeed())
+
+		By("Verifying the remote alert has the default entity labels")
+		alertDef, err := alertsClient.Get(ctx, &cxsdk.GetAlertDefRequest{Id: wrapperspb.String(alertID)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(alertDef.GetAlertDef().GetAlertDefProperties().GetEntityLabels()).To(Equal(map[string]string{
+			"owner":        "payments",
+			"routing.team": "checkout",
+		}))
+
+		By("Deleting the Alert")
+		Expect(crClient.Delete(ctx, alert)).To(Succeed())
+		Eventually(func() error {
+			_, err := alertsClient.Get(ctx, &cxsdk.GetAlertDefRequest{Id: wrapperspb.String(alertID)})
+			return err
+		}, time.Minute, time.Second).Should(HaveOccurred())
+	})
+})
@@ -0,0 +1,120 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+var _ = Describe("CoralogixTenant", Ordered, func() {
+	var (
+		crClient   client.Client
+		tenant     *coralogixv1alpha1.CoralogixTenant
+		tenantName = uniqueName("tenant-sample")
+	)
+
+	BeforeEach(func() {
+		crClient = ClientsInstance.GetControllerRuntimeClient()
+		tenant = &coralogixv1alpha1.CoralogixTenant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tenantName,
+				Namespace: testNamespace,
+			},
+			Spec: coralogixv1alpha1.CoralogixTenantSpec{
+				TeamName: tenantName,
+				Filters: &coralogixv1alpha1.CoralogixTenantFilters{
+					Applications: []string{"checkout"},
+					Subsystems:   []string{"payments"},
+				},
+				Role: coralogixv1alpha1.CoralogixTenantRole{
+					ParentRoleName: "Standard User",
+					Permissions:    []string{"team-actions:UpdateConfig"},
+				},
+				Members: []coralogixv1alpha1.Member{
+					{UserName: "example@coralogix.com"},
+				},
+				ApiKey: &coralogixv1alpha1.CoralogixTenantApiKey{
+					TeamId:  4013254,
+					Presets: []string{"SendData"},
+				},
+			},
+		}
+	})
+
+	It("Should be created successfully", func(ctx context.Context) {
+		By("Creating CoralogixTenant")
+		Expect(crClient.Create(ctx, tenant)).To(Succeed())
+
+		By("Waiting for the CoralogixTenant and its resources to be RemoteSynced")
+		Eventually(func(g Gomega) {
+			fetchedTenant := &coralogixv1alpha1.CoralogixTenant{}
+			g.Expect(crClient.Get(ctx, types.NamespacedName{Name: tenantName, Namespace: testNamespace}, fetchedTenant)).To(Succeed())
+			g.Expect(meta.IsStatusConditionTrue(fetchedTenant.Status.Conditions, utils.ConditionTypeRemoteSynced)).To(BeTrue())
+			g.Expect(fetchedTenant.Status.PrintableStatus).To(Equal("RemoteSynced"))
+			g.Expect(fetchedTenant.Status.Resources).To(HaveLen(4))
+			for _, resource := range fetchedTenant.Status.Resources {
+				g.Expect(resource.ID).ToNot(BeNil())
+			}
+		}, 2*time.Minute, time.Second).Should(Succeed())
+
+		By("Verifying the Group refers to the Scope and CustomRole of the tenant")
+		group := &coralogixv1alpha1.Group{}
+		Expect(crClient.Get(ctx, types.NamespacedName{Name: tenantName + "-group", Namespace: testNamespace}, group)).To(Succeed())
+		Expect(group.Spec.Scope.ResourceRef.Name).To(Equal(tenantName + "-scope"))
+		Expect(group.Spec.CustomRole.ResourceRef.Name).To(Equal(tenantName + "-role"))
+	})
+
+	It("Should delete the ApiKey once removed from the spec", func(ctx context.Context) {
+		By("Removing the ApiKey")
+		modifiedTenant := tenant.DeepCopy()
+		modifiedTenant.Spec.ApiKey = nil
+		Expect(crClient.Patch(ctx, modifiedTenant, client.MergeFrom(tenant))).To(Succeed())
+
+		By("Verifying the ApiKey is deleted")
+		Eventually(func() bool {
+			err := crClient.Get(ctx, types.NamespacedName{Name: tenantName + "-api-key", Namespace: testNamespace}, &coralogixv1alpha1.ApiKey{})
+			return errors.IsNotFound(err)
+		}, time.Minute, time.Second).Should(BeTrue())
+	})
+
+	It("Should be deleted successfully", func(ctx context.Context) {
+		By("Deleting the CoralogixTenant")
+		Expect(crClient.Delete(ctx, tenant)).To(Succeed())
+
+		By("Verifying the resources of the tenant are deleted")
+		Eventually(func() bool {
+			err := crClient.Get(ctx, types.NamespacedName{Name: tenantName + "-group", Namespace: testNamespace}, &coralogixv1alpha1.Group{})
+			return errors.IsNotFound(err)
+		}, time.Minute, time.Second).Should(BeTrue())
+	})
+
+	It("Should deny creation of CoralogixTenant with empty filters", func(ctx context.Context) {
+		tenant.Spec.Filters = &coralogixv1alpha1.CoralogixTenantFilters{}
+		err := crClient.Create(ctx, tenant)
+		Expect(err.Error()).To(ContainSubstring("At least one of applications or subsystems must be set"))
+	})
+})
@@ -0,0 +1,150 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cxsdk "github.com/coralogix/coralogix-management-sdk/go"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+var _ = Describe("User", Ordered, func() {
+	var (
+		crClient    client.Client
+		usersClient *cxsdk.UsersClient
+		user        *coralogixv1alpha1.User
+		group       *coralogixv1alpha1.Group
+		userID      string
+		userName    = uniqueName("user-sample")
+		groupName   = uniqueName("group-with-user")
+	)
+
+	BeforeEach(func() {
+		crClient = ClientsInstance.GetControllerRuntimeClient()
+		usersClient = ClientsInstance.GetCoralogixClientSet().Users()
+		user = &coralogixv1alpha1.User{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      userName,
+				Namespace: testNamespace,
+			},
+			Spec: coralogixv1alpha1.UserSpec{
+				UserName: userName + "@coralogix.com",
+				Name: &coralogixv1alpha1.UserFullName{
+					GivenName:  "Example",
+					FamilyName: "User",
+				},
+				Active: true,
+			},
+		}
+		group = &coralogixv1alpha1.Group{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      groupName,
+				Namespace: testNamespace,
+			},
+			Spec: coralogixv1alpha1.GroupSpec{
+				Name: groupName,
+				Members: []coralogixv1alpha1.Member{
+					{ResourceRef: &coralogixv1alpha1.ResourceRef{Name: userName}},
+					{UserName: "unknown-" + userName + "@coralogix.com"},
+				},
+			},
+		}
+	})
+
+	It("Should be created successfully", func(ctx context.Context) {
+		By("Creating User")
+		Expect(crClient.Create(ctx, user)).To(Succeed())
+
+		By("Fetching the User ID")
+		fetchedUser := &coralogixv1alpha1.User{}
+		Eventually(func(g Gomega) {
+			g.Expect(crClient.Get(ctx, types.NamespacedName{Name: userName, Namespace: testNamespace}, fetchedUser)).To(Succeed())
+			g.Expect(meta.IsStatusConditionTrue(fetchedUser.Status.Conditions, utils.ConditionTypeRemoteSynced)).To(BeTrue())
+			g.Expect(fetchedUser.Status.PrintableStatus).To(Equal("RemoteSynced"))
+			g.Expect(fetchedUser.Status.ID).ToNot(BeNil())
+			userID = *fetchedUser.Status.ID
+		}, time.Minute, time.Second).Should(Succeed())
+
+		By("Verifying User exists in Coralogix backend")
+		Eventually(func() error {
+			_, err := usersClient.Get(ctx, userID)
+			return err
+		}, time.Minute, time.Second).Should(Succeed())
+	})
+
+	It("Should be referenced by Group members", func(ctx context.Context) {
+		By("Creating Group")
+		Expect(crClient.Create(ctx, group)).To(Succeed())
+
+		By("Verifying the User is resolved and the unknown user is reported")
+		Eventually(func(g Gomega) {
+			fetchedGroup := &coralogixv1alpha1.Group{}
+			g.Expect(crClient.Get(ctx, types.NamespacedName{Name: groupName, Namespace: testNamespace}, fetchedGroup)).To(Succeed())
+			g.Expect(fetchedGroup.Status.Members).To(HaveLen(2))
+			g.Expect(fetchedGroup.Status.Members[0].ID).To(Equal(ptr.To(userID)))
+			g.Expect(fetchedGroup.Status.Members[1].ID).To(BeNil())
+			g.Expect(fetchedGroup.Status.Members[1].Message).ToNot(BeEmpty())
+		}, time.Minute, time.Second).Should(Succeed())
+
+		By("Deleting the Group")
+		Expect(crClient.Delete(ctx, group)).To(Succeed())
+	})
+
+	It("Should be deactivated successfully", func(ctx context.Context) {
+		By("Deactivating the User")
+		modifiedUser := user.DeepCopy()
+		modifiedUser.Spec.Active = false
+		Expect(crClient.Patch(ctx, modifiedUser, client.MergeFrom(user))).To(Succeed())
+
+		By("Verifying User is deactivated in Coralogix backend")
+		Eventually(func() bool {
+			getUserRes, err := usersClient.Get(ctx, userID)
+			Expect(err).ToNot(HaveOccurred())
+			return getUserRes.Active
+		}, time.Minute, time.Second).Should(BeFalse())
+	})
+
+	It("Should be deleted successfully", func(ctx context.Context) {
+		By("Deleting the User")
+		Expect(crClient.Delete(ctx, user)).To(Succeed())
+
+		By("Verifying User is deleted from Coralogix backend")
+		Eventually(func() codes.Code {
+			_, err := usersClient.Get(ctx, userID)
+			return cxsdk.Code(err)
+		}, time.Minute, time.Second).Should(Equal(codes.NotFound))
+	})
+
+	It("Should deny creation of Group member with both userName and resourceRef", func(ctx context.Context) {
+		group.Spec.Members = []coralogixv1alpha1.Member{
+			{UserName: userName + "@coralogix.com", ResourceRef: &coralogixv1alpha1.ResourceRef{Name: userName}},
+		}
+		err := crClient.Create(ctx, group)
+		Expect(err.Error()).To(ContainSubstring("Exactly one of userName or resourceRef is required"))
+	})
+})
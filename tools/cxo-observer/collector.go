@@ -126,6 +126,13 @@ func collectGvkCRs(ctx context.Context, log logr.Logger, ns string, gvk schema.G
 		}
 		labelSelector = labelSelector.Add(*req)
 	}
+	if gvk.Kind == utils.AlertmanagerConfigKind {
+		req, err := labels.NewRequirement(utils.TrackAlertmanagerConfigLabelKey, selection.Equals, []string{"true"})
+		if err != nil {
+			return fmt.Errorf("failed to create label requirement: %w", err)
+		}
+		labelSelector = labelSelector.Add(*req)
+	}
 
 	listOpts := &client.ListOptions{LabelSelector: labelSelector}
 	if ns != "" {
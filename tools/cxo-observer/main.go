@@ -108,7 +108,7 @@ func main() {
 		if gvk.Kind == utils.TCOLogsPoliciesKind || gvk.Kind == utils.TCOTracesPoliciesKind {
 			crdName = strings.ToLower(gvk.Kind) + ".coralogix.com"
 		}
-		if gvk.Kind == utils.PrometheusRuleKind {
+		if gvk.Group == utils.MonitoringAPIGroup {
 			crdName = strings.ToLower(gvk.Kind) + "s.monitoring.coreos.com"
 		}
 
@@ -0,0 +1,258 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openapicxsdk "github.com/coralogix/coralogix-management-sdk/go/openapi/cxsdk"
+
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// remoteDiffer returns the object the operator would send for a resource, and the remote object it is compared with.
+type remoteDiffer func(ctx context.Context, clientSet *openapicxsdk.ClientSet, obj client.Object) (desired, remote any, err error)
+
+// remoteDiffers are the kinds supported by diff.
+var remoteDiffers = map[string]remoteDiffer{
+	utils.AlertKind: diffAlert,
+	utils.SLOKind:   diffSLO,
+}
+
+// runDiff prints the fields of the remote object of a resource that differ from its spec. The exit code is 1 if there
+// are differences, as for diff(1).
+func runDiff(ctx context.Context, args []string) (int, error) {
+	flags, namespaceFlags := newFlagSet("diff", "<alert|slo> <name>", false)
+	region := flags.String("region", os.Getenv("CORALOGIX_REGION"), "The region of your Coralogix cluster. Conflicts with 'domain'.")
+	domain := flags.String("domain", os.Getenv("CORALOGIX_DOMAIN"), "The domain of your Coralogix cluster. Conflicts with 'region'.")
+	apiKey := flags.String("api-key", os.Getenv("CORALOGIX_API_KEY"), "The api-key of your Coralogix cluster.")
+	_ = flags.Parse(args)
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return 2, nil
+	}
+
+	gvk, err := resolveKind(flags.Arg(0))
+	if err != nil {
+		return 0, err
+	}
+	differ, err := remoteDifferFor(gvk.Kind)
+	if err != nil {
+		return 0, err
+	}
+	if *apiKey == "" {
+		return 0, fmt.Errorf("api-key must be set")
+	}
+	url, err := config.GetCoralogixOpenApiUrl(strings.ToUpper(*region), *domain)
+	if err != nil {
+		return 0, err
+	}
+	clientSet := openapicxsdk.NewClientSet(openapicxsdk.NewConfigBuilder().
+		WithURL(url).
+		WithAPIKey(*apiKey).
+		Build())
+
+	c, namespace, err := newClient(namespaceFlags)
+	if err != nil {
+		return 0, err
+	}
+	obj, err := newObject(gvk)
+	if err != nil {
+		return 0, err
+	}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: flags.Arg(1)}, obj); err != nil {
+		return 0, err
+	}
+
+	desired, remote, err := differ(ctx, clientSet, obj)
+	if err != nil {
+		return 0, err
+	}
+	differences, err := diffJSON(desired, remote)
+	if err != nil {
+		return 0, err
+	}
+	if len(differences) == 0 {
+		return 0, nil
+	}
+	printDifferences(os.Stdout, differences)
+	return 1, nil
+}
+
+// remoteDifferFor returns the differ of a kind, or an error listing the supported kinds.
+func remoteDifferFor(kind string) (remoteDiffer, error) {
+	differ, ok := remoteDiffers[kind]
+	if !ok {
+		return nil, fmt.Errorf("diff is not supported for %s, supported kinds are %q", kind, slices.Sorted(maps.Keys(remoteDiffers)))
+	}
+	return differ, nil
+}
+
+func diffAlert(ctx context.Context, clientSet *openapicxsdk.ClientSet, obj client.Object) (any, any, error) {
+	alert := obj.(*v1beta1.Alert)
+	if alert.Status.ID == nil {
+		return nil, nil, fmt.Errorf("alert %s/%s has no remote alert yet", alert.Namespace, alert.Name)
+	}
+	defaults, err := v1alpha1.GetAlertDefaults(ctx, alert.Namespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error on getting alert defaults: %w", err)
+	}
+	props, err := alert.Spec.ExtractAlertDefProperties(&v1beta1.GetResourceRefProperties{
+		Ctx:       ctx,
+		Log:       logr.Discard(),
+		ClientSet: clientSet,
+		Namespace: alert.Namespace,
+		Defaults:  defaults,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error on extracting alert properties: %w", err)
+	}
+
+	getResponse, httpResp, err := clientSet.Alerts().
+		AlertDefsServiceGetAlertDef(ctx, *alert.Status.ID).
+		Execute()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error on getting remote alert: %w", openapicxsdk.NewAPIError(httpResp, err))
+	}
+	alertDef := getResponse.GetAlertDef()
+	return props, alertDef.GetAlertDefProperties(), nil
+}
+
+func diffSLO(ctx context.Context, clientSet *openapicxsdk.ClientSet, obj client.Object) (any, any, error) {
+	slo := obj.(*v1alpha1.SLO)
+	if slo.Status.ID == nil {
+		return nil, nil, fmt.Errorf("slo %s/%s has no remote slo yet", slo.Namespace, slo.Name)
+	}
+	defaults, err := v1alpha1.GetSLODefaults(ctx, slo.Namespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error on getting slo defaults: %w", err)
+	}
+	updateRequest, err := slo.ExtractSLOUpdateRequest(defaults)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error on extracting update request: %w", err)
+	}
+
+	getResponse, httpResp, err := clientSet.SLOs().
+		SlosServiceGetSlo(ctx, *slo.Status.ID).
+		Execute()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error on getting remote slo: %w", openapicxsdk.NewAPIError(httpResp, err))
+	}
+	return updateRequest, getResponse.GetSlo(), nil
+}
+
+// difference is a field whose desired and remote values differ. A nil remote value means the field is not set in
+// the remote object.
+type difference struct {
+	path    string
+	desired any
+	remote  any
+}
+
+// diffJSON compares the JSON representations of the desired and remote objects. Only the fields set in the desired
+// object are compared, since the remote object also holds the fields set by Coralogix, e.g. its ID. Lists are also
+// compared by length, so that an item added remotely is reported.
+func diffJSON(desired, remote any) ([]difference, error) {
+	desiredFields, err := flattenJSON(desired)
+	if err != nil {
+		return nil, err
+	}
+	remoteFields, err := flattenJSON(remote)
+	if err != nil {
+		return nil, err
+	}
+
+	var differences []difference
+	for _, path := range slices.Sorted(maps.Keys(desiredFields)) {
+		desiredValue := desiredFields[path]
+		remoteValue, ok := remoteFields[path]
+		if !ok {
+			// Zero values are omitted from the remote object.
+			if reflect.ValueOf(desiredValue).IsZero() {
+				continue
+			}
+			differences = append(differences, difference{path: path, desired: desiredValue})
+			continue
+		}
+		if !reflect.DeepEqual(desiredValue, remoteValue) {
+			differences = append(differences, difference{path: path, desired: desiredValue, remote: remoteValue})
+		}
+	}
+	return differences, nil
+}
+
+// flattenJSON returns the leaf fields of the JSON representation of an object by path, e.g.
+// "notificationGroup.webhooks[0].integration.integrationId". The length of a list is keyed by "len(<path>)".
+func flattenJSON(obj any) (map[string]any, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	fields := map[string]any{}
+	flatten("", value, fields)
+	return fields, nil
+}
+
+func flatten(path string, value any, fields map[string]any) {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, field := range value {
+			if path == "" {
+				flatten(key, field, fields)
+			} else {
+				flatten(path+"."+key, field, fields)
+			}
+		}
+	case []any:
+		fields[fmt.Sprintf("len(%s)", path)] = float64(len(value))
+		for i, item := range value {
+			flatten(fmt.Sprintf("%s[%d]", path, i), item, fields)
+		}
+	default:
+		if value != nil {
+			fields[path] = value
+		}
+	}
+}
+
+func printDifferences(w io.Writer, differences []difference) {
+	for _, d := range differences {
+		desired, _ := json.Marshal(d.desired)
+		if d.remote == nil {
+			fmt.Fprintf(w, "- %s: %s (not set remotely)\n", d.path, desired)
+			continue
+		}
+		remote, _ := json.Marshal(d.remote)
+		fmt.Fprintf(w, "~ %s: %s (spec) != %s (remote)\n", d.path, desired, remote)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+func TestFlatten(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		value    any
+		expected map[string]any
+	}{
+		{
+			name:     "scalar fields",
+			value:    map[string]any{"name": "errors", "enabled": true, "threshold": float64(5)},
+			expected: map[string]any{"name": "errors", "enabled": true, "threshold": float64(5)},
+		},
+		{
+			name:  "nested maps",
+			value: map[string]any{"notificationGroup": map[string]any{"groupByKeys": map[string]any{"key": "service"}}},
+			expected: map[string]any{
+				"notificationGroup.groupByKeys.key": "service",
+			},
+		},
+		{
+			name: "arrays",
+			value: map[string]any{"webhooks": []any{
+				map[string]any{"integration": map[string]any{"integrationId": float64(7)}},
+				map[string]any{"minutes": float64(10)},
+			}},
+			expected: map[string]any{
+				"len(webhooks)":                         float64(2),
+				"webhooks[0].integration.integrationId": float64(7),
+				"webhooks[1].minutes":                   float64(10),
+			},
+		},
+		{
+			name:     "empty arrays",
+			value:    map[string]any{"labels": []any{}},
+			expected: map[string]any{"len(labels)": float64(0)},
+		},
+		{
+			name:     "null fields",
+			value:    map[string]any{"description": nil, "name": "errors"},
+			expected: map[string]any{"name": "errors"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := map[string]any{}
+			flatten("", tt.value, fields)
+			require.Equal(t, tt.expected, fields)
+		})
+	}
+}
+
+func TestDiffJSON(t *testing.T) {
+	type group struct {
+		Key      string   `json:"key,omitempty"`
+		Webhooks []string `json:"webhooks,omitempty"`
+	}
+	type object struct {
+		Name     string            `json:"name,omitempty"`
+		Priority string            `json:"priority,omitempty"`
+		Enabled  bool              `json:"enabled"`
+		Labels   map[string]string `json:"labels,omitempty"`
+		Group    *group            `json:"group,omitempty"`
+		ID       string            `json:"id,omitempty"`
+	}
+
+	for _, tt := range []struct {
+		name     string
+		desired  object
+		remote   any
+		expected []difference
+	}{
+		{
+			name:    "equal",
+			desired: object{Name: "errors", Labels: map[string]string{"team": "payments"}},
+			remote:  object{Name: "errors", Labels: map[string]string{"team": "payments"}},
+		},
+		{
+			name:     "changed scalar",
+			desired:  object{Name: "errors", Priority: "P2"},
+			remote:   object{Name: "errors", Priority: "P1"},
+			expected: []difference{{path: "priority", desired: "P2", remote: "P1"}},
+		},
+		{
+			name:     "nested field only in the spec",
+			desired:  object{Labels: map[string]string{"team": "payments"}},
+			remote:   object{},
+			expected: []difference{{path: "labels.team", desired: "payments"}},
+		},
+		{
+			name:    "fields only in the remote object are ignored",
+			desired: object{Name: "errors"},
+			remote:  object{Name: "errors", ID: "3f5a2c1e", Labels: map[string]string{"team": "payments"}},
+		},
+		{
+			name:    "zero values omitted remotely are ignored",
+			desired: object{Name: "errors", Enabled: false},
+			remote:  map[string]any{"name": "errors"},
+		},
+		{
+			name:     "set value omitted remotely",
+			desired:  object{Name: "errors", Enabled: true},
+			remote:   map[string]any{"name": "errors"},
+			expected: []difference{{path: "enabled", desired: true}},
+		},
+		{
+			name:    "item added remotely",
+			desired: object{Group: &group{Webhooks: []string{"slack"}}},
+			remote:  object{Group: &group{Webhooks: []string{"slack", "pagerduty"}}},
+			expected: []difference{
+				{path: "len(group.webhooks)", desired: float64(1), remote: float64(2)},
+			},
+		},
+		{
+			name:    "item changed and removed remotely",
+			desired: object{Group: &group{Key: "service", Webhooks: []string{"slack", "pagerduty"}}},
+			remote:  object{Group: &group{Key: "service", Webhooks: []string{"email"}}},
+			expected: []difference{
+				{path: "group.webhooks[0]", desired: "slack", remote: "email"},
+				{path: "group.webhooks[1]", desired: "pagerduty"},
+				{path: "len(group.webhooks)", desired: float64(2), remote: float64(1)},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			differences, err := diffJSON(tt.desired, tt.remote)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, differences)
+		})
+	}
+}
+
+func TestPrintDifferences(t *testing.T) {
+	var out bytes.Buffer
+	printDifferences(&out, []difference{
+		{path: "priority", desired: "P2", remote: "P1"},
+		{path: "entityLabels.team", desired: "payments"},
+	})
+	require.Equal(t, `~ priority: "P2" (spec) != "P1" (remote)
+- entityLabels.team: "payments" (not set remotely)
+`, out.String())
+}
+
+func TestRemoteDifferFor(t *testing.T) {
+	for _, kind := range []string{utils.AlertKind, utils.SLOKind} {
+		differ, err := remoteDifferFor(kind)
+		require.NoError(t, err, kind)
+		require.NotNil(t, differ, kind)
+	}
+
+	_, err := remoteDifferFor(utils.DashboardKind)
+	require.EqualError(t, err, `diff is not supported for Dashboard, supported kinds are ["Alert" "SLO"]`)
+}
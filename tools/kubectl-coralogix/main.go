@@ -0,0 +1,173 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/config"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+var scheme = k8sruntime.NewScheme()
+
+func init() {
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(v1beta1.AddToScheme(scheme))
+}
+
+const usage = `kubectl-coralogix inspects the Coralogix resources of the cluster.
+
+Usage:
+  kubectl coralogix status [-n namespace | -A] [-all]
+  kubectl coralogix tree [-n namespace | -A] [name]
+  kubectl coralogix diff [-n namespace] <alert|slo> <name>
+  kubectl coralogix sync [-n namespace] <kind> <name>...
+
+Use "kubectl coralogix <command> -h" for the flags of a command.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	commands := map[string]func(ctx context.Context, args []string) (int, error){
+		"status": runStatus,
+		"tree":   runTree,
+		"diff":   runDiff,
+		"sync":   runSync,
+	}
+	command, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	code, err := command(context.Background(), os.Args[2:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(2)
+	}
+	os.Exit(code)
+}
+
+// namespaceFlags are the namespace flags shared by the commands.
+type namespaceFlags struct {
+	namespace     string
+	allNamespaces bool
+}
+
+func newFlagSet(name, arguments string, allowAllNamespaces bool) (*flag.FlagSet, *namespaceFlags) {
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: kubectl coralogix %s [flags] %s\n", name, arguments)
+		flags.PrintDefaults()
+	}
+	namespace := &namespaceFlags{}
+	flags.StringVar(&namespace.namespace, "n", "", "Namespace of the resources. Defaults to the namespace of the current context.")
+	if allowAllNamespaces {
+		flags.BoolVar(&namespace.allNamespaces, "A", false, "List the resources of all namespaces.")
+	}
+	return flags, namespace
+}
+
+// newClient returns a client for the current kubeconfig context, along with the namespace to use. It also
+// initializes the client of the config package, which the API types use to resolve references and defaults.
+func newClient(flags *namespaceFlags) (client.Client, string, error) {
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{})
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("error loading kubeconfig: %w", err)
+	}
+
+	c, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, "", fmt.Errorf("error creating client: %w", err)
+	}
+	config.InitClient(c)
+
+	if flags.allNamespaces {
+		return c, "", nil
+	}
+	if flags.namespace != "" {
+		return c, flags.namespace, nil
+	}
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting the namespace of the current context: %w", err)
+	}
+	return c, namespace, nil
+}
+
+// coralogixGVKs returns the kinds of the coralogix.com group, sorted by kind.
+func coralogixGVKs() []schema.GroupVersionKind {
+	var result []schema.GroupVersionKind
+	for _, gvk := range utils.GetGVKs(scheme) {
+		if gvk.Group == utils.CoralogixAPIGroup {
+			result = append(result, gvk)
+		}
+	}
+	slices.SortFunc(result, func(a, b schema.GroupVersionKind) int {
+		return strings.Compare(a.Kind, b.Kind)
+	})
+	return result
+}
+
+// resolveKind returns the kind of the coralogix.com group matching a kind name as typed on the command line, e.g.
+// "Alert", "alert" or "alerts".
+func resolveKind(name string) (schema.GroupVersionKind, error) {
+	name = strings.ToLower(name)
+	for _, gvk := range coralogixGVKs() {
+		kind := strings.ToLower(gvk.Kind)
+		if name == kind || name == kind+"s" {
+			return gvk, nil
+		}
+	}
+	return schema.GroupVersionKind{}, fmt.Errorf("unknown kind %q", name)
+}
+
+// newObject returns a new object of a kind of the scheme.
+func newObject(gvk schema.GroupVersionKind) (client.Object, error) {
+	obj, err := scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(client.Object), nil
+}
+
+// newObjectList returns a new list of a kind of the scheme.
+func newObjectList(gvk schema.GroupVersionKind) (client.ObjectList, error) {
+	list, err := scheme.New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		return nil, err
+	}
+	return list.(client.ObjectList), nil
+}
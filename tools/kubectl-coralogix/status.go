@@ -0,0 +1,116 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// maxMessageLength is the length above which the messages of the conditions are truncated.
+const maxMessageLength = 100
+
+// runStatus prints the resources of all the kinds whose remote object is not synced. The exit code is 1 if there
+// is any.
+func runStatus(ctx context.Context, args []string) (int, error) {
+	flags, namespaceFlags := newFlagSet("status", "", true)
+	all := flags.Bool("all", false, "Also print the synced resources.")
+	_ = flags.Parse(args)
+
+	c, namespace, err := newClient(namespaceFlags)
+	if err != nil {
+		return 0, err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "KIND\tNAMESPACE\tNAME\tSTATUS\tREASON\tMESSAGE")
+	unsynced := 0
+	for _, gvk := range coralogixGVKs() {
+		list, err := newObjectList(gvk)
+		if err != nil {
+			return 0, err
+		}
+		if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			// The CRDs of the kinds that are not installed are skipped.
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return 0, fmt.Errorf("error listing %s: %w", gvk.Kind, err)
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return 0, err
+		}
+		for _, item := range items {
+			obj, ok := item.(coralogix.Object)
+			if !ok {
+				continue
+			}
+			synced, reason, message := syncState(obj)
+			if !synced {
+				unsynced++
+			} else if !*all {
+				continue
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				gvk.Kind, obj.GetNamespace(), obj.GetName(), obj.GetPrintableStatus(), reason, message)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return 0, err
+	}
+
+	if unsynced > 0 {
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// syncState returns whether the remote object of a resource is synced with its latest generation, along with the
+// reason and message of its RemoteSynced condition.
+func syncState(obj coralogix.Object) (bool, string, string) {
+	cond := meta.FindStatusCondition(obj.GetConditions(), utils.ConditionTypeRemoteSynced)
+	if cond == nil {
+		return false, "NotReconciled", "The resource was not reconciled yet"
+	}
+	message := truncate(cond.Message)
+	if cond.Status != metav1.ConditionTrue {
+		return false, cond.Reason, message
+	}
+	if cond.ObservedGeneration != 0 && cond.ObservedGeneration < obj.GetGeneration() {
+		return false, cond.Reason, fmt.Sprintf("Generation %d was not reconciled yet, the last synced generation is %d",
+			obj.GetGeneration(), cond.ObservedGeneration)
+	}
+	return true, cond.Reason, message
+}
+
+// truncate returns the first line of a message, cut at maxMessageLength.
+func truncate(message string) string {
+	message, _, _ = strings.Cut(message, "\n")
+	if len(message) > maxMessageLength {
+		return message[:maxMessageLength-3] + "..."
+	}
+	return message
+}
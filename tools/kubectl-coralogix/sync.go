@@ -0,0 +1,74 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// runSync forces the reconciliation of resources by setting their reconcile-requested-at annotation to the current
+// time. Any change of the annotations triggers a reconciliation, which updates the remote object even if the spec
+// did not change.
+func runSync(ctx context.Context, args []string) (int, error) {
+	flags, namespaceFlags := newFlagSet("sync", "<kind> <name>...", false)
+	_ = flags.Parse(args)
+	if flags.NArg() < 2 {
+		flags.Usage()
+		return 2, nil
+	}
+
+	gvk, err := resolveKind(flags.Arg(0))
+	if err != nil {
+		return 0, err
+	}
+	c, namespace, err := newClient(namespaceFlags)
+	if err != nil {
+		return 0, err
+	}
+
+	requestedAt := time.Now().UTC().Format(time.RFC3339)
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				utils.ReconcileRequestedAtAnnotationKey: requestedAt,
+			},
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, name := range flags.Args()[1:] {
+		obj, err := newObject(gvk)
+		if err != nil {
+			return 0, err
+		}
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		if err := c.Patch(ctx, obj, client.RawPatch(types.MergePatchType, patch)); err != nil {
+			return 0, fmt.Errorf("error requesting the reconciliation of %s %s/%s: %w", gvk.Kind, namespace, name, err)
+		}
+		fmt.Printf("%s %s/%s reconciliation requested at %s\n", gvk.Kind, namespace, name, requestedAt)
+	}
+	return 0, nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix"
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/api/coralogix/v1beta1"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+// node is a node of the printed reference graph.
+type node struct {
+	label    string
+	children []node
+}
+
+// runTree prints the Alerts and AlertSets along with the webhooks, connectors, presets and SLOs they refer to.
+func runTree(ctx context.Context, args []string) (int, error) {
+	flags, namespaceFlags := newFlagSet("tree", "[name]", true)
+	_ = flags.Parse(args)
+	if flags.NArg() > 1 {
+		flags.Usage()
+		return 2, nil
+	}
+	name := flags.Arg(0)
+
+	c, namespace, err := newClient(namespaceFlags)
+	if err != nil {
+		return 0, err
+	}
+
+	var roots []node
+	alerts := &v1beta1.AlertList{}
+	if err := c.List(ctx, alerts, client.InNamespace(namespace)); err != nil {
+		return 0, fmt.Errorf("error listing Alerts: %w", err)
+	}
+	for i := range alerts.Items {
+		alert := &alerts.Items[i]
+		if name != "" && alert.Name != name {
+			continue
+		}
+		roots = append(roots, node{
+			label:    objectLabel(utils.AlertKind, alert),
+			children: alertRefNodes(ctx, c, alert.Namespace, &alert.Spec),
+		})
+	}
+
+	alertSets := &v1alpha1.AlertSetList{}
+	if err := c.List(ctx, alertSets, client.InNamespace(namespace)); err != nil {
+		return 0, fmt.Errorf("error listing AlertSets: %w", err)
+	}
+	for i := range alertSets.Items {
+		alertSet := &alertSets.Items[i]
+		if name != "" && alertSet.Name != name {
+			continue
+		}
+		root := node{label: objectLabel(utils.AlertSetKind, alertSet)}
+		for _, item := range alertSet.Spec.Alerts {
+			root.children = append(root.children, node{
+				label:    "alert " + item.Key,
+				children: alertRefNodes(ctx, c, alertSet.Namespace, &item.Spec),
+			})
+		}
+		roots = append(roots, root)
+	}
+
+	if name != "" && len(roots) == 0 {
+		return 0, fmt.Errorf("no Alert or AlertSet named %q", name)
+	}
+	for _, root := range roots {
+		fmt.Println(root.label)
+		printNodes(os.Stdout, root.children, "")
+	}
+	return 0, nil
+}
+
+// alertRefNodes returns the nodes of the webhooks, connectors, presets and SLOs an alert refers to.
+func alertRefNodes(ctx context.Context, c client.Client, namespace string, spec *v1beta1.AlertSpec) []node {
+	var nodes []node
+	add := func(kind string, resourceRef *v1beta1.ResourceRef, backendRef string) {
+		var n node
+		if resourceRef != nil {
+			n = resourceRefNode(ctx, c, kind, ptr.Deref(resourceRef.Namespace, namespace), resourceRef.Name)
+		} else {
+			n = node{label: fmt.Sprintf("%s %s (backend)", kind, backendRef)}
+		}
+		if !slices.ContainsFunc(nodes, func(existing node) bool { return existing.label == n.label }) {
+			nodes = append(nodes, n)
+		}
+	}
+
+	groups := slices.Clone(spec.NotificationGroupExcess)
+	if spec.NotificationGroup != nil {
+		groups = append([]v1beta1.NotificationGroup{*spec.NotificationGroup}, groups...)
+	}
+	for _, group := range groups {
+		for _, webhook := range group.Webhooks {
+			ref := webhook.Integration.IntegrationRef
+			if ref == nil {
+				continue
+			}
+			add(utils.OutboundWebhookKind, ref.ResourceRef, webhookBackendRef(ref.BackendRef))
+		}
+		for _, destination := range group.Destinations {
+			add(utils.ConnectorKind, destination.Connector.ResourceRef, ncBackendRef(destination.Connector.BackendRef))
+			if destination.Preset != nil {
+				add(utils.PresetKind, destination.Preset.ResourceRef, ncBackendRef(destination.Preset.BackendRef))
+			}
+		}
+	}
+
+	if sloThreshold := spec.TypeDefinition.SloThreshold; sloThreshold != nil {
+		ref := sloThreshold.SloDefinition.SloRef
+		var backendRef string
+		if ref.BackendRef != nil {
+			backendRef = backendIDOrName(ref.BackendRef.ID, ref.BackendRef.Name)
+		}
+		add(utils.SLOKind, ref.ResourceRef, backendRef)
+	}
+	return nodes
+}
+
+// resourceRefNode returns the node of a referenced resource, along with its status.
+func resourceRefNode(ctx context.Context, c client.Client, kind, namespace, name string) node {
+	gvk, err := resolveKind(kind)
+	if err != nil {
+		return node{label: fmt.Sprintf("%s %s/%s [%v]", kind, namespace, name, err)}
+	}
+	obj, err := newObject(gvk)
+	if err != nil {
+		return node{label: fmt.Sprintf("%s %s/%s [%v]", kind, namespace, name, err)}
+	}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return node{label: fmt.Sprintf("%s %s/%s [not found]", kind, namespace, name)}
+		}
+		return node{label: fmt.Sprintf("%s %s/%s [%v]", kind, namespace, name, err)}
+	}
+	return node{label: objectLabel(kind, obj)}
+}
+
+// objectLabel returns the label of a resource, along with its printable status if it has one.
+func objectLabel(kind string, obj client.Object) string {
+	label := fmt.Sprintf("%s %s/%s", kind, obj.GetNamespace(), obj.GetName())
+	if coralogixObject, ok := obj.(coralogix.Object); ok && coralogixObject.GetPrintableStatus() != "" {
+		label += fmt.Sprintf(" [%s]", coralogixObject.GetPrintableStatus())
+	}
+	return label
+}
+
+func webhookBackendRef(ref *v1beta1.OutboundWebhookBackendRef) string {
+	if ref == nil {
+		return ""
+	}
+	var id *string
+	if ref.ID != nil {
+		id = ptr.To(strconv.FormatInt(*ref.ID, 10))
+	}
+	return backendIDOrName(id, ref.Name)
+}
+
+func ncBackendRef(ref *v1beta1.NCBackendRef) string {
+	if ref == nil {
+		return ""
+	}
+	return "id " + ref.ID
+}
+
+func backendIDOrName(id, name *string) string {
+	if id != nil {
+		return "id " + *id
+	}
+	return "name " + ptr.Deref(name, "")
+}
+
+// printNodes prints nodes as a tree below their parent.
+func printNodes(w io.Writer, nodes []node, prefix string) {
+	for i, n := range nodes {
+		branch, indent := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Fprintf(w, "%s%s%s\n", prefix, branch, n.label)
+		printNodes(w, n.children, prefix+indent)
+	}
+}
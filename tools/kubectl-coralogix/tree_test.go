@@ -0,0 +1,76 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintNodes(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		nodes    []node
+		expected string
+	}{
+		{
+			name: "no nodes",
+		},
+		{
+			name:     "single node",
+			nodes:    []node{{label: "OutboundWebhook payments/slack-payments [RemoteSynced]"}},
+			expected: "└── OutboundWebhook payments/slack-payments [RemoteSynced]\n",
+		},
+		{
+			name: "siblings",
+			nodes: []node{
+				{label: "OutboundWebhook payments/slack-payments [RemoteSynced]"},
+				{label: "Connector payments/pagerduty [not found]"},
+				{label: "Preset id 3f5a2c1e (backend)"},
+			},
+			expected: "├── OutboundWebhook payments/slack-payments [RemoteSynced]\n" +
+				"├── Connector payments/pagerduty [not found]\n" +
+				"└── Preset id 3f5a2c1e (backend)\n",
+		},
+		{
+			name: "nested nodes",
+			nodes: []node{
+				{label: "alert availability", children: []node{
+					{label: "SLO payments/checkout-availability [RemoteSynced]"},
+					{label: "OutboundWebhook name on-call (backend)"},
+				}},
+				{label: "alert latency", children: []node{
+					{label: "Connector payments/pagerduty [RemoteSynced]", children: []node{
+						{label: "Preset id 3f5a2c1e (backend)"},
+					}},
+				}},
+			},
+			expected: "├── alert availability\n" +
+				"│   ├── SLO payments/checkout-availability [RemoteSynced]\n" +
+				"│   └── OutboundWebhook name on-call (backend)\n" +
+				"└── alert latency\n" +
+				"    └── Connector payments/pagerduty [RemoteSynced]\n" +
+				"        └── Preset id 3f5a2c1e (backend)\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			printNodes(&out, tt.nodes, "")
+			require.Equal(t, tt.expected, out.String())
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/ruleengine"
+	"github.com/coralogix/coralogix-operator/v2/internal/utils"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of %s: %s [flags] <file>... (use - to read from stdin)\n", os.Args[0], os.Args[0])
+		flag.PrintDefaults()
+	}
+	verbose := flag.Bool("v", false, "Print passing tests as well as failing ones.")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	failed := false
+	for _, path := range flag.Args() {
+		ruleGroups, err := readRuleGroups(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			os.Exit(2)
+		}
+		for _, ruleGroup := range ruleGroups {
+			if !runTests(os.Stdout, path, ruleGroup, *verbose) {
+				failed = true
+			}
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// runTests prints the results of the rule-group tests, and returns whether all of them passed.
+func runTests(out io.Writer, path string, ruleGroup *coralogixv1alpha1.RuleGroup, verbose bool) bool {
+	results := ruleengine.RunTests(&ruleGroup.Spec)
+	if len(results) == 0 {
+		if verbose {
+			fmt.Fprintf(out, "%s %s: no tests\n", path, ruleGroup.Name)
+		}
+		return true
+	}
+
+	passed := 0
+	for _, result := range results {
+		if result.Passed {
+			passed++
+			if verbose {
+				fmt.Fprintf(out, "PASS %s %s: %s\n", path, ruleGroup.Name, result.Name)
+			}
+			continue
+		}
+		fmt.Fprintf(out, "FAIL %s %s: %s\n     %s\n", path, ruleGroup.Name, result.Name, result.Message)
+	}
+	fmt.Fprintf(out, "%s %s: %d/%d tests passed\n", path, ruleGroup.Name, passed, len(results))
+	return passed == len(results)
+}
+
+// readRuleGroups reads the RuleGroup documents of a YAML or JSON file, ignoring other kinds.
+func readRuleGroups(path string) ([]*coralogixv1alpha1.RuleGroup, error) {
+	reader := io.Reader(os.Stdin)
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	var ruleGroups []*coralogixv1alpha1.RuleGroup
+	decoder := utilyaml.NewYAMLOrJSONDecoder(reader, 4096)
+	for {
+		var document map[string]any
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				return ruleGroups, nil
+			}
+			return nil, err
+		}
+		if document["kind"] != utils.RuleGroupKind {
+			continue
+		}
+
+		setRuleGroupDefaults(document)
+		ruleGroup := &coralogixv1alpha1.RuleGroup{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(document, ruleGroup); err != nil {
+			return nil, fmt.Errorf("invalid RuleGroup: %w", err)
+		}
+		ruleGroups = append(ruleGroups, ruleGroup)
+	}
+}
+
+// setRuleGroupDefaults sets the defaults the API server would apply from the CRD schema to the fields
+// the rule engine depends on.
+func setRuleGroupDefaults(document map[string]any) {
+	spec, ok := document["spec"].(map[string]any)
+	if !ok {
+		return
+	}
+	setDefault(spec, "active", true)
+	subgroups, _ := spec["subgroups"].([]any)
+	for _, subgroup := range subgroups {
+		subgroup, ok := subgroup.(map[string]any)
+		if !ok {
+			continue
+		}
+		setDefault(subgroup, "active", true)
+		rules, _ := subgroup["rules"].([]any)
+		for _, rule := range rules {
+			rule, ok := rule.(map[string]any)
+			if !ok {
+				continue
+			}
+			setDefault(rule, "active", true)
+			if block, ok := rule["block"].(map[string]any); ok {
+				setDefault(block, "blockingAllMatchingBlocks", true)
+			}
+		}
+	}
+}
+
+func setDefault(object map[string]any, key string, value any) {
+	if _, ok := object[key]; !ok {
+		object[key] = value
+	}
+}
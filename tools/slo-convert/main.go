@@ -0,0 +1,127 @@
+// Copyright 2024 Coralogix Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	coralogixv1alpha1 "github.com/coralogix/coralogix-operator/v2/api/coralogix/v1alpha1"
+	"github.com/coralogix/coralogix-operator/v2/internal/sloconvert"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of %s: %s [flags] <file>... (use - to read from stdin)\n", os.Args[0], os.Args[0])
+		flag.PrintDefaults()
+	}
+	defaults := sloconvert.DefaultOptions()
+	namespace := flag.String("namespace", "", "Namespace of the SLOs. Defaults to the namespace of the Sloth manifests, if any.")
+	timeFrame := flag.String("time-frame", string(defaults.TimeFrame), "Time frame of the SLOs converted from Sloth, which has no time window: 7d, 14d, 21d or 28d.")
+	rateWindow := flag.String("rate-window", defaults.RateWindow, "Range that replaces the {{.window}} placeholder of Sloth queries.")
+	strict := flag.Bool("strict", false, "Exit with code 1 if any field or SLO could not be converted.")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var documents []map[string]any
+	for _, path := range flag.Args() {
+		fileDocuments, err := readDocuments(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			os.Exit(2)
+		}
+		documents = append(documents, fileDocuments...)
+	}
+
+	result := sloconvert.Convert(documents, sloconvert.Options{
+		Namespace:  *namespace,
+		TimeFrame:  coralogixv1alpha1.SloTimeFrame(*timeFrame),
+		RateWindow: *rateWindow,
+	})
+	for _, unsupported := range result.Unsupported {
+		fmt.Fprintf(os.Stderr, "unsupported: %s\n", unsupported)
+	}
+	if err := writeSLOs(os.Stdout, result.SLOs); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if *strict && len(result.Unsupported) > 0 {
+		os.Exit(1)
+	}
+}
+
+// writeSLOs writes the SLOs as YAML documents, without the fields that are only set by the API server.
+func writeSLOs(out io.Writer, slos []*coralogixv1alpha1.SLO) error {
+	for i, slo := range slos {
+		document, err := runtime.DefaultUnstructuredConverter.ToUnstructured(slo)
+		if err != nil {
+			return fmt.Errorf("error converting SLO %s: %w", slo.Name, err)
+		}
+		delete(document, "status")
+		if metadata, ok := document["metadata"].(map[string]any); ok {
+			delete(metadata, "creationTimestamp")
+		}
+
+		data, err := yaml.Marshal(document)
+		if err != nil {
+			return fmt.Errorf("error marshaling SLO %s: %w", slo.Name, err)
+		}
+		if i > 0 {
+			fmt.Fprintln(out, "---")
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readDocuments reads the documents of a YAML or JSON file.
+func readDocuments(path string) ([]map[string]any, error) {
+	reader := io.Reader(os.Stdin)
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	var documents []map[string]any
+	decoder := utilyaml.NewYAMLOrJSONDecoder(reader, 4096)
+	for {
+		var document map[string]any
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				return documents, nil
+			}
+			return nil, err
+		}
+		if document != nil {
+			documents = append(documents, document)
+		}
+	}
+}